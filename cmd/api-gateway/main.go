@@ -8,16 +8,13 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/oogway93/taskmanager/config"
 	"github.com/oogway93/taskmanager/internal/api-gateway"
 	"github.com/oogway93/taskmanager/logger"
-	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
 
 	AuthHandler "github.com/oogway93/taskmanager/internal/api-gateway/auth"
-	healthHandler "github.com/oogway93/taskmanager/internal/api-gateway/health"
 	TaskHandler "github.com/oogway93/taskmanager/internal/api-gateway/task"
 )
 
@@ -45,27 +42,7 @@ func main() {
 	defer taskHandler.Close()
 
 	middlewares.PrometheusInit()
-	router := gin.Default()
-	router.Use(cors.Default())
-
-	router.Use(middlewares.PrometheusMiddleware())
-
-	router.Use(middlewares.JWTMiddleware(jwtConfig))
-	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
-	public := router.Group("/api/v1")
-	{
-		public.GET("/health", healthHandler.HealthCheck)
-		public.POST("/auth/login", authHandler.Login)
-		public.POST("/auth/registration", authHandler.Register)
-	}
-
-	protected := router.Group("/api/v1")
-	{
-		protected.GET("/auth/profile", authHandler.GetProfile)
-		protected.POST("/task", taskHandler.Create)
-		protected.GET("/task", taskHandler.ListTasks)
-		protected.GET("/task/:id", taskHandler.GetTask)
-	}
+	router := newRouter(jwtConfig, authHandler, taskHandler)
 
 	server := &http.Server{
 		Addr:         cfg.GetServerAddress(),
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/oogway93/taskmanager/internal/api-gateway"
+	"github.com/oogway93/taskmanager/internal/api-gateway/openapi"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	AuthHandler "github.com/oogway93/taskmanager/internal/api-gateway/auth"
+	healthHandler "github.com/oogway93/taskmanager/internal/api-gateway/health"
+	TaskHandler "github.com/oogway93/taskmanager/internal/api-gateway/task"
+)
+
+// newRouter регистрирует все маршруты шлюза. Новый маршрут нужно описать и в openapi.Operations,
+// иначе упадет routes_test.go
+func newRouter(jwtConfig *middlewares.JWTConfig, authHandler *AuthHandler.Handler, taskHandler *TaskHandler.Handler) *gin.Engine {
+	router := gin.Default()
+	router.Use(cors.Default())
+
+	router.Use(middlewares.PrometheusMiddleware())
+
+	router.Use(middlewares.JWTMiddleware(jwtConfig))
+	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
+	router.GET("/api/v1/openapi.json", openapi.Spec)
+	router.GET("/api/v1/docs", openapi.SwaggerUI)
+	router.GET("/api/v1/docs/assets/:file", openapi.SwaggerAsset)
+	public := router.Group("/api/v1")
+	{
+		public.GET("/health", healthHandler.HealthCheck)
+		public.POST("/auth/login", authHandler.Login)
+		public.POST("/auth/registration", authHandler.Register)
+		public.GET("/public/tasks/:token", taskHandler.GetPublicTask)
+	}
+
+	protected := router.Group("/api/v1")
+	{
+		protected.GET("/auth/profile", authHandler.GetProfile)
+		protected.GET("/auth/digest", authHandler.GetDigestSettings)
+		protected.PUT("/auth/digest", authHandler.UpdateDigestSettings)
+		protected.GET("/auth/preferences", authHandler.GetNotificationPreferences)
+		protected.PUT("/auth/preferences", authHandler.UpdateNotificationPreferences)
+		protected.PUT("/auth/timezone", authHandler.UpdateTimezone)
+		protected.POST("/task", taskHandler.Create)
+		protected.GET("/task", taskHandler.ListTasks)
+		protected.GET("/task/next", taskHandler.NextTasks)
+		protected.GET("/task/:id", taskHandler.GetTask)
+		protected.PATCH("/task/:id", taskHandler.UpdateTask)
+		protected.PUT("/task/:id/quadrant", taskHandler.SetQuadrant)
+		protected.DELETE("/task/:id/quadrant", taskHandler.ClearQuadrant)
+		protected.POST("/task/:id/snooze", taskHandler.SnoozeTask)
+		protected.DELETE("/task/:id/snooze", taskHandler.UnsnoozeTask)
+		protected.POST("/task/:id/clone", taskHandler.CloneTask)
+		protected.GET("/task/:id/shares", taskHandler.ListShares)
+		protected.POST("/task/:id/shares", taskHandler.ShareTask)
+		protected.DELETE("/task/:id/shares/:user", taskHandler.UnshareTask)
+		protected.GET("/task/:id/links", taskHandler.ListPublicLinks)
+		protected.POST("/task/:id/links", taskHandler.CreatePublicLink)
+		protected.DELETE("/task/:id/links/:link", taskHandler.RevokePublicLink)
+		protected.GET("/task/:id/checklist", taskHandler.GetChecklist)
+		protected.POST("/task/:id/checklist", taskHandler.AddChecklistItem)
+		protected.PUT("/task/:id/checklist/order", taskHandler.ReorderChecklist)
+		protected.POST("/task/:id/checklist/:item/toggle", taskHandler.ToggleChecklistItem)
+		protected.POST("/task/:id/checklist/:item/convert", taskHandler.ConvertChecklistItem)
+		protected.DELETE("/task/:id/checklist/:item", taskHandler.DeleteChecklistItem)
+		protected.POST("/task/import/ics", taskHandler.ImportICS)
+		protected.POST("/task/quick", taskHandler.QuickAdd)
+		protected.GET("/matrix", taskHandler.EisenhowerMatrix)
+		protected.GET("/calendar", taskHandler.Calendar)
+		protected.GET("/stats", taskHandler.Stats)
+		protected.GET("/stats/burndown", taskHandler.Burndown)
+		protected.GET("/stats/cfd", taskHandler.CumulativeFlow)
+		protected.POST("/views", taskHandler.CreateView)
+		protected.GET("/views", taskHandler.ListViews)
+		protected.GET("/views/:id", taskHandler.GetView)
+		protected.PUT("/views/:id", taskHandler.UpdateView)
+		protected.DELETE("/views/:id", taskHandler.DeleteView)
+		protected.POST("/templates", taskHandler.CreateTemplate)
+		protected.GET("/templates", taskHandler.ListTemplates)
+		protected.GET("/templates/:id", taskHandler.GetTemplate)
+		protected.PUT("/templates/:id", taskHandler.UpdateTemplate)
+		protected.DELETE("/templates/:id", taskHandler.DeleteTemplate)
+		protected.POST("/templates/:id/instantiate", taskHandler.InstantiateTemplate)
+		protected.POST("/fields", taskHandler.CreateField)
+		protected.GET("/fields", taskHandler.ListFields)
+		protected.PUT("/fields/:id", taskHandler.UpdateField)
+		protected.DELETE("/fields/:id", taskHandler.DeleteField)
+		protected.GET("/sla/policies", taskHandler.ListSLAPolicies)
+		protected.PUT("/sla/policies/:priority", middlewares.AdminOnly(), taskHandler.UpdateSLAPolicy)
+		protected.POST("/webhooks", taskHandler.CreateWebhook)
+		protected.GET("/webhooks", taskHandler.ListWebhooks)
+		protected.PUT("/webhooks/:id", taskHandler.UpdateWebhook)
+		protected.DELETE("/webhooks/:id", taskHandler.DeleteWebhook)
+		protected.GET("/webhooks/:id/deliveries", taskHandler.ListWebhookDeliveries)
+		protected.POST("/webhooks/:id/deliveries/:delivery_id/redeliver", taskHandler.RedeliverWebhook)
+		protected.GET("/notifications", taskHandler.ListNotifications)
+		protected.POST("/notifications/read", taskHandler.MarkAllNotificationsRead)
+		protected.POST("/notifications/:id/read", taskHandler.MarkNotificationRead)
+	}
+
+	return router
+}
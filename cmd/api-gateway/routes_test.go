@@ -0,0 +1,79 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oogway93/taskmanager/internal/api-gateway"
+	"github.com/oogway93/taskmanager/internal/api-gateway/openapi"
+
+	AuthHandler "github.com/oogway93/taskmanager/internal/api-gateway/auth"
+	TaskHandler "github.com/oogway93/taskmanager/internal/api-gateway/task"
+)
+
+// testRouter собирает маршруты с пустыми обработчиками: тесту нужны только таблица маршрутов
+// и JWTMiddleware, а паника обработчика без клиентов превращается Recovery в 500
+func testRouter(t *testing.T) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	gin.DefaultWriter, gin.DefaultErrorWriter = io.Discard, io.Discard
+	return newRouter(&middlewares.JWTConfig{SecretKey: "test"}, &AuthHandler.Handler{}, &TaskHandler.Handler{})
+}
+
+func TestOpenAPIMatchesRoutes(t *testing.T) {
+	router := testRouter(t)
+
+	registered := make(map[string]bool)
+	for _, route := range router.Routes() {
+		registered[route.Method+" "+route.Path] = true
+	}
+	documented := make(map[string]bool)
+	for _, op := range openapi.Operations {
+		key := op.Method + " " + op.Path
+		if documented[key] {
+			t.Errorf("%s is documented twice", key)
+		}
+		documented[key] = true
+	}
+
+	for key := range registered {
+		if !documented[key] {
+			t.Errorf("%s is registered but missing from openapi.Operations", key)
+		}
+	}
+	for key := range documented {
+		if !registered[key] {
+			t.Errorf("%s is documented but not registered", key)
+		}
+	}
+}
+
+// TestOpenAPIAuthMatchesMiddleware проверяет, что маршрут помечен Public ровно тогда,
+// когда JWTMiddleware пропускает запрос без токена
+func TestOpenAPIAuthMatchesMiddleware(t *testing.T) {
+	router := testRouter(t)
+
+	for _, op := range openapi.Operations {
+		path := op.Path
+		for _, segment := range strings.Split(op.Path, "/") {
+			if name, ok := strings.CutPrefix(segment, ":"); ok {
+				value := "x"
+				if name == "token" {
+					value = strings.Repeat("a", 43)
+				}
+				path = strings.Replace(path, segment, value, 1)
+			}
+		}
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(op.Method, path, nil))
+		unauthorized := w.Code == http.StatusUnauthorized
+		if op.Public == unauthorized {
+			t.Errorf("%s %s: documented public=%v, got status %d without token", op.Method, op.Path, op.Public, w.Code)
+		}
+	}
+}
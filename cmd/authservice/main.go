@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"net"
 	"os"
 	"os/signal"
@@ -11,7 +12,10 @@ import (
 	"github.com/oogway93/taskmanager/internal/authservice/repository"
 	"github.com/oogway93/taskmanager/internal/authservice/server"
 	"github.com/oogway93/taskmanager/internal/authservice/service"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/infrastructure/outbox"
 	"github.com/oogway93/taskmanager/internal/infrastructure/postgres"
+	"github.com/oogway93/taskmanager/internal/infrastructure/rabbitmq"
 	"github.com/oogway93/taskmanager/logger"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -38,6 +42,15 @@ func main() {
 	tokenService := service.NewTokenService(cfg, Log)
 	authService := service.NewAuthService(userRepo, tokenService, Log)
 
+	// Outbox relay публикует user.registered и другие доменные события в RabbitMQ
+	publisher := rabbitmq.NewPublisher(cfg.RabbitMQ.URL, Log)
+	defer publisher.Close()
+
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	defer stopRelay()
+	go outbox.NewRelay(db, publisher, entity.EventsExchange,
+		cfg.Outbox.PollInterval, cfg.Outbox.BatchSize, cfg.Outbox.MaxAttempts, Log).Run(relayCtx)
+
 	// Create gRPC server
 	grpcServer := grpc.NewServer()
 	authServer := server.NewAuthServer(authService, tokenService, Log)
@@ -67,6 +80,7 @@ func main() {
 	<-quit
 
 	Log.Info("Shutting down Auth Service...")
+	stopRelay()
 	grpcServer.GracefulStop()
 	Log.Info("Auth Service stopped")
 }
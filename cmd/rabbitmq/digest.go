@@ -0,0 +1,154 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	htmltemplate "html/template"
+	"log"
+	"mime"
+	"mime/multipart"
+	"net/smtp"
+	"net/textproto"
+	"slices"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/streadway/amqp"
+)
+
+// digestQueue получает события digest.daily, опубликованные планировщиком task service через outbox
+const digestQueue = "email_digests"
+
+var digestFuncs = map[string]any{
+	"due": func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format("02.01.2006 15:04")
+	},
+}
+
+var digestText = texttemplate.Must(texttemplate.New("digest.txt").Funcs(digestFuncs).Parse(
+	`Привет, {{.Username}}!
+
+Ваши задачи на {{.Date}} ({{.Timezone}}).
+{{if .Overdue}}
+Просрочены:
+{{range .Overdue}}  - [{{.Priority}}] {{.Title}} (срок {{due .DueDate}})
+{{end}}{{end}}{{if .DueToday}}
+Срок сегодня:
+{{range .DueToday}}  - [{{.Priority}}] {{.Title}} (до {{due .DueDate}})
+{{end}}{{end}}{{if .RecentlyAssigned}}
+Новые задачи:
+{{range .RecentlyAssigned}}  - [{{.Priority}}] {{.Title}}
+{{end}}{{end}}{{if .Notifications}}
+Уведомления:
+{{range .Notifications}}  - {{.Title}}{{if .Body}}: {{.Body}}{{end}}
+{{end}}{{end}}
+Отключить дайджест можно в настройках: PUT /api/v1/auth/digest {"enabled": false}
+`))
+
+var digestHTML = htmltemplate.Must(htmltemplate.New("digest.html").Funcs(digestFuncs).Parse(
+	`<!DOCTYPE html>
+<html>
+<body style="font-family: sans-serif; color: #222;">
+<p>Привет, {{.Username}}!</p>
+<p>Ваши задачи на <b>{{.Date}}</b> ({{.Timezone}}).</p>
+{{if .Overdue}}<h3 style="color: #c0392b;">Просрочены</h3>
+<ul>{{range .Overdue}}<li><b>[{{.Priority}}]</b> {{.Title}} <i>срок {{due .DueDate}}</i></li>{{end}}</ul>{{end}}
+{{if .DueToday}}<h3>Срок сегодня</h3>
+<ul>{{range .DueToday}}<li><b>[{{.Priority}}]</b> {{.Title}} <i>до {{due .DueDate}}</i></li>{{end}}</ul>{{end}}
+{{if .RecentlyAssigned}}<h3>Новые задачи</h3>
+<ul>{{range .RecentlyAssigned}}<li><b>[{{.Priority}}]</b> {{.Title}}</li>{{end}}</ul>{{end}}
+{{if .Notifications}}<h3>Уведомления</h3>
+<ul>{{range .Notifications}}<li>{{.Title}}{{if .Body}} <i>{{.Body}}</i>{{end}}</li>{{end}}</ul>{{end}}
+<p style="font-size: 12px; color: #888;">Отключить дайджест можно в настройках профиля.</p>
+</body>
+</html>
+`))
+
+// consumeDigests отправляет письма дайджеста, если пользователь не отключил письма-напоминания.
+// Сообщение подтверждается после отправки; при ошибке SMTP оно возвращается в очередь один раз
+func consumeDigests(ch *amqp.Channel, emailFrom, pass string) {
+	msgs := consumeEvents(ch, digestQueue, entity.EventDailyDigest)
+
+	go func() {
+		for d := range msgs {
+			var digest entity.DailyDigest
+			if err := json.Unmarshal(d.Body, &digest); err != nil {
+				log.Printf("Ошибка декодирования дайджеста: %s", err)
+				d.Nack(false, false)
+				continue
+			}
+			if digest.Channels != nil && !slices.Contains(digest.Channels, entity.ChannelEmail) {
+				d.Ack(false)
+				continue
+			}
+
+			if err := sendDigestEmail(emailFrom, pass, &digest); err != nil {
+				log.Printf("Ошибка отправки дайджеста: %s", err)
+				d.Nack(false, !d.Redelivered)
+				continue
+			}
+			d.Ack(false)
+			log.Printf("Дайджест отправлен для: %s", digest.Email)
+		}
+	}()
+}
+
+func sendDigestEmail(emailFrom, pass string, digest *entity.DailyDigest) error {
+	msg, err := renderDigest(emailFrom, digest)
+	if err != nil {
+		return err
+	}
+
+	return smtp.SendMail("smtp.gmail.com:587",
+		smtp.PlainAuth("", emailFrom, pass, "smtp.gmail.com"),
+		emailFrom, []string{digest.Email}, msg)
+}
+
+// renderDigest собирает письмо multipart/alternative с текстовой и HTML версиями
+func renderDigest(emailFrom string, digest *entity.DailyDigest) ([]byte, error) {
+	var text, html bytes.Buffer
+	if err := digestText.Execute(&text, digest); err != nil {
+		return nil, err
+	}
+	if err := digestHTML.Execute(&html, digest); err != nil {
+		return nil, err
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for _, part := range []struct {
+		contentType string
+		content     []byte
+	}{
+		{"text/plain; charset=UTF-8", text.Bytes()},
+		{"text/html; charset=UTF-8", html.Bytes()},
+	} {
+		w, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"8bit"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(part.content); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", emailFrom)
+	fmt.Fprintf(&msg, "To: %s\r\n", digest.Email)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", "Задачи на "+digest.Date))
+	fmt.Fprintf(&msg, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&msg, "Content-Type: multipart/alternative; boundary=%s\r\n\r\n", writer.Boundary())
+	msg.Write(body.Bytes())
+	return msg.Bytes(), nil
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"mime"
+	"net/smtp"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/streadway/amqp"
+)
+
+// notificationQueue получает письма об отдельных уведомлениях; тихие часы уже учтены task service,
+// который публикует событие только после их окончания
+const notificationQueue = "email_notifications"
+
+// consumeEvents объявляет постоянную очередь, привязывает ее к событию routingKey и возвращает
+// сообщения с ручным подтверждением
+func consumeEvents(ch *amqp.Channel, queue, routingKey string) <-chan amqp.Delivery {
+	q, err := ch.QueueDeclare(queue, true, false, false, false, nil)
+	if err != nil {
+		log.Fatalf("Ошибка объявления очереди: %s", err)
+	}
+	err = ch.QueueBind(q.Name, routingKey, entity.EventsExchange, false, nil)
+	if err != nil {
+		log.Fatalf("Ошибка привязки очереди: %s", err)
+	}
+
+	msgs, err := ch.Consume(q.Name, "", false, false, false, false, nil)
+	if err != nil {
+		log.Fatalf("Ошибка регистрации потребителя: %s", err)
+	}
+	return msgs
+}
+
+// consumeNotifications отправляет письма событий notification.email
+func consumeNotifications(ch *amqp.Channel, emailFrom, pass string) {
+	msgs := consumeEvents(ch, notificationQueue, entity.EventNotificationEmail)
+
+	go func() {
+		for d := range msgs {
+			var email entity.NotificationEmail
+			if err := json.Unmarshal(d.Body, &email); err != nil {
+				log.Printf("Ошибка декодирования уведомления: %s", err)
+				d.Nack(false, false)
+				continue
+			}
+
+			if err := sendNotificationEmail(emailFrom, pass, &email); err != nil {
+				log.Printf("Ошибка отправки уведомления: %s", err)
+				d.Nack(false, !d.Redelivered)
+				continue
+			}
+			d.Ack(false)
+			log.Printf("Уведомление отправлено для: %s", email.Email)
+		}
+	}()
+}
+
+func sendNotificationEmail(emailFrom, pass string, email *entity.NotificationEmail) error {
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", emailFrom)
+	fmt.Fprintf(&msg, "To: %s\r\n", email.Email)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", email.Title))
+	fmt.Fprintf(&msg, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&msg, "Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	fmt.Fprintf(&msg, "Привет, %s!\r\n\r\n%s\r\n", email.Username, email.Title)
+	if email.Body != "" {
+		fmt.Fprintf(&msg, "%s\r\n", email.Body)
+	}
+	fmt.Fprintf(&msg, "\r\nНастроить уведомления можно в профиле: PUT /api/v1/auth/preferences\r\n")
+
+	return smtp.SendMail("smtp.gmail.com:587",
+		smtp.PlainAuth("", emailFrom, pass, "smtp.gmail.com"),
+		emailFrom, []string{email.Email}, msg.Bytes())
+}
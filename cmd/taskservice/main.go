@@ -1,18 +1,30 @@
 package main
 
 import (
+	"context"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 
+	"github.com/gofrs/uuid/v5"
 	"github.com/oogway93/taskmanager/config"
 	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/infrastructure/outbox"
 	"github.com/oogway93/taskmanager/internal/infrastructure/postgres"
+	"github.com/oogway93/taskmanager/internal/infrastructure/rabbitmq"
+	"github.com/oogway93/taskmanager/internal/taskservice/cache"
+	"github.com/oogway93/taskmanager/internal/taskservice/digest"
+	"github.com/oogway93/taskmanager/internal/taskservice/notification"
 	"github.com/oogway93/taskmanager/internal/taskservice/repository"
 	"github.com/oogway93/taskmanager/internal/taskservice/server"
 	"github.com/oogway93/taskmanager/internal/taskservice/service"
+	"github.com/oogway93/taskmanager/internal/taskservice/sla"
+	"github.com/oogway93/taskmanager/internal/taskservice/webhook"
 	"github.com/oogway93/taskmanager/logger"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 )
@@ -33,12 +45,96 @@ func main() {
 
 	// Initialize repositories
 	taskRepo := repository.NewTaskRepository(db, Log)
+	viewRepo := repository.NewSavedViewRepository(db, Log)
+	templateRepo := repository.NewTemplateRepository(db, Log)
+	fieldRepo := repository.NewCustomFieldRepository(db, Log)
+	slaRepo := repository.NewSLARepository(db, Log)
+	webhookRepo := repository.NewWebhookRepository(db, Log)
+	digestRepo := repository.NewDigestRepository(db, Log)
+	notificationRepo := repository.NewNotificationRepository(db, Log)
+	preferenceRepo := repository.NewPreferenceRepository(db, Log)
+	quadrantRepo := repository.NewQuadrantRepository(db, Log)
+	checklistRepo := repository.NewChecklistRepository(db, Log)
+	shareRepo := repository.NewShareRepository(db, Log)
+	linkRepo := repository.NewPublicLinkRepository(db, Log)
+
+	// Кэш GetTask/ListTasks: общий Redis, если задан TASK_CACHE_REDIS_URL, иначе LRU в памяти процесса
+	var (
+		tasks         repository.TaskRepository = taskRepo
+		cachedTasks   *cache.Repository
+		cacheConsumer *rabbitmq.Consumer
+	)
+	if cfg.Cache.Enabled {
+		var backend cache.Backend = cache.NewLRU(cfg.Cache.Size)
+		// с LRU каждый экземпляр получает все события через собственную временную очередь
+		cacheConsumer = rabbitmq.NewConsumer(cfg.RabbitMQ.URL, entity.EventsExchange,
+			cache.Queue+"."+uuid.Must(uuid.NewV4()).String(), cache.EventTypes, Log).AutoDelete()
+		if cfg.Cache.RedisURL != "" {
+			redisCache, err := cache.NewRedis(cfg.Cache.RedisURL)
+			if err != nil {
+				Log.Fatal("Failed to configure Redis cache:", zap.Error(err))
+			}
+			defer redisCache.Close()
+			if err := redisCache.Ping(context.Background()); err != nil {
+				Log.Warn("Redis cache is unavailable, requests go to the database until it recovers", zap.Error(err))
+			}
+			backend = redisCache
+			cacheConsumer = rabbitmq.NewConsumer(cfg.RabbitMQ.URL, entity.EventsExchange, cache.Queue, cache.EventTypes, Log)
+		}
+		cachedTasks = cache.NewRepository(taskRepo, backend, cfg.Cache.TaskTTL, cfg.Cache.ListTTL, Log)
+		tasks = cachedTasks
+	}
+
+	allowedNetworks, err := webhook.ParseAllowedNetworks(cfg.Webhook.AllowedNetworks)
+	if err != nil {
+		Log.Fatal("Failed to parse WEBHOOK_ALLOWED_NETWORKS:", zap.Error(err))
+	}
+	webhookPolicy := webhook.AddressPolicy{Allowed: allowedNetworks}
 
 	// Initialize services
-	taskService := service.NewTaskService(taskRepo, Log)
+	taskService := service.NewTaskService(tasks, viewRepo, templateRepo, fieldRepo, slaRepo, webhookRepo, notificationRepo, quadrantRepo, checklistRepo, shareRepo, linkRepo, webhookPolicy, Log)
+
+	publisher := rabbitmq.NewPublisher(cfg.RabbitMQ.URL, Log)
+	defer publisher.Close()
+
+	// Фоновая проверка SLA пишет эскалации в outbox
+	checkerCtx, stopChecker := context.WithCancel(context.Background())
+	defer stopChecker()
+	go sla.NewChecker(slaRepo, cfg.SLA.CheckInterval, Log).Run(checkerCtx)
+
+	// Outbox relay публикует доменные события задач в RabbitMQ
+	go outbox.NewRelay(db, publisher, entity.EventsExchange,
+		cfg.Outbox.PollInterval, cfg.Outbox.BatchSize, cfg.Outbox.MaxAttempts, Log).Run(checkerCtx)
+
+	// Вебхуки: события задач раскладываются по подпискам, доставки отправляются с повторами
+	webhookConsumer := rabbitmq.NewConsumer(cfg.RabbitMQ.URL, entity.EventsExchange, webhook.Queue, webhook.EventTypes, Log)
+	go webhookConsumer.Run(checkerCtx, webhook.NewFanout(webhookRepo, preferenceRepo, Log).Handle)
+	go webhook.NewDispatcher(webhookRepo, webhook.NewHTTPClient(cfg.Webhook.Timeout, webhookPolicy),
+		cfg.Webhook.DispatchInterval, cfg.Webhook.MaxAttempts, Log).Run(checkerCtx)
+
+	// Уведомления в приложении создаются из тех же доменных событий, что получает email worker
+	notificationConsumer := rabbitmq.NewConsumer(cfg.RabbitMQ.URL, entity.EventsExchange, notification.Queue, notification.EventTypes, Log)
+	go notificationConsumer.Run(checkerCtx, notification.NewFeed(notificationRepo, preferenceRepo, Log).Handle)
+
+	// Кэш сбрасывается и по событиям: изменения других экземпляров и проверки SLA идут в обход декоратора
+	if cachedTasks != nil {
+		go cacheConsumer.Run(checkerCtx, cachedTasks.Handle)
+	}
+
+	// Ежедневный дайджест публикуется через outbox, письмо собирает cmd/rabbitmq
+	go digest.NewScheduler(digestRepo, preferenceRepo, cfg.Digest.CheckInterval, Log).Run(checkerCtx)
+
+	// Метрики Prometheus, в том числе попадания в кэш задач
+	cache.PrometheusInit()
+	metricsServer := &http.Server{Addr: cfg.GetTaskMetricsAddress(), Handler: promhttp.Handler()}
+	go func() {
+		if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			Log.Error("Failed to serve metrics in Task Service:", zap.Error(err))
+		}
+	}()
 
 	// Create gRPC server
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(server.ErrorInterceptor))
 	taskServer := server.NewTaskServer(taskService, Log)
 
 	// Register auth service
@@ -65,6 +161,8 @@ func main() {
 	<-quit
 
 	Log.Info("Shutting down Task Service...")
+	stopChecker()
 	grpcServer.GracefulStop()
+	metricsServer.Close()
 	Log.Info("Task Service stopped")
 }
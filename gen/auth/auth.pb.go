@@ -499,6 +499,8 @@ type User struct {
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	LastLoginAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_login_at,json=lastLoginAt,proto3" json:"last_login_at,omitempty"`
+	// часовой пояс IANA: локальные дни календаря, время дайджеста и тихие часы
+	Timezone      string `protobuf:"bytes,10,opt,name=timezone,proto3" json:"timezone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -596,6 +598,519 @@ func (x *User) GetLastLoginAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// Настройки ежедневного дайджеста
+type DigestSettings struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Enabled  bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Timezone string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// локальный час отправки, 0..23
+	Hour          int32 `protobuf:"varint,3,opt,name=hour,proto3" json:"hour,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DigestSettings) Reset() {
+	*x = DigestSettings{}
+	mi := &file_proto_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigestSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigestSettings) ProtoMessage() {}
+
+func (x *DigestSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigestSettings.ProtoReflect.Descriptor instead.
+func (*DigestSettings) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DigestSettings) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *DigestSettings) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *DigestSettings) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+type GetDigestSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDigestSettingsRequest) Reset() {
+	*x = GetDigestSettingsRequest{}
+	mi := &file_proto_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDigestSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDigestSettingsRequest) ProtoMessage() {}
+
+func (x *GetDigestSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDigestSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetDigestSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetDigestSettingsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Часовой пояс меняется только через UpdateTimezone
+type UpdateDigestSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Enabled       *bool                  `protobuf:"varint,2,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	Hour          *int32                 `protobuf:"varint,4,opt,name=hour,proto3,oneof" json:"hour,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDigestSettingsRequest) Reset() {
+	*x = UpdateDigestSettingsRequest{}
+	mi := &file_proto_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDigestSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDigestSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateDigestSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDigestSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDigestSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateDigestSettingsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateDigestSettingsRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *UpdateDigestSettingsRequest) GetHour() int32 {
+	if x != nil && x.Hour != nil {
+		return *x.Hour
+	}
+	return 0
+}
+
+// NotificationChannels - каналы одного типа уведомления: email, in_app, webhook; пустой список - тип отключен
+type NotificationChannels struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channels      []string               `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationChannels) Reset() {
+	*x = NotificationChannels{}
+	mi := &file_proto_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationChannels) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationChannels) ProtoMessage() {}
+
+func (x *NotificationChannels) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationChannels.ProtoReflect.Descriptor instead.
+func (*NotificationChannels) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *NotificationChannels) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+// QuietHours - локальное время HH:MM, когда письма откладываются
+type QuietHours struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Start         string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuietHours) Reset() {
+	*x = QuietHours{}
+	mi := &file_proto_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuietHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuietHours) ProtoMessage() {}
+
+func (x *QuietHours) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuietHours.ProtoReflect.Descriptor instead.
+func (*QuietHours) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QuietHours) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *QuietHours) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *QuietHours) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type NotificationPreferences struct {
+	state          protoimpl.MessageState           `protogen:"open.v1"`
+	Channels       map[string]*NotificationChannels `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	QuietHours     *QuietHours                      `protobuf:"bytes,2,opt,name=quiet_hours,json=quietHours,proto3" json:"quiet_hours,omitempty"`
+	Timezone       string                           `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	DigestBatching bool                             `protobuf:"varint,4,opt,name=digest_batching,json=digestBatching,proto3" json:"digest_batching,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NotificationPreferences) Reset() {
+	*x = NotificationPreferences{}
+	mi := &file_proto_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferences) ProtoMessage() {}
+
+func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *NotificationPreferences) GetChannels() map[string]*NotificationChannels {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *NotificationPreferences) GetQuietHours() *QuietHours {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+func (x *NotificationPreferences) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetDigestBatching() bool {
+	if x != nil {
+		return x.DigestBatching
+	}
+	return false
+}
+
+type GetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	mi := &file_proto_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetNotificationPreferencesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// UpdateNotificationPreferencesRequest - частичное обновление, каналы меняются только для переданных типов
+type UpdateNotificationPreferencesRequest struct {
+	state          protoimpl.MessageState           `protogen:"open.v1"`
+	UserId         string                           `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Channels       map[string]*NotificationChannels `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	QuietHours     *QuietHours                      `protobuf:"bytes,3,opt,name=quiet_hours,json=quietHours,proto3" json:"quiet_hours,omitempty"`
+	DigestBatching *bool                            `protobuf:"varint,5,opt,name=digest_batching,json=digestBatching,proto3,oneof" json:"digest_batching,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
+	mi := &file_proto_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetChannels() map[string]*NotificationChannels {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetQuietHours() *QuietHours {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetDigestBatching() bool {
+	if x != nil && x.DigestBatching != nil {
+		return *x.DigestBatching
+	}
+	return false
+}
+
+type UpdateTimezoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTimezoneRequest) Reset() {
+	*x = UpdateTimezoneRequest{}
+	mi := &file_proto_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTimezoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTimezoneRequest) ProtoMessage() {}
+
+func (x *UpdateTimezoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTimezoneRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTimezoneRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateTimezoneRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateTimezoneRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
 var File_proto_auth_proto protoreflect.FileDescriptor
 
 const file_proto_auth_proto_rawDesc = "" +
@@ -634,7 +1149,7 @@ const file_proto_auth_proto_rawDesc = "" +
 	"token_type\x18\x03 \x01(\tR\ttokenType\x129\n" +
 	"\n" +
 	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x19\n" +
-	"\x04user\x18\x05 \x01(\v2\x05.UserR\x04user\"\xd1\x02\n" +
+	"\x04user\x18\x05 \x01(\v2\x05.UserR\x04user\"\xed\x02\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
@@ -646,12 +1161,63 @@ const file_proto_auth_proto_rawDesc = "" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
-	"\rlast_login_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vlastLoginAt2\xf1\x01\n" +
+	"\rlast_login_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vlastLoginAt\x12\x1a\n" +
+	"\btimezone\x18\n" +
+	" \x01(\tR\btimezone\"Z\n" +
+	"\x0eDigestSettings\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12\x12\n" +
+	"\x04hour\x18\x03 \x01(\x05R\x04hour\"3\n" +
+	"\x18GetDigestSettingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x93\x01\n" +
+	"\x1bUpdateDigestSettingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\aenabled\x18\x02 \x01(\bH\x00R\aenabled\x88\x01\x01\x12\x17\n" +
+	"\x04hour\x18\x04 \x01(\x05H\x01R\x04hour\x88\x01\x01B\n" +
+	"\n" +
+	"\b_enabledB\a\n" +
+	"\x05_hourJ\x04\b\x03\x10\x04R\btimezone\"2\n" +
+	"\x14NotificationChannels\x12\x1a\n" +
+	"\bchannels\x18\x01 \x03(\tR\bchannels\"N\n" +
+	"\n" +
+	"QuietHours\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\"\xa4\x02\n" +
+	"\x17NotificationPreferences\x12B\n" +
+	"\bchannels\x18\x01 \x03(\v2&.NotificationPreferences.ChannelsEntryR\bchannels\x12,\n" +
+	"\vquiet_hours\x18\x02 \x01(\v2\v.QuietHoursR\n" +
+	"quietHours\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12'\n" +
+	"\x0fdigest_batching\x18\x04 \x01(\bR\x0edigestBatching\x1aR\n" +
+	"\rChannelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12+\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.NotificationChannelsR\x05value:\x028\x01\"<\n" +
+	"!GetNotificationPreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xe4\x02\n" +
+	"$UpdateNotificationPreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12O\n" +
+	"\bchannels\x18\x02 \x03(\v23.UpdateNotificationPreferencesRequest.ChannelsEntryR\bchannels\x12,\n" +
+	"\vquiet_hours\x18\x03 \x01(\v2\v.QuietHoursR\n" +
+	"quietHours\x12,\n" +
+	"\x0fdigest_batching\x18\x05 \x01(\bH\x00R\x0edigestBatching\x88\x01\x01\x1aR\n" +
+	"\rChannelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12+\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.NotificationChannelsR\x05value:\x028\x01B\x12\n" +
+	"\x10_digest_batchingJ\x04\b\x04\x10\x05R\btimezone\"L\n" +
+	"\x15UpdateTimezoneRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone2\x84\x05\n" +
 	"\vAuthService\x121\n" +
 	"\bRegister\x12\x10.RegisterRequest\x1a\x11.RegisterResponse\"\x00\x12(\n" +
 	"\x05Login\x12\r.LoginRequest\x1a\x0e.LoginResponse\"\x00\x12@\n" +
 	"\rValidateToken\x12\x15.ValidateTokenRequest\x1a\x16.ValidateTokenResponse\"\x00\x12C\n" +
-	"\x0eGetUserProfile\x12\x16.GetUserProfileRequest\x1a\x17.GetUserProfileResponse\"\x00B\n" +
+	"\x0eGetUserProfile\x12\x16.GetUserProfileRequest\x1a\x17.GetUserProfileResponse\"\x00\x12A\n" +
+	"\x11GetDigestSettings\x12\x19.GetDigestSettingsRequest\x1a\x0f.DigestSettings\"\x00\x12G\n" +
+	"\x14UpdateDigestSettings\x12\x1c.UpdateDigestSettingsRequest\x1a\x0f.DigestSettings\"\x00\x12\\\n" +
+	"\x1aGetNotificationPreferences\x12\".GetNotificationPreferencesRequest\x1a\x18.NotificationPreferences\"\x00\x12b\n" +
+	"\x1dUpdateNotificationPreferences\x12%.UpdateNotificationPreferencesRequest\x1a\x18.NotificationPreferences\"\x00\x12C\n" +
+	"\x0eUpdateTimezone\x12\x16.UpdateTimezoneRequest\x1a\x17.GetUserProfileResponse\"\x00B\n" +
 	"Z\bgen/authb\x06proto3"
 
 var (
@@ -666,41 +1232,68 @@ func file_proto_auth_proto_rawDescGZIP() []byte {
 	return file_proto_auth_proto_rawDescData
 }
 
-var file_proto_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_proto_auth_proto_goTypes = []any{
-	(*RegisterRequest)(nil),        // 0: RegisterRequest
-	(*RegisterResponse)(nil),       // 1: RegisterResponse
-	(*ValidateTokenRequest)(nil),   // 2: ValidateTokenRequest
-	(*ValidateTokenResponse)(nil),  // 3: ValidateTokenResponse
-	(*GetUserProfileRequest)(nil),  // 4: GetUserProfileRequest
-	(*GetUserProfileResponse)(nil), // 5: GetUserProfileResponse
-	(*LoginRequest)(nil),           // 6: LoginRequest
-	(*LoginResponse)(nil),          // 7: LoginResponse
-	(*User)(nil),                   // 8: User
-	(*timestamppb.Timestamp)(nil),  // 9: google.protobuf.Timestamp
+	(*RegisterRequest)(nil),                      // 0: RegisterRequest
+	(*RegisterResponse)(nil),                     // 1: RegisterResponse
+	(*ValidateTokenRequest)(nil),                 // 2: ValidateTokenRequest
+	(*ValidateTokenResponse)(nil),                // 3: ValidateTokenResponse
+	(*GetUserProfileRequest)(nil),                // 4: GetUserProfileRequest
+	(*GetUserProfileResponse)(nil),               // 5: GetUserProfileResponse
+	(*LoginRequest)(nil),                         // 6: LoginRequest
+	(*LoginResponse)(nil),                        // 7: LoginResponse
+	(*User)(nil),                                 // 8: User
+	(*DigestSettings)(nil),                       // 9: DigestSettings
+	(*GetDigestSettingsRequest)(nil),             // 10: GetDigestSettingsRequest
+	(*UpdateDigestSettingsRequest)(nil),          // 11: UpdateDigestSettingsRequest
+	(*NotificationChannels)(nil),                 // 12: NotificationChannels
+	(*QuietHours)(nil),                           // 13: QuietHours
+	(*NotificationPreferences)(nil),              // 14: NotificationPreferences
+	(*GetNotificationPreferencesRequest)(nil),    // 15: GetNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesRequest)(nil), // 16: UpdateNotificationPreferencesRequest
+	(*UpdateTimezoneRequest)(nil),                // 17: UpdateTimezoneRequest
+	nil,                                          // 18: NotificationPreferences.ChannelsEntry
+	nil,                                          // 19: UpdateNotificationPreferencesRequest.ChannelsEntry
+	(*timestamppb.Timestamp)(nil),                // 20: google.protobuf.Timestamp
 }
 var file_proto_auth_proto_depIdxs = []int32{
-	9,  // 0: RegisterResponse.expires_at:type_name -> google.protobuf.Timestamp
+	20, // 0: RegisterResponse.expires_at:type_name -> google.protobuf.Timestamp
 	8,  // 1: RegisterResponse.user:type_name -> User
 	8,  // 2: GetUserProfileResponse.user:type_name -> User
-	9,  // 3: LoginResponse.expires_at:type_name -> google.protobuf.Timestamp
+	20, // 3: LoginResponse.expires_at:type_name -> google.protobuf.Timestamp
 	8,  // 4: LoginResponse.user:type_name -> User
-	9,  // 5: User.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 6: User.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 7: User.last_login_at:type_name -> google.protobuf.Timestamp
-	0,  // 8: AuthService.Register:input_type -> RegisterRequest
-	6,  // 9: AuthService.Login:input_type -> LoginRequest
-	2,  // 10: AuthService.ValidateToken:input_type -> ValidateTokenRequest
-	4,  // 11: AuthService.GetUserProfile:input_type -> GetUserProfileRequest
-	1,  // 12: AuthService.Register:output_type -> RegisterResponse
-	7,  // 13: AuthService.Login:output_type -> LoginResponse
-	3,  // 14: AuthService.ValidateToken:output_type -> ValidateTokenResponse
-	5,  // 15: AuthService.GetUserProfile:output_type -> GetUserProfileResponse
-	12, // [12:16] is the sub-list for method output_type
-	8,  // [8:12] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	20, // 5: User.created_at:type_name -> google.protobuf.Timestamp
+	20, // 6: User.updated_at:type_name -> google.protobuf.Timestamp
+	20, // 7: User.last_login_at:type_name -> google.protobuf.Timestamp
+	18, // 8: NotificationPreferences.channels:type_name -> NotificationPreferences.ChannelsEntry
+	13, // 9: NotificationPreferences.quiet_hours:type_name -> QuietHours
+	19, // 10: UpdateNotificationPreferencesRequest.channels:type_name -> UpdateNotificationPreferencesRequest.ChannelsEntry
+	13, // 11: UpdateNotificationPreferencesRequest.quiet_hours:type_name -> QuietHours
+	12, // 12: NotificationPreferences.ChannelsEntry.value:type_name -> NotificationChannels
+	12, // 13: UpdateNotificationPreferencesRequest.ChannelsEntry.value:type_name -> NotificationChannels
+	0,  // 14: AuthService.Register:input_type -> RegisterRequest
+	6,  // 15: AuthService.Login:input_type -> LoginRequest
+	2,  // 16: AuthService.ValidateToken:input_type -> ValidateTokenRequest
+	4,  // 17: AuthService.GetUserProfile:input_type -> GetUserProfileRequest
+	10, // 18: AuthService.GetDigestSettings:input_type -> GetDigestSettingsRequest
+	11, // 19: AuthService.UpdateDigestSettings:input_type -> UpdateDigestSettingsRequest
+	15, // 20: AuthService.GetNotificationPreferences:input_type -> GetNotificationPreferencesRequest
+	16, // 21: AuthService.UpdateNotificationPreferences:input_type -> UpdateNotificationPreferencesRequest
+	17, // 22: AuthService.UpdateTimezone:input_type -> UpdateTimezoneRequest
+	1,  // 23: AuthService.Register:output_type -> RegisterResponse
+	7,  // 24: AuthService.Login:output_type -> LoginResponse
+	3,  // 25: AuthService.ValidateToken:output_type -> ValidateTokenResponse
+	5,  // 26: AuthService.GetUserProfile:output_type -> GetUserProfileResponse
+	9,  // 27: AuthService.GetDigestSettings:output_type -> DigestSettings
+	9,  // 28: AuthService.UpdateDigestSettings:output_type -> DigestSettings
+	14, // 29: AuthService.GetNotificationPreferences:output_type -> NotificationPreferences
+	14, // 30: AuthService.UpdateNotificationPreferences:output_type -> NotificationPreferences
+	5,  // 31: AuthService.UpdateTimezone:output_type -> GetUserProfileResponse
+	23, // [23:32] is the sub-list for method output_type
+	14, // [14:23] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_proto_auth_proto_init() }
@@ -708,13 +1301,15 @@ func file_proto_auth_proto_init() {
 	if File_proto_auth_proto != nil {
 		return
 	}
+	file_proto_auth_proto_msgTypes[11].OneofWrappers = []any{}
+	file_proto_auth_proto_msgTypes[16].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_auth_proto_rawDesc), len(file_proto_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
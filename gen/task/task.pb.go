@@ -9,6 +9,7 @@ package task
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -127,17 +128,32 @@ func (TaskPriorities) EnumDescriptor() ([]byte, []int) {
 }
 
 type Task struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Priority      string                 `protobuf:"bytes,4,opt,name=priority,proto3" json:"priority,omitempty"`
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	UserId        string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
-	Tags          []string               `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Priority    string                 `protobuf:"bytes,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	Status      string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	UserId      string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DueDate     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Tags        []string               `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	Estimate    float64                `protobuf:"fixed64,11,opt,name=estimate,proto3" json:"estimate,omitempty"`
+	ParentId    string                 `protobuf:"bytes,12,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Project     string                 `protobuf:"bytes,13,opt,name=project,proto3" json:"project,omitempty"`
+	// значения пользовательских полей по ключу определения
+	CustomFields     *structpb.Struct       `protobuf:"bytes,14,opt,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	SlaResponseDueAt *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=sla_response_due_at,json=slaResponseDueAt,proto3" json:"sla_response_due_at,omitempty"`
+	SlaDueAt         *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=sla_due_at,json=slaDueAt,proto3" json:"sla_due_at,omitempty"`
+	RespondedAt      *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=responded_at,json=respondedAt,proto3" json:"responded_at,omitempty"`
+	// ON_TRACK, AT_RISK, BREACHED, MET; пустой - SLA не отслеживается
+	SlaStatus string `protobuf:"bytes,18,opt,name=sla_status,json=slaStatus,proto3" json:"sla_status,omitempty"`
+	// до start_at и snoozed_until задача скрыта из списка
+	StartAt      *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	SnoozedUntil *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=snoozed_until,json=snoozedUntil,proto3" json:"snoozed_until,omitempty"`
+	// прогресс чек-листа; total = 0 - чек-листа нет
+	Checklist     *ChecklistProgress `protobuf:"bytes,21,opt,name=checklist,proto3" json:"checklist,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -242,6 +258,83 @@ func (x *Task) GetTags() []string {
 	return nil
 }
 
+func (x *Task) GetEstimate() float64 {
+	if x != nil {
+		return x.Estimate
+	}
+	return 0
+}
+
+func (x *Task) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Task) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *Task) GetCustomFields() *structpb.Struct {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+func (x *Task) GetSlaResponseDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SlaResponseDueAt
+	}
+	return nil
+}
+
+func (x *Task) GetSlaDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SlaDueAt
+	}
+	return nil
+}
+
+func (x *Task) GetRespondedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RespondedAt
+	}
+	return nil
+}
+
+func (x *Task) GetSlaStatus() string {
+	if x != nil {
+		return x.SlaStatus
+	}
+	return ""
+}
+
+func (x *Task) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *Task) GetSnoozedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SnoozedUntil
+	}
+	return nil
+}
+
+func (x *Task) GetChecklist() *ChecklistProgress {
+	if x != nil {
+		return x.Checklist
+	}
+	return nil
+}
+
 type CreateTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -327,8 +420,10 @@ func (x *CreateTaskRequest) GetTags() []string {
 }
 
 type GetTaskRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TaskId string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// задача возвращается владельцу и пользователям, которым к ней выдан доступ
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -370,9 +465,29 @@ func (x *GetTaskRequest) GetTaskId() string {
 	return ""
 }
 
+func (x *GetTaskRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 type ListTasksRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// view_id - применить фильтр и сортировку сохраненного представления
+	ViewId string `protobuf:"bytes,2,opt,name=view_id,json=viewId,proto3" json:"view_id,omitempty"`
+	// часовой пояс для вычисления относительных дат представления
+	Timezone     string                  `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Project      string                  `protobuf:"bytes,4,opt,name=project,proto3" json:"project,omitempty"`
+	CustomFields []*CustomFieldCondition `protobuf:"bytes,5,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	// поле сортировки, для пользовательского поля - "cf:<key>"
+	SortBy   string `protobuf:"bytes,6,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortDesc bool   `protobuf:"varint,7,opt,name=sort_desc,json=sortDesc,proto3" json:"sort_desc,omitempty"`
+	// включить задачи, которые еще нельзя начать или которые отложены
+	IncludeDeferred bool `protobuf:"varint,8,opt,name=include_deferred,json=includeDeferred,proto3" json:"include_deferred,omitempty"`
+	// вернуть задачи других пользователей, к которым выдан доступ, вместо своих
+	SharedWithMe  bool `protobuf:"varint,9,opt,name=shared_with_me,json=sharedWithMe,proto3" json:"shared_with_me,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -414,6 +529,62 @@ func (x *ListTasksRequest) GetUserId() string {
 	return ""
 }
 
+func (x *ListTasksRequest) GetViewId() string {
+	if x != nil {
+		return x.ViewId
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetCustomFields() []*CustomFieldCondition {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetSortDesc() bool {
+	if x != nil {
+		return x.SortDesc
+	}
+	return false
+}
+
+func (x *ListTasksRequest) GetIncludeDeferred() bool {
+	if x != nil {
+		return x.IncludeDeferred
+	}
+	return false
+}
+
+func (x *ListTasksRequest) GetSharedWithMe() bool {
+	if x != nil {
+		return x.SharedWithMe
+	}
+	return false
+}
+
 type ListTasksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
@@ -467,14 +638,20 @@ func (x *ListTasksResponse) GetTotal() int32 {
 }
 
 type UpdateTaskRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
-	Tags          []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TaskId      string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId      string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Status      string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	DueDate     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Tags        []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	Estimate    *float64               `protobuf:"fixed64,8,opt,name=estimate,proto3,oneof" json:"estimate,omitempty"`
+	Project     *string                `protobuf:"bytes,9,opt,name=project,proto3,oneof" json:"project,omitempty"`
+	// сливается с текущими значениями; null удаляет значение поля
+	CustomFields *structpb.Struct `protobuf:"bytes,10,opt,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	// нулевое время снимает дату начала
+	StartAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -558,6 +735,34 @@ func (x *UpdateTaskRequest) GetTags() []string {
 	return nil
 }
 
+func (x *UpdateTaskRequest) GetEstimate() float64 {
+	if x != nil && x.Estimate != nil {
+		return *x.Estimate
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetProject() string {
+	if x != nil && x.Project != nil {
+		return *x.Project
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetCustomFields() *structpb.Struct {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
 type DeleteTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
@@ -698,75 +903,6246 @@ func (x *DeleteTaskResponse) GetSuccess() bool {
 	return false
 }
 
-var File_proto_task_proto protoreflect.FileDescriptor
+type ImportICSRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_task_proto_rawDesc = "" +
-	"\n" +
-	"\x10proto/task.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdc\x02\n" +
-	"\x04Task\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
-	"\bpriority\x18\x04 \x01(\tR\bpriority\x12\x16\n" +
-	"\x06status\x18\x05 \x01(\tR\x06status\x12\x17\n" +
-	"\auser_id\x18\x06 \x01(\tR\x06userId\x129\n" +
-	"\n" +
-	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x125\n" +
-	"\bdue_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12\x12\n" +
-	"\x04tags\x18\n" +
-	" \x03(\tR\x04tags\"\xcb\x01\n" +
-	"\x11CreateTaskRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
-	"\bpriority\x18\x03 \x01(\tR\bpriority\x12\x17\n" +
-	"\auser_id\x18\x04 \x01(\tR\x06userId\x125\n" +
-	"\bdue_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12\x12\n" +
-	"\x04tags\x18\x06 \x03(\tR\x04tags\")\n" +
-	"\x0eGetTaskRequest\x12\x17\n" +
-	"\atask_id\x18\x01 \x01(\tR\x06taskId\"+\n" +
-	"\x10ListTasksRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"F\n" +
-	"\x11ListTasksResponse\x12\x1b\n" +
-	"\x05tasks\x18\x01 \x03(\v2\x05.TaskR\x05tasks\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"\xe0\x01\n" +
-	"\x11UpdateTaskRequest\x12\x17\n" +
-	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
-	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x16\n" +
-	"\x06status\x18\x05 \x01(\tR\x06status\x125\n" +
-	"\bdue_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12\x12\n" +
-	"\x04tags\x18\a \x03(\tR\x04tags\"E\n" +
-	"\x11DeleteTaskRequest\x12\x17\n" +
-	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\")\n" +
-	"\fTaskResponse\x12\x19\n" +
-	"\x04task\x18\x01 \x01(\v2\x05.TaskR\x04task\".\n" +
-	"\x12DeleteTaskResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess*H\n" +
-	"\n" +
-	"TaskStatus\x12\v\n" +
-	"\aPENDING\x10\x00\x12\x0f\n" +
-	"\vIN_PROGRESS\x10\x01\x12\r\n" +
-	"\tCOMPLETED\x10\x02\x12\r\n" +
-	"\tCANCELLED\x10\x03*=\n" +
-	"\x0eTaskPriorities\x12\a\n" +
-	"\x03LOW\x10\x00\x12\n" +
-	"\n" +
-	"\x06NORMAL\x10\x01\x12\b\n" +
-	"\x04HIGH\x10\x02\x12\f\n" +
-	"\bCRITICAL\x10\x032\x82\x02\n" +
-	"\vTaskService\x12$\n" +
-	"\n" +
-	"CreateTask\x12\x05.Task\x1a\r.TaskResponse\"\x00\x12+\n" +
-	"\aGetTask\x12\x0f.GetTaskRequest\x1a\r.TaskResponse\"\x00\x124\n" +
-	"\tListTasks\x12\x11.ListTasksRequest\x1a\x12.ListTasksResponse\"\x00\x121\n" +
-	"\n" +
-	"UpdateTask\x12\x12.UpdateTaskRequest\x1a\r.TaskResponse\"\x00\x127\n" +
+func (x *ImportICSRequest) Reset() {
+	*x = ImportICSRequest{}
+	mi := &file_proto_task_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportICSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportICSRequest) ProtoMessage() {}
+
+func (x *ImportICSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportICSRequest.ProtoReflect.Descriptor instead.
+func (*ImportICSRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ImportICSRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ImportICSRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ImportICSResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Created       int32                  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                  `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Skipped       int32                  `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Tasks         []*Task                `protobuf:"bytes,4,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportICSResponse) Reset() {
+	*x = ImportICSResponse{}
+	mi := &file_proto_task_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportICSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportICSResponse) ProtoMessage() {}
+
+func (x *ImportICSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportICSResponse.ProtoReflect.Descriptor instead.
+func (*ImportICSResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ImportICSResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportICSResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportICSResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ImportICSResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type QuickAddTaskRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Text   string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// IANA имя часового пояса, например "Europe/Moscow"; по умолчанию UTC
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// preview - только распознать строку, не сохраняя задачу
+	Preview       bool `protobuf:"varint,4,opt,name=preview,proto3" json:"preview,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuickAddTaskRequest) Reset() {
+	*x = QuickAddTaskRequest{}
+	mi := &file_proto_task_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuickAddTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuickAddTaskRequest) ProtoMessage() {}
+
+func (x *QuickAddTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuickAddTaskRequest.ProtoReflect.Descriptor instead.
+func (*QuickAddTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QuickAddTaskRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QuickAddTaskRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *QuickAddTaskRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *QuickAddTaskRequest) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
+	return false
+}
+
+type QuickAddTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Saved         bool                   `protobuf:"varint,2,opt,name=saved,proto3" json:"saved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuickAddTaskResponse) Reset() {
+	*x = QuickAddTaskResponse{}
+	mi := &file_proto_task_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuickAddTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuickAddTaskResponse) ProtoMessage() {}
+
+func (x *QuickAddTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuickAddTaskResponse.ProtoReflect.Descriptor instead.
+func (*QuickAddTaskResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QuickAddTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *QuickAddTaskResponse) GetSaved() bool {
+	if x != nil {
+		return x.Saved
+	}
+	return false
+}
+
+type GetTaskStatsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Tags   []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	From   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// часовой пояс для группировки по дням и неделям
+	Timezone      string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskStatsRequest) Reset() {
+	*x = GetTaskStatsRequest{}
+	mi := &file_proto_task_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskStatsRequest) ProtoMessage() {}
+
+func (x *GetTaskStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetTaskStatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTaskStatsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetTaskStatsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetTaskStatsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetTaskStatsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type StatsBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	Created       int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Completed     int32                  `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsBucket) Reset() {
+	*x = StatsBucket{}
+	mi := &file_proto_task_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsBucket) ProtoMessage() {}
+
+func (x *StatsBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsBucket.ProtoReflect.Descriptor instead.
+func (*StatsBucket) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StatsBucket) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *StatsBucket) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *StatsBucket) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+type StatusDuration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TotalHours    float64                `protobuf:"fixed64,2,opt,name=total_hours,json=totalHours,proto3" json:"total_hours,omitempty"`
+	AvgHours      float64                `protobuf:"fixed64,3,opt,name=avg_hours,json=avgHours,proto3" json:"avg_hours,omitempty"`
+	Tasks         int32                  `protobuf:"varint,4,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusDuration) Reset() {
+	*x = StatusDuration{}
+	mi := &file_proto_task_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusDuration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusDuration) ProtoMessage() {}
+
+func (x *StatusDuration) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusDuration.ProtoReflect.Descriptor instead.
+func (*StatusDuration) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StatusDuration) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StatusDuration) GetTotalHours() float64 {
+	if x != nil {
+		return x.TotalHours
+	}
+	return 0
+}
+
+func (x *StatusDuration) GetAvgHours() float64 {
+	if x != nil {
+		return x.AvgHours
+	}
+	return 0
+}
+
+func (x *StatusDuration) GetTasks() int32 {
+	if x != nil {
+		return x.Tasks
+	}
+	return 0
+}
+
+type TaskStats struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	From              *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Created           int32                  `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Completed         int32                  `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	Daily             []*StatsBucket         `protobuf:"bytes,5,rep,name=daily,proto3" json:"daily,omitempty"`
+	Weekly            []*StatsBucket         `protobuf:"bytes,6,rep,name=weekly,proto3" json:"weekly,omitempty"`
+	AvgCycleTimeHours float64                `protobuf:"fixed64,7,opt,name=avg_cycle_time_hours,json=avgCycleTimeHours,proto3" json:"avg_cycle_time_hours,omitempty"`
+	P90CycleTimeHours float64                `protobuf:"fixed64,8,opt,name=p90_cycle_time_hours,json=p90CycleTimeHours,proto3" json:"p90_cycle_time_hours,omitempty"`
+	TimeInStatus      []*StatusDuration      `protobuf:"bytes,9,rep,name=time_in_status,json=timeInStatus,proto3" json:"time_in_status,omitempty"`
+	OverdueRate       float64                `protobuf:"fixed64,10,opt,name=overdue_rate,json=overdueRate,proto3" json:"overdue_rate,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TaskStats) Reset() {
+	*x = TaskStats{}
+	mi := &file_proto_task_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskStats) ProtoMessage() {}
+
+func (x *TaskStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskStats.ProtoReflect.Descriptor instead.
+func (*TaskStats) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TaskStats) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TaskStats) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *TaskStats) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *TaskStats) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *TaskStats) GetDaily() []*StatsBucket {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+func (x *TaskStats) GetWeekly() []*StatsBucket {
+	if x != nil {
+		return x.Weekly
+	}
+	return nil
+}
+
+func (x *TaskStats) GetAvgCycleTimeHours() float64 {
+	if x != nil {
+		return x.AvgCycleTimeHours
+	}
+	return 0
+}
+
+func (x *TaskStats) GetP90CycleTimeHours() float64 {
+	if x != nil {
+		return x.P90CycleTimeHours
+	}
+	return 0
+}
+
+func (x *TaskStats) GetTimeInStatus() []*StatusDuration {
+	if x != nil {
+		return x.TimeInStatus
+	}
+	return nil
+}
+
+func (x *TaskStats) GetOverdueRate() float64 {
+	if x != nil {
+		return x.OverdueRate
+	}
+	return 0
+}
+
+type BurndownPoint struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Date              string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	OpenTasks         int32                  `protobuf:"varint,2,opt,name=open_tasks,json=openTasks,proto3" json:"open_tasks,omitempty"`
+	RemainingEstimate float64                `protobuf:"fixed64,3,opt,name=remaining_estimate,json=remainingEstimate,proto3" json:"remaining_estimate,omitempty"`
+	IdealEstimate     float64                `protobuf:"fixed64,4,opt,name=ideal_estimate,json=idealEstimate,proto3" json:"ideal_estimate,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BurndownPoint) Reset() {
+	*x = BurndownPoint{}
+	mi := &file_proto_task_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BurndownPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BurndownPoint) ProtoMessage() {}
+
+func (x *BurndownPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BurndownPoint.ProtoReflect.Descriptor instead.
+func (*BurndownPoint) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BurndownPoint) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *BurndownPoint) GetOpenTasks() int32 {
+	if x != nil {
+		return x.OpenTasks
+	}
+	return 0
+}
+
+func (x *BurndownPoint) GetRemainingEstimate() float64 {
+	if x != nil {
+		return x.RemainingEstimate
+	}
+	return 0
+}
+
+func (x *BurndownPoint) GetIdealEstimate() float64 {
+	if x != nil {
+		return x.IdealEstimate
+	}
+	return 0
+}
+
+type BurndownResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*BurndownPoint       `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BurndownResponse) Reset() {
+	*x = BurndownResponse{}
+	mi := &file_proto_task_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BurndownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BurndownResponse) ProtoMessage() {}
+
+func (x *BurndownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BurndownResponse.ProtoReflect.Descriptor instead.
+func (*BurndownResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BurndownResponse) GetPoints() []*BurndownPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type CumulativeFlowPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Counts        map[string]int32       `protobuf:"bytes,2,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CumulativeFlowPoint) Reset() {
+	*x = CumulativeFlowPoint{}
+	mi := &file_proto_task_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CumulativeFlowPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CumulativeFlowPoint) ProtoMessage() {}
+
+func (x *CumulativeFlowPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CumulativeFlowPoint.ProtoReflect.Descriptor instead.
+func (*CumulativeFlowPoint) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CumulativeFlowPoint) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CumulativeFlowPoint) GetCounts() map[string]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type CumulativeFlowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []string               `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	Points        []*CumulativeFlowPoint `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CumulativeFlowResponse) Reset() {
+	*x = CumulativeFlowResponse{}
+	mi := &file_proto_task_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CumulativeFlowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CumulativeFlowResponse) ProtoMessage() {}
+
+func (x *CumulativeFlowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CumulativeFlowResponse.ProtoReflect.Descriptor instead.
+func (*CumulativeFlowResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CumulativeFlowResponse) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *CumulativeFlowResponse) GetPoints() []*CumulativeFlowPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type SavedViewFilter struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Statuses   []string               `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	Priorities []string               `protobuf:"bytes,2,rep,name=priorities,proto3" json:"priorities,omitempty"`
+	Tags       []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Search     string                 `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
+	// относительные выражения: "today", "end_of_week", "now+3d"
+	DueFrom       string                  `protobuf:"bytes,5,opt,name=due_from,json=dueFrom,proto3" json:"due_from,omitempty"`
+	DueTo         string                  `protobuf:"bytes,6,opt,name=due_to,json=dueTo,proto3" json:"due_to,omitempty"`
+	Project       string                  `protobuf:"bytes,7,opt,name=project,proto3" json:"project,omitempty"`
+	CustomFields  []*CustomFieldCondition `protobuf:"bytes,8,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedViewFilter) Reset() {
+	*x = SavedViewFilter{}
+	mi := &file_proto_task_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedViewFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedViewFilter) ProtoMessage() {}
+
+func (x *SavedViewFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedViewFilter.ProtoReflect.Descriptor instead.
+func (*SavedViewFilter) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SavedViewFilter) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *SavedViewFilter) GetPriorities() []string {
+	if x != nil {
+		return x.Priorities
+	}
+	return nil
+}
+
+func (x *SavedViewFilter) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SavedViewFilter) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *SavedViewFilter) GetDueFrom() string {
+	if x != nil {
+		return x.DueFrom
+	}
+	return ""
+}
+
+func (x *SavedViewFilter) GetDueTo() string {
+	if x != nil {
+		return x.DueTo
+	}
+	return ""
+}
+
+func (x *SavedViewFilter) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *SavedViewFilter) GetCustomFields() []*CustomFieldCondition {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+type SavedViewSort struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Desc          bool                   `protobuf:"varint,2,opt,name=desc,proto3" json:"desc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedViewSort) Reset() {
+	*x = SavedViewSort{}
+	mi := &file_proto_task_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedViewSort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedViewSort) ProtoMessage() {}
+
+func (x *SavedViewSort) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedViewSort.ProtoReflect.Descriptor instead.
+func (*SavedViewSort) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SavedViewSort) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SavedViewSort) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+type SavedView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Filter        *SavedViewFilter       `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort          *SavedViewSort         `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedView) Reset() {
+	*x = SavedView{}
+	mi := &file_proto_task_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedView) ProtoMessage() {}
+
+func (x *SavedView) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedView.ProtoReflect.Descriptor instead.
+func (*SavedView) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SavedView) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SavedView) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SavedView) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedView) GetFilter() *SavedViewFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SavedView) GetSort() *SavedViewSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *SavedView) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SavedView) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SavedViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ViewId        string                 `protobuf:"bytes,1,opt,name=view_id,json=viewId,proto3" json:"view_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedViewRequest) Reset() {
+	*x = SavedViewRequest{}
+	mi := &file_proto_task_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedViewRequest) ProtoMessage() {}
+
+func (x *SavedViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedViewRequest.ProtoReflect.Descriptor instead.
+func (*SavedViewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SavedViewRequest) GetViewId() string {
+	if x != nil {
+		return x.ViewId
+	}
+	return ""
+}
+
+func (x *SavedViewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListSavedViewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedViewsRequest) Reset() {
+	*x = ListSavedViewsRequest{}
+	mi := &file_proto_task_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedViewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedViewsRequest) ProtoMessage() {}
+
+func (x *ListSavedViewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedViewsRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedViewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListSavedViewsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListSavedViewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Views         []*SavedView           `protobuf:"bytes,1,rep,name=views,proto3" json:"views,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedViewsResponse) Reset() {
+	*x = ListSavedViewsResponse{}
+	mi := &file_proto_task_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedViewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedViewsResponse) ProtoMessage() {}
+
+func (x *ListSavedViewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedViewsResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedViewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListSavedViewsResponse) GetViews() []*SavedView {
+	if x != nil {
+		return x.Views
+	}
+	return nil
+}
+
+func (x *ListSavedViewsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type DeleteSavedViewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedViewResponse) Reset() {
+	*x = DeleteSavedViewResponse{}
+	mi := &file_proto_task_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedViewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedViewResponse) ProtoMessage() {}
+
+func (x *DeleteSavedViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedViewResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSavedViewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteSavedViewResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SubtaskBlueprint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Priority      string                 `protobuf:"bytes,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	DueOffset     string                 `protobuf:"bytes,4,opt,name=due_offset,json=dueOffset,proto3" json:"due_offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubtaskBlueprint) Reset() {
+	*x = SubtaskBlueprint{}
+	mi := &file_proto_task_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubtaskBlueprint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubtaskBlueprint) ProtoMessage() {}
+
+func (x *SubtaskBlueprint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubtaskBlueprint.ProtoReflect.Descriptor instead.
+func (*SubtaskBlueprint) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SubtaskBlueprint) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SubtaskBlueprint) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SubtaskBlueprint) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *SubtaskBlueprint) GetDueOffset() string {
+	if x != nil {
+		return x.DueOffset
+	}
+	return ""
+}
+
+type TaskTemplate struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name   string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// title и description могут содержать плейсхолдеры вида {{client}}
+	Title       string   `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Priority    string   `protobuf:"bytes,6,opt,name=priority,proto3" json:"priority,omitempty"`
+	Tags        []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	// относительное выражение срока: "today+2d", "now+4h"
+	DueOffset     string                 `protobuf:"bytes,8,opt,name=due_offset,json=dueOffset,proto3" json:"due_offset,omitempty"`
+	Checklist     []string               `protobuf:"bytes,9,rep,name=checklist,proto3" json:"checklist,omitempty"`
+	Subtasks      []*SubtaskBlueprint    `protobuf:"bytes,10,rep,name=subtasks,proto3" json:"subtasks,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskTemplate) Reset() {
+	*x = TaskTemplate{}
+	mi := &file_proto_task_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTemplate) ProtoMessage() {}
+
+func (x *TaskTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTemplate.ProtoReflect.Descriptor instead.
+func (*TaskTemplate) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TaskTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TaskTemplate) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TaskTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskTemplate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TaskTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TaskTemplate) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *TaskTemplate) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TaskTemplate) GetDueOffset() string {
+	if x != nil {
+		return x.DueOffset
+	}
+	return ""
+}
+
+func (x *TaskTemplate) GetChecklist() []string {
+	if x != nil {
+		return x.Checklist
+	}
+	return nil
+}
+
+func (x *TaskTemplate) GetSubtasks() []*SubtaskBlueprint {
+	if x != nil {
+		return x.Subtasks
+	}
+	return nil
+}
+
+func (x *TaskTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TaskTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type TaskTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskTemplateRequest) Reset() {
+	*x = TaskTemplateRequest{}
+	mi := &file_proto_task_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTemplateRequest) ProtoMessage() {}
+
+func (x *TaskTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTemplateRequest.ProtoReflect.Descriptor instead.
+func (*TaskTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TaskTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *TaskTemplateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListTaskTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskTemplatesRequest) Reset() {
+	*x = ListTaskTemplatesRequest{}
+	mi := &file_proto_task_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTaskTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListTaskTemplatesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListTaskTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*TaskTemplate        `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskTemplatesResponse) Reset() {
+	*x = ListTaskTemplatesResponse{}
+	mi := &file_proto_task_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTaskTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListTaskTemplatesResponse) GetTemplates() []*TaskTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *ListTaskTemplatesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type DeleteTaskTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskTemplateResponse) Reset() {
+	*x = DeleteTaskTemplateResponse{}
+	mi := &file_proto_task_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteTaskTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaskTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteTaskTemplateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type InstantiateTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Variables     map[string]string      `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstantiateTemplateRequest) Reset() {
+	*x = InstantiateTemplateRequest{}
+	mi := &file_proto_task_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstantiateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstantiateTemplateRequest) ProtoMessage() {}
+
+func (x *InstantiateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstantiateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*InstantiateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *InstantiateTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *InstantiateTemplateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InstantiateTemplateRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *InstantiateTemplateRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type InstantiateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Subtasks      []*Task                `protobuf:"bytes,2,rep,name=subtasks,proto3" json:"subtasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstantiateTemplateResponse) Reset() {
+	*x = InstantiateTemplateResponse{}
+	mi := &file_proto_task_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstantiateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstantiateTemplateResponse) ProtoMessage() {}
+
+func (x *InstantiateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstantiateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*InstantiateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *InstantiateTemplateResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *InstantiateTemplateResponse) GetSubtasks() []*Task {
+	if x != nil {
+		return x.Subtasks
+	}
+	return nil
+}
+
+type CustomFieldDefinition struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// пустой project - поле действует во всех проектах пользователя
+	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	Key     string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Name    string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// text, number, date, single_select, multi_select
+	Type          string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	Options       []string               `protobuf:"bytes,7,rep,name=options,proto3" json:"options,omitempty"`
+	Required      bool                   `protobuf:"varint,8,opt,name=required,proto3" json:"required,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomFieldDefinition) Reset() {
+	*x = CustomFieldDefinition{}
+	mi := &file_proto_task_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomFieldDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomFieldDefinition) ProtoMessage() {}
+
+func (x *CustomFieldDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomFieldDefinition.ProtoReflect.Descriptor instead.
+func (*CustomFieldDefinition) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CustomFieldDefinition) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CustomFieldDefinition) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CustomFieldDefinition) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *CustomFieldDefinition) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CustomFieldDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomFieldDefinition) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CustomFieldDefinition) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CustomFieldDefinition) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *CustomFieldDefinition) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CustomFieldDefinition) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CustomFieldCondition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// eq, gt, gte, lt, lte
+	Op            string `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Value         string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomFieldCondition) Reset() {
+	*x = CustomFieldCondition{}
+	mi := &file_proto_task_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomFieldCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomFieldCondition) ProtoMessage() {}
+
+func (x *CustomFieldCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomFieldCondition.ProtoReflect.Descriptor instead.
+func (*CustomFieldCondition) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CustomFieldCondition) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CustomFieldCondition) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *CustomFieldCondition) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type CustomFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldId       string                 `protobuf:"bytes,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomFieldRequest) Reset() {
+	*x = CustomFieldRequest{}
+	mi := &file_proto_task_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomFieldRequest) ProtoMessage() {}
+
+func (x *CustomFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomFieldRequest.ProtoReflect.Descriptor instead.
+func (*CustomFieldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CustomFieldRequest) GetFieldId() string {
+	if x != nil {
+		return x.FieldId
+	}
+	return ""
+}
+
+func (x *CustomFieldRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListCustomFieldsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// фильтр по проекту; пустой - все определения пользователя
+	Project       string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomFieldsRequest) Reset() {
+	*x = ListCustomFieldsRequest{}
+	mi := &file_proto_task_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomFieldsRequest) ProtoMessage() {}
+
+func (x *ListCustomFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomFieldsRequest.ProtoReflect.Descriptor instead.
+func (*ListCustomFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListCustomFieldsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListCustomFieldsRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+type ListCustomFieldsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Fields        []*CustomFieldDefinition `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	Total         int32                    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomFieldsResponse) Reset() {
+	*x = ListCustomFieldsResponse{}
+	mi := &file_proto_task_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomFieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomFieldsResponse) ProtoMessage() {}
+
+func (x *ListCustomFieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomFieldsResponse.ProtoReflect.Descriptor instead.
+func (*ListCustomFieldsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListCustomFieldsResponse) GetFields() []*CustomFieldDefinition {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ListCustomFieldsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type DeleteCustomFieldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomFieldResponse) Reset() {
+	*x = DeleteCustomFieldResponse{}
+	mi := &file_proto_task_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomFieldResponse) ProtoMessage() {}
+
+func (x *DeleteCustomFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomFieldResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCustomFieldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteCustomFieldResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SLAPolicy struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Priority          string                 `protobuf:"bytes,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	ResponseMinutes   int32                  `protobuf:"varint,2,opt,name=response_minutes,json=responseMinutes,proto3" json:"response_minutes,omitempty"`
+	ResolutionMinutes int32                  `protobuf:"varint,3,opt,name=resolution_minutes,json=resolutionMinutes,proto3" json:"resolution_minutes,omitempty"`
+	// доля цели, после которой задача считается под угрозой (0..1)
+	AtRiskThreshold float64                `protobuf:"fixed64,4,opt,name=at_risk_threshold,json=atRiskThreshold,proto3" json:"at_risk_threshold,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SLAPolicy) Reset() {
+	*x = SLAPolicy{}
+	mi := &file_proto_task_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLAPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLAPolicy) ProtoMessage() {}
+
+func (x *SLAPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLAPolicy.ProtoReflect.Descriptor instead.
+func (*SLAPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SLAPolicy) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *SLAPolicy) GetResponseMinutes() int32 {
+	if x != nil {
+		return x.ResponseMinutes
+	}
+	return 0
+}
+
+func (x *SLAPolicy) GetResolutionMinutes() int32 {
+	if x != nil {
+		return x.ResolutionMinutes
+	}
+	return 0
+}
+
+func (x *SLAPolicy) GetAtRiskThreshold() float64 {
+	if x != nil {
+		return x.AtRiskThreshold
+	}
+	return 0
+}
+
+func (x *SLAPolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListSLAPoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSLAPoliciesRequest) Reset() {
+	*x = ListSLAPoliciesRequest{}
+	mi := &file_proto_task_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSLAPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSLAPoliciesRequest) ProtoMessage() {}
+
+func (x *ListSLAPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSLAPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListSLAPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{43}
+}
+
+type ListSLAPoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*SLAPolicy           `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSLAPoliciesResponse) Reset() {
+	*x = ListSLAPoliciesResponse{}
+	mi := &file_proto_task_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSLAPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSLAPoliciesResponse) ProtoMessage() {}
+
+func (x *ListSLAPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSLAPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListSLAPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListSLAPoliciesResponse) GetPolicies() []*SLAPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+// Webhook - подписка на события задач; secret заполняется только при создании и смене
+type Webhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Project       string                 `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,5,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Secret        string                 `protobuf:"bytes,6,opt,name=secret,proto3" json:"secret,omitempty"`
+	Active        bool                   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_proto_task_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Webhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Webhook) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Webhook) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Webhook) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Webhook) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type WebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookRequest) Reset() {
+	*x = WebhookRequest{}
+	mi := &file_proto_task_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookRequest) ProtoMessage() {}
+
+func (x *WebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookRequest.ProtoReflect.Descriptor instead.
+func (*WebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *WebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *WebhookRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_proto_task_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListWebhooksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_proto_task_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *ListWebhooksResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_proto_task_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteWebhookResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type WebhookDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscriptionId string                 `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	EventId        string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType      string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// JSON полезной нагрузки события
+	Payload        string                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts       int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	ResponseStatus int32                  `protobuf:"varint,8,opt,name=response_status,json=responseStatus,proto3" json:"response_status,omitempty"`
+	ResponseBody   string                 `protobuf:"bytes,9,opt,name=response_body,json=responseBody,proto3" json:"response_body,omitempty"`
+	LastError      string                 `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	DurationMs     int64                  `protobuf:"varint,11,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	NextAttemptAt  *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	DeliveredAt    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_proto_task_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetResponseStatus() int32 {
+	if x != nil {
+		return x.ResponseStatus
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetResponseBody() string {
+	if x != nil {
+		return x.ResponseBody
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_proto_task_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_proto_task_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RedeliverWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeliveryId    string                 `protobuf:"bytes,3,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookRequest) Reset() {
+	*x = RedeliverWebhookRequest{}
+	mi := &file_proto_task_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookRequest) ProtoMessage() {}
+
+func (x *RedeliverWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RedeliverWebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *RedeliverWebhookRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RedeliverWebhookRequest) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+// Notification - уведомление в приложении: assignment, comment, mention, reminder, sla_breach
+type Notification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	TaskId        string                 `protobuf:"bytes,6,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ReadAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=read_at,json=readAt,proto3" json:"read_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_proto_task_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Notification) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Notification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Notification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Notification) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *Notification) GetReadAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadAt
+	}
+	return nil
+}
+
+func (x *Notification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UnreadOnly    bool                   `protobuf:"varint,2,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_proto_task_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListNotificationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+func (x *ListNotificationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	UnreadCount   int32                  `protobuf:"varint,3,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	mi := &file_proto_task_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListNotificationsResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type MarkReadRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NotificationId string                 `protobuf:"bytes,2,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MarkReadRequest) Reset() {
+	*x = MarkReadRequest{}
+	mi := &file_proto_task_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReadRequest) ProtoMessage() {}
+
+func (x *MarkReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *MarkReadRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MarkReadRequest) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+type MarkAllReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllReadRequest) Reset() {
+	*x = MarkAllReadRequest{}
+	mi := &file_proto_task_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllReadRequest) ProtoMessage() {}
+
+func (x *MarkAllReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAllReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *MarkAllReadRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type MarkReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Updated       int32                  `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	UnreadCount   int32                  `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkReadResponse) Reset() {
+	*x = MarkReadResponse{}
+	mi := &file_proto_task_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReadResponse) ProtoMessage() {}
+
+func (x *MarkReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkReadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *MarkReadResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *MarkReadResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type GetNextTasksRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	UserId  string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Project string                 `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	Limit   int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// веса факторов по имени: priority, due_date, sla, blocking, age; пропущенные - значения по умолчанию
+	Weights       map[string]float64 `protobuf:"bytes,4,rep,name=weights,proto3" json:"weights,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNextTasksRequest) Reset() {
+	*x = GetNextTasksRequest{}
+	mi := &file_proto_task_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNextTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextTasksRequest) ProtoMessage() {}
+
+func (x *GetNextTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextTasksRequest.ProtoReflect.Descriptor instead.
+func (*GetNextTasksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetNextTasksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetNextTasksRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *GetNextTasksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetNextTasksRequest) GetWeights() map[string]float64 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+// ScoreFactor - вклад фактора в оценку: contribution = weight * value
+type ScoreFactor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Factor        string                 `protobuf:"bytes,1,opt,name=factor,proto3" json:"factor,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Weight        float64                `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Contribution  float64                `protobuf:"fixed64,4,opt,name=contribution,proto3" json:"contribution,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreFactor) Reset() {
+	*x = ScoreFactor{}
+	mi := &file_proto_task_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreFactor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreFactor) ProtoMessage() {}
+
+func (x *ScoreFactor) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreFactor.ProtoReflect.Descriptor instead.
+func (*ScoreFactor) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ScoreFactor) GetFactor() string {
+	if x != nil {
+		return x.Factor
+	}
+	return ""
+}
+
+func (x *ScoreFactor) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ScoreFactor) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ScoreFactor) GetContribution() float64 {
+	if x != nil {
+		return x.Contribution
+	}
+	return 0
+}
+
+func (x *ScoreFactor) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ScoredTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Factors       []*ScoreFactor         `protobuf:"bytes,3,rep,name=factors,proto3" json:"factors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoredTask) Reset() {
+	*x = ScoredTask{}
+	mi := &file_proto_task_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoredTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoredTask) ProtoMessage() {}
+
+func (x *ScoredTask) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoredTask.ProtoReflect.Descriptor instead.
+func (*ScoredTask) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ScoredTask) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *ScoredTask) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ScoredTask) GetFactors() []*ScoreFactor {
+	if x != nil {
+		return x.Factors
+	}
+	return nil
+}
+
+type GetNextTasksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Tasks []*ScoredTask          `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	// веса, с которыми посчитаны оценки
+	Weights       map[string]float64 `protobuf:"bytes,2,rep,name=weights,proto3" json:"weights,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNextTasksResponse) Reset() {
+	*x = GetNextTasksResponse{}
+	mi := &file_proto_task_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNextTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextTasksResponse) ProtoMessage() {}
+
+func (x *GetNextTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextTasksResponse.ProtoReflect.Descriptor instead.
+func (*GetNextTasksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetNextTasksResponse) GetTasks() []*ScoredTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *GetNextTasksResponse) GetWeights() map[string]float64 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+// Пороги классификации задач по матрице Эйзенхауэра; пустые поля - значения по умолчанию
+type MatrixThresholds struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// минимальный приоритет важной задачи
+	ImportantPriority string `protobuf:"bytes,1,opt,name=important_priority,json=importantPriority,proto3" json:"important_priority,omitempty"`
+	// задача срочная, если до срока осталось не больше указанного числа часов
+	UrgentWithinHours int32 `protobuf:"varint,2,opt,name=urgent_within_hours,json=urgentWithinHours,proto3" json:"urgent_within_hours,omitempty"`
+	// минимальный статус SLA срочной задачи: AT_RISK или BREACHED
+	UrgentSlaStatus string `protobuf:"bytes,3,opt,name=urgent_sla_status,json=urgentSlaStatus,proto3" json:"urgent_sla_status,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MatrixThresholds) Reset() {
+	*x = MatrixThresholds{}
+	mi := &file_proto_task_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatrixThresholds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatrixThresholds) ProtoMessage() {}
+
+func (x *MatrixThresholds) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatrixThresholds.ProtoReflect.Descriptor instead.
+func (*MatrixThresholds) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *MatrixThresholds) GetImportantPriority() string {
+	if x != nil {
+		return x.ImportantPriority
+	}
+	return ""
+}
+
+func (x *MatrixThresholds) GetUrgentWithinHours() int32 {
+	if x != nil {
+		return x.UrgentWithinHours
+	}
+	return 0
+}
+
+func (x *MatrixThresholds) GetUrgentSlaStatus() string {
+	if x != nil {
+		return x.UrgentSlaStatus
+	}
+	return ""
+}
+
+type GetEisenhowerMatrixRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Project       string                 `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	Thresholds    *MatrixThresholds      `protobuf:"bytes,3,opt,name=thresholds,proto3" json:"thresholds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEisenhowerMatrixRequest) Reset() {
+	*x = GetEisenhowerMatrixRequest{}
+	mi := &file_proto_task_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEisenhowerMatrixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEisenhowerMatrixRequest) ProtoMessage() {}
+
+func (x *GetEisenhowerMatrixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEisenhowerMatrixRequest.ProtoReflect.Descriptor instead.
+func (*GetEisenhowerMatrixRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetEisenhowerMatrixRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetEisenhowerMatrixRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *GetEisenhowerMatrixRequest) GetThresholds() *MatrixThresholds {
+	if x != nil {
+		return x.Thresholds
+	}
+	return nil
+}
+
+type MatrixTask struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Task      *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Quadrant  string                 `protobuf:"bytes,2,opt,name=quadrant,proto3" json:"quadrant,omitempty"`
+	Important bool                   `protobuf:"varint,3,opt,name=important,proto3" json:"important,omitempty"`
+	Urgent    bool                   `protobuf:"varint,4,opt,name=urgent,proto3" json:"urgent,omitempty"`
+	// квадрант задан пользователем вручную
+	Overridden    bool   `protobuf:"varint,5,opt,name=overridden,proto3" json:"overridden,omitempty"`
+	Reason        string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatrixTask) Reset() {
+	*x = MatrixTask{}
+	mi := &file_proto_task_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatrixTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatrixTask) ProtoMessage() {}
+
+func (x *MatrixTask) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatrixTask.ProtoReflect.Descriptor instead.
+func (*MatrixTask) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *MatrixTask) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *MatrixTask) GetQuadrant() string {
+	if x != nil {
+		return x.Quadrant
+	}
+	return ""
+}
+
+func (x *MatrixTask) GetImportant() bool {
+	if x != nil {
+		return x.Important
+	}
+	return false
+}
+
+func (x *MatrixTask) GetUrgent() bool {
+	if x != nil {
+		return x.Urgent
+	}
+	return false
+}
+
+func (x *MatrixTask) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
+func (x *MatrixTask) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetEisenhowerMatrixResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	DoFirst   []*MatrixTask          `protobuf:"bytes,1,rep,name=do_first,json=doFirst,proto3" json:"do_first,omitempty"`
+	Schedule  []*MatrixTask          `protobuf:"bytes,2,rep,name=schedule,proto3" json:"schedule,omitempty"`
+	Delegate  []*MatrixTask          `protobuf:"bytes,3,rep,name=delegate,proto3" json:"delegate,omitempty"`
+	Eliminate []*MatrixTask          `protobuf:"bytes,4,rep,name=eliminate,proto3" json:"eliminate,omitempty"`
+	// примененные пороги с учетом значений по умолчанию
+	Thresholds    *MatrixThresholds `protobuf:"bytes,5,opt,name=thresholds,proto3" json:"thresholds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEisenhowerMatrixResponse) Reset() {
+	*x = GetEisenhowerMatrixResponse{}
+	mi := &file_proto_task_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEisenhowerMatrixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEisenhowerMatrixResponse) ProtoMessage() {}
+
+func (x *GetEisenhowerMatrixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEisenhowerMatrixResponse.ProtoReflect.Descriptor instead.
+func (*GetEisenhowerMatrixResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetEisenhowerMatrixResponse) GetDoFirst() []*MatrixTask {
+	if x != nil {
+		return x.DoFirst
+	}
+	return nil
+}
+
+func (x *GetEisenhowerMatrixResponse) GetSchedule() []*MatrixTask {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *GetEisenhowerMatrixResponse) GetDelegate() []*MatrixTask {
+	if x != nil {
+		return x.Delegate
+	}
+	return nil
+}
+
+func (x *GetEisenhowerMatrixResponse) GetEliminate() []*MatrixTask {
+	if x != nil {
+		return x.Eliminate
+	}
+	return nil
+}
+
+func (x *GetEisenhowerMatrixResponse) GetThresholds() *MatrixThresholds {
+	if x != nil {
+		return x.Thresholds
+	}
+	return nil
+}
+
+// SetQuadrantOverrideRequest закрепляет задачу в квадранте; пустой quadrant снимает закрепление
+type SetQuadrantOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Quadrant      string                 `protobuf:"bytes,3,opt,name=quadrant,proto3" json:"quadrant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetQuadrantOverrideRequest) Reset() {
+	*x = SetQuadrantOverrideRequest{}
+	mi := &file_proto_task_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQuadrantOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQuadrantOverrideRequest) ProtoMessage() {}
+
+func (x *SetQuadrantOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQuadrantOverrideRequest.ProtoReflect.Descriptor instead.
+func (*SetQuadrantOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SetQuadrantOverrideRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetQuadrantOverrideRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *SetQuadrantOverrideRequest) GetQuadrant() string {
+	if x != nil {
+		return x.Quadrant
+	}
+	return ""
+}
+
+type SetQuadrantOverrideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Quadrant      string                 `protobuf:"bytes,2,opt,name=quadrant,proto3" json:"quadrant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetQuadrantOverrideResponse) Reset() {
+	*x = SetQuadrantOverrideResponse{}
+	mi := &file_proto_task_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQuadrantOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQuadrantOverrideResponse) ProtoMessage() {}
+
+func (x *SetQuadrantOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQuadrantOverrideResponse.ProtoReflect.Descriptor instead.
+func (*SetQuadrantOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SetQuadrantOverrideResponse) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *SetQuadrantOverrideResponse) GetQuadrant() string {
+	if x != nil {
+		return x.Quadrant
+	}
+	return ""
+}
+
+// SnoozeTaskRequest откладывает задачу; пустой until возвращает ее в список сразу
+type SnoozeTaskRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TaskId string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// RFC3339 или относительное выражение: "now+2h", "tomorrow+9h", "start_of_week+7d"
+	Until string `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	// часовой пояс для относительного выражения
+	Timezone      string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnoozeTaskRequest) Reset() {
+	*x = SnoozeTaskRequest{}
+	mi := &file_proto_task_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnoozeTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnoozeTaskRequest) ProtoMessage() {}
+
+func (x *SnoozeTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnoozeTaskRequest.ProtoReflect.Descriptor instead.
+func (*SnoozeTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SnoozeTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *SnoozeTaskRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SnoozeTaskRequest) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+func (x *SnoozeTaskRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// GetCalendarRequest - задачи за диапазон локальных дат [from, to] включительно в формате YYYY-MM-DD
+type GetCalendarRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	From   string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To     string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// часовой пояс, в котором считаются дни
+	Timezone      string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Project       string `protobuf:"bytes,5,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarRequest) Reset() {
+	*x = GetCalendarRequest{}
+	mi := &file_proto_task_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarRequest) ProtoMessage() {}
+
+func (x *GetCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetCalendarRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetCalendarRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetCalendarRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetCalendarRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetCalendarRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+// CalendarEntry - событие задачи в календаре: срок (due) или начало (start)
+type CalendarEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	Task          *Task                  `protobuf:"bytes,3,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarEntry) Reset() {
+	*x = CalendarEntry{}
+	mi := &file_proto_task_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarEntry) ProtoMessage() {}
+
+func (x *CalendarEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarEntry.ProtoReflect.Descriptor instead.
+func (*CalendarEntry) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CalendarEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CalendarEntry) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *CalendarEntry) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type CalendarDay struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// локальная дата YYYY-MM-DD
+	Date          string           `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Entries       []*CalendarEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarDay) Reset() {
+	*x = CalendarDay{}
+	mi := &file_proto_task_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarDay) ProtoMessage() {}
+
+func (x *CalendarDay) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarDay.ProtoReflect.Descriptor instead.
+func (*CalendarDay) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CalendarDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CalendarDay) GetEntries() []*CalendarEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type GetCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Timezone      string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Days          []*CalendarDay         `protobuf:"bytes,4,rep,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarResponse) Reset() {
+	*x = GetCalendarResponse{}
+	mi := &file_proto_task_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarResponse) ProtoMessage() {}
+
+func (x *GetCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetCalendarResponse) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetCalendarResponse) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetCalendarResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetCalendarResponse) GetDays() []*CalendarDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+type ChecklistProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Done          int32                  `protobuf:"varint,1,opt,name=done,proto3" json:"done,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistProgress) Reset() {
+	*x = ChecklistProgress{}
+	mi := &file_proto_task_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistProgress) ProtoMessage() {}
+
+func (x *ChecklistProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistProgress.ProtoReflect.Descriptor instead.
+func (*ChecklistProgress) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ChecklistProgress) GetDone() int32 {
+	if x != nil {
+		return x.Done
+	}
+	return 0
+}
+
+func (x *ChecklistProgress) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ChecklistItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Done          bool                   `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	Position      int32                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistItem) Reset() {
+	*x = ChecklistItem{}
+	mi := &file_proto_task_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistItem) ProtoMessage() {}
+
+func (x *ChecklistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistItem.ProtoReflect.Descriptor instead.
+func (*ChecklistItem) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ChecklistItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *ChecklistItem) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ChecklistItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ChecklistItem) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ChecklistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistRequest) Reset() {
+	*x = ChecklistRequest{}
+	mi := &file_proto_task_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistRequest) ProtoMessage() {}
+
+func (x *ChecklistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistRequest.ProtoReflect.Descriptor instead.
+func (*ChecklistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ChecklistRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ChecklistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AddChecklistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddChecklistItemRequest) Reset() {
+	*x = AddChecklistItemRequest{}
+	mi := &file_proto_task_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddChecklistItemRequest) ProtoMessage() {}
+
+func (x *AddChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*AddChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AddChecklistItemRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *AddChecklistItemRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddChecklistItemRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// ChecklistItemRequest - операция над одним пунктом: отметка, удаление или превращение в задачу
+type ChecklistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ItemId        string                 `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistItemRequest) Reset() {
+	*x = ChecklistItemRequest{}
+	mi := &file_proto_task_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistItemRequest) ProtoMessage() {}
+
+func (x *ChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*ChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ChecklistItemRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ChecklistItemRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChecklistItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+// ReorderChecklistRequest - новый порядок, item_ids перечисляет все пункты чек-листа
+type ReorderChecklistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ItemIds       []string               `protobuf:"bytes,3,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderChecklistRequest) Reset() {
+	*x = ReorderChecklistRequest{}
+	mi := &file_proto_task_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderChecklistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderChecklistRequest) ProtoMessage() {}
+
+func (x *ReorderChecklistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderChecklistRequest.ProtoReflect.Descriptor instead.
+func (*ReorderChecklistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ReorderChecklistRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ReorderChecklistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReorderChecklistRequest) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+// ChecklistResponse - чек-лист после операции
+type ChecklistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ChecklistItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Progress      *ChecklistProgress     `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistResponse) Reset() {
+	*x = ChecklistResponse{}
+	mi := &file_proto_task_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistResponse) ProtoMessage() {}
+
+func (x *ChecklistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistResponse.ProtoReflect.Descriptor instead.
+func (*ChecklistResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ChecklistResponse) GetItems() []*ChecklistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ChecklistResponse) GetProgress() *ChecklistProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+// CloneTaskRequest копирует задачу с тегами и чек-листом; возвращается новый корень
+type CloneTaskRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TaskId string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// копировать все дерево подзадач
+	IncludeSubtasks bool `protobuf:"varint,3,opt,name=include_subtasks,json=includeSubtasks,proto3" json:"include_subtasks,omitempty"`
+	// сбросить статусы копий и отметки чек-листов в PENDING
+	ResetStatus bool `protobuf:"varint,4,opt,name=reset_status,json=resetStatus,proto3" json:"reset_status,omitempty"`
+	// сдвиг сроков и дат начала: "+7d", "-2h", "+1w"
+	ShiftDueBy string `protobuf:"bytes,5,opt,name=shift_due_by,json=shiftDueBy,proto3" json:"shift_due_by,omitempty"`
+	// часовой пояс, в котором сдвигаются дни и недели
+	Timezone      string `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneTaskRequest) Reset() {
+	*x = CloneTaskRequest{}
+	mi := &file_proto_task_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneTaskRequest) ProtoMessage() {}
+
+func (x *CloneTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneTaskRequest.ProtoReflect.Descriptor instead.
+func (*CloneTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CloneTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *CloneTaskRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CloneTaskRequest) GetIncludeSubtasks() bool {
+	if x != nil {
+		return x.IncludeSubtasks
+	}
+	return false
+}
+
+func (x *CloneTaskRequest) GetResetStatus() bool {
+	if x != nil {
+		return x.ResetStatus
+	}
+	return false
+}
+
+func (x *CloneTaskRequest) GetShiftDueBy() string {
+	if x != nil {
+		return x.ShiftDueBy
+	}
+	return ""
+}
+
+func (x *CloneTaskRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// TaskShare - доступ пользователя grantee_id к задаче: READ или EDIT
+type TaskShare struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	GranteeId     string                 `protobuf:"bytes,2,opt,name=grantee_id,json=granteeId,proto3" json:"grantee_id,omitempty"`
+	Permission    string                 `protobuf:"bytes,3,opt,name=permission,proto3" json:"permission,omitempty"`
+	GrantedBy     string                 `protobuf:"bytes,4,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskShare) Reset() {
+	*x = TaskShare{}
+	mi := &file_proto_task_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskShare) ProtoMessage() {}
+
+func (x *TaskShare) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskShare.ProtoReflect.Descriptor instead.
+func (*TaskShare) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *TaskShare) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskShare) GetGranteeId() string {
+	if x != nil {
+		return x.GranteeId
+	}
+	return ""
+}
+
+func (x *TaskShare) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *TaskShare) GetGrantedBy() string {
+	if x != nil {
+		return x.GrantedBy
+	}
+	return ""
+}
+
+func (x *TaskShare) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TaskShare) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ShareTaskRequest выдает доступ или меняет его уровень; пустой permission означает READ
+type ShareTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GranteeId     string                 `protobuf:"bytes,3,opt,name=grantee_id,json=granteeId,proto3" json:"grantee_id,omitempty"`
+	Permission    string                 `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareTaskRequest) Reset() {
+	*x = ShareTaskRequest{}
+	mi := &file_proto_task_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareTaskRequest) ProtoMessage() {}
+
+func (x *ShareTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareTaskRequest.ProtoReflect.Descriptor instead.
+func (*ShareTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ShareTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ShareTaskRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ShareTaskRequest) GetGranteeId() string {
+	if x != nil {
+		return x.GranteeId
+	}
+	return ""
+}
+
+func (x *ShareTaskRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+// UnshareTaskRequest отзывает доступ; получатель может отозвать свой доступ сам
+type UnshareTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GranteeId     string                 `protobuf:"bytes,3,opt,name=grantee_id,json=granteeId,proto3" json:"grantee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnshareTaskRequest) Reset() {
+	*x = UnshareTaskRequest{}
+	mi := &file_proto_task_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnshareTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareTaskRequest) ProtoMessage() {}
+
+func (x *UnshareTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareTaskRequest.ProtoReflect.Descriptor instead.
+func (*UnshareTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *UnshareTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *UnshareTaskRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnshareTaskRequest) GetGranteeId() string {
+	if x != nil {
+		return x.GranteeId
+	}
+	return ""
+}
+
+type UnshareTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnshareTaskResponse) Reset() {
+	*x = UnshareTaskResponse{}
+	mi := &file_proto_task_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnshareTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareTaskResponse) ProtoMessage() {}
+
+func (x *UnshareTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareTaskResponse.ProtoReflect.Descriptor instead.
+func (*UnshareTaskResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{86}
+}
+
+type ListSharesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharesRequest) Reset() {
+	*x = ListSharesRequest{}
+	mi := &file_proto_task_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharesRequest) ProtoMessage() {}
+
+func (x *ListSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListSharesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListSharesRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ListSharesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListSharesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shares        []*TaskShare           `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharesResponse) Reset() {
+	*x = ListSharesResponse{}
+	mi := &file_proto_task_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharesResponse) ProtoMessage() {}
+
+func (x *ListSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListSharesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListSharesResponse) GetShares() []*TaskShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+// PublicLink - ссылка на задачу только для чтения; token заполнен только в ответе CreatePublicLink
+type PublicLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	ViewCount     int64                  `protobuf:"varint,6,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	LastViewedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_viewed_at,json=lastViewedAt,proto3" json:"last_viewed_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublicLink) Reset() {
+	*x = PublicLink{}
+	mi := &file_proto_task_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicLink) ProtoMessage() {}
+
+func (x *PublicLink) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicLink.ProtoReflect.Descriptor instead.
+func (*PublicLink) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *PublicLink) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PublicLink) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *PublicLink) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *PublicLink) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *PublicLink) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *PublicLink) GetViewCount() int64 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *PublicLink) GetLastViewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastViewedAt
+	}
+	return nil
+}
+
+func (x *PublicLink) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreatePublicLinkRequest: expires_at - RFC3339 или выражение вроде "now+7d", пустой - неделя
+type CreatePublicLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePublicLinkRequest) Reset() {
+	*x = CreatePublicLinkRequest{}
+	mi := &file_proto_task_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePublicLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePublicLinkRequest) ProtoMessage() {}
+
+func (x *CreatePublicLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePublicLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreatePublicLinkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *CreatePublicLinkRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *CreatePublicLinkRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreatePublicLinkRequest) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *CreatePublicLinkRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type ListPublicLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPublicLinksRequest) Reset() {
+	*x = ListPublicLinksRequest{}
+	mi := &file_proto_task_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPublicLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPublicLinksRequest) ProtoMessage() {}
+
+func (x *ListPublicLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPublicLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListPublicLinksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListPublicLinksRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ListPublicLinksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListPublicLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         []*PublicLink          `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPublicLinksResponse) Reset() {
+	*x = ListPublicLinksResponse{}
+	mi := &file_proto_task_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPublicLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPublicLinksResponse) ProtoMessage() {}
+
+func (x *ListPublicLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPublicLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListPublicLinksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListPublicLinksResponse) GetLinks() []*PublicLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type RevokePublicLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LinkId        string                 `protobuf:"bytes,3,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokePublicLinkRequest) Reset() {
+	*x = RevokePublicLinkRequest{}
+	mi := &file_proto_task_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokePublicLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokePublicLinkRequest) ProtoMessage() {}
+
+func (x *RevokePublicLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokePublicLinkRequest.ProtoReflect.Descriptor instead.
+func (*RevokePublicLinkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *RevokePublicLinkRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *RevokePublicLinkRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RevokePublicLinkRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+// GetPublicTaskRequest вызывается шлюзом без пользователя: доступ дает только токен
+type GetPublicTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicTaskRequest) Reset() {
+	*x = GetPublicTaskRequest{}
+	mi := &file_proto_task_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicTaskRequest) ProtoMessage() {}
+
+func (x *GetPublicTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetPublicTaskRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// PublicTask - поля задачи, видимые по публичной ссылке
+type PublicTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Priority      string                 `protobuf:"bytes,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Checklist     *ChecklistProgress     `protobuf:"bytes,8,opt,name=checklist,proto3" json:"checklist,omitempty"`
+	LinkExpiresAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=link_expires_at,json=linkExpiresAt,proto3" json:"link_expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublicTask) Reset() {
+	*x = PublicTask{}
+	mi := &file_proto_task_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicTask) ProtoMessage() {}
+
+func (x *PublicTask) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicTask.ProtoReflect.Descriptor instead.
+func (*PublicTask) Descriptor() ([]byte, []int) {
+	return file_proto_task_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *PublicTask) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PublicTask) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PublicTask) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PublicTask) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *PublicTask) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *PublicTask) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *PublicTask) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PublicTask) GetChecklist() *ChecklistProgress {
+	if x != nil {
+		return x.Checklist
+	}
+	return nil
+}
+
+func (x *PublicTask) GetLinkExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkExpiresAt
+	}
+	return nil
+}
+
+var File_proto_task_proto protoreflect.FileDescriptor
+
+const file_proto_task_proto_rawDesc = "" +
+	"\n" +
+	"\x10proto/task.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xfa\x06\n" +
+	"\x04Task\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\tR\bpriority\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x17\n" +
+	"\auser_id\x18\x06 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x125\n" +
+	"\bdue_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12\x12\n" +
+	"\x04tags\x18\n" +
+	" \x03(\tR\x04tags\x12\x1a\n" +
+	"\bestimate\x18\v \x01(\x01R\bestimate\x12\x1b\n" +
+	"\tparent_id\x18\f \x01(\tR\bparentId\x12\x18\n" +
+	"\aproject\x18\r \x01(\tR\aproject\x12<\n" +
+	"\rcustom_fields\x18\x0e \x01(\v2\x17.google.protobuf.StructR\fcustomFields\x12I\n" +
+	"\x13sla_response_due_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\x10slaResponseDueAt\x128\n" +
+	"\n" +
+	"sla_due_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\bslaDueAt\x12=\n" +
+	"\fresponded_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\vrespondedAt\x12\x1d\n" +
+	"\n" +
+	"sla_status\x18\x12 \x01(\tR\tslaStatus\x125\n" +
+	"\bstart_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x12?\n" +
+	"\rsnoozed_until\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\fsnoozedUntil\x120\n" +
+	"\tchecklist\x18\x15 \x01(\v2\x12.ChecklistProgressR\tchecklist\"\xcb\x01\n" +
+	"\x11CreateTaskRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\tR\bpriority\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x125\n" +
+	"\bdue_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\"B\n" +
+	"\x0eGetTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xbd\x02\n" +
+	"\x10ListTasksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\aview_id\x18\x02 \x01(\tR\x06viewId\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12\x18\n" +
+	"\aproject\x18\x04 \x01(\tR\aproject\x12:\n" +
+	"\rcustom_fields\x18\x05 \x03(\v2\x15.CustomFieldConditionR\fcustomFields\x12\x17\n" +
+	"\asort_by\x18\x06 \x01(\tR\x06sortBy\x12\x1b\n" +
+	"\tsort_desc\x18\a \x01(\bR\bsortDesc\x12)\n" +
+	"\x10include_deferred\x18\b \x01(\bR\x0fincludeDeferred\x12$\n" +
+	"\x0eshared_with_me\x18\t \x01(\bR\fsharedWithMe\"F\n" +
+	"\x11ListTasksResponse\x12\x1b\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x05.TaskR\x05tasks\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xae\x03\n" +
+	"\x11UpdateTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x125\n" +
+	"\bdue_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12\x1f\n" +
+	"\bestimate\x18\b \x01(\x01H\x00R\bestimate\x88\x01\x01\x12\x1d\n" +
+	"\aproject\x18\t \x01(\tH\x01R\aproject\x88\x01\x01\x12<\n" +
+	"\rcustom_fields\x18\n" +
+	" \x01(\v2\x17.google.protobuf.StructR\fcustomFields\x125\n" +
+	"\bstart_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\astartAtB\v\n" +
+	"\t_estimateB\n" +
+	"\n" +
+	"\b_project\"E\n" +
+	"\x11DeleteTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\")\n" +
+	"\fTaskResponse\x12\x19\n" +
+	"\x04task\x18\x01 \x01(\v2\x05.TaskR\x04task\".\n" +
+	"\x12DeleteTaskResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"?\n" +
+	"\x10ImportICSRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"~\n" +
+	"\x11ImportICSResponse\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x05R\aupdated\x12\x18\n" +
+	"\askipped\x18\x03 \x01(\x05R\askipped\x12\x1b\n" +
+	"\x05tasks\x18\x04 \x03(\v2\x05.TaskR\x05tasks\"x\n" +
+	"\x13QuickAddTaskRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12\x18\n" +
+	"\apreview\x18\x04 \x01(\bR\apreview\"G\n" +
+	"\x14QuickAddTaskResponse\x12\x19\n" +
+	"\x04task\x18\x01 \x01(\v2\x05.TaskR\x04task\x12\x14\n" +
+	"\x05saved\x18\x02 \x01(\bR\x05saved\"\xba\x01\n" +
+	"\x13GetTaskStatsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\"]\n" +
+	"\vStatsBucket\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x1c\n" +
+	"\tcompleted\x18\x03 \x01(\x05R\tcompleted\"|\n" +
+	"\x0eStatusDuration\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vtotal_hours\x18\x02 \x01(\x01R\n" +
+	"totalHours\x12\x1b\n" +
+	"\tavg_hours\x18\x03 \x01(\x01R\bavgHours\x12\x14\n" +
+	"\x05tasks\x18\x04 \x01(\x05R\x05tasks\"\xa5\x03\n" +
+	"\tTaskStats\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x18\n" +
+	"\acreated\x18\x03 \x01(\x05R\acreated\x12\x1c\n" +
+	"\tcompleted\x18\x04 \x01(\x05R\tcompleted\x12\"\n" +
+	"\x05daily\x18\x05 \x03(\v2\f.StatsBucketR\x05daily\x12$\n" +
+	"\x06weekly\x18\x06 \x03(\v2\f.StatsBucketR\x06weekly\x12/\n" +
+	"\x14avg_cycle_time_hours\x18\a \x01(\x01R\x11avgCycleTimeHours\x12/\n" +
+	"\x14p90_cycle_time_hours\x18\b \x01(\x01R\x11p90CycleTimeHours\x125\n" +
+	"\x0etime_in_status\x18\t \x03(\v2\x0f.StatusDurationR\ftimeInStatus\x12!\n" +
+	"\foverdue_rate\x18\n" +
+	" \x01(\x01R\voverdueRate\"\x98\x01\n" +
+	"\rBurndownPoint\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1d\n" +
+	"\n" +
+	"open_tasks\x18\x02 \x01(\x05R\topenTasks\x12-\n" +
+	"\x12remaining_estimate\x18\x03 \x01(\x01R\x11remainingEstimate\x12%\n" +
+	"\x0eideal_estimate\x18\x04 \x01(\x01R\ridealEstimate\":\n" +
+	"\x10BurndownResponse\x12&\n" +
+	"\x06points\x18\x01 \x03(\v2\x0e.BurndownPointR\x06points\"\x9e\x01\n" +
+	"\x13CumulativeFlowPoint\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x128\n" +
+	"\x06counts\x18\x02 \x03(\v2 .CumulativeFlowPoint.CountsEntryR\x06counts\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"b\n" +
+	"\x16CumulativeFlowResponse\x12\x1a\n" +
+	"\bstatuses\x18\x01 \x03(\tR\bstatuses\x12,\n" +
+	"\x06points\x18\x02 \x03(\v2\x14.CumulativeFlowPointR\x06points\"\x81\x02\n" +
+	"\x0fSavedViewFilter\x12\x1a\n" +
+	"\bstatuses\x18\x01 \x03(\tR\bstatuses\x12\x1e\n" +
+	"\n" +
+	"priorities\x18\x02 \x03(\tR\n" +
+	"priorities\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x16\n" +
+	"\x06search\x18\x04 \x01(\tR\x06search\x12\x19\n" +
+	"\bdue_from\x18\x05 \x01(\tR\adueFrom\x12\x15\n" +
+	"\x06due_to\x18\x06 \x01(\tR\x05dueTo\x12\x18\n" +
+	"\aproject\x18\a \x01(\tR\aproject\x12:\n" +
+	"\rcustom_fields\x18\b \x03(\v2\x15.CustomFieldConditionR\fcustomFields\"9\n" +
+	"\rSavedViewSort\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04desc\x18\x02 \x01(\bR\x04desc\"\x8c\x02\n" +
+	"\tSavedView\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12(\n" +
+	"\x06filter\x18\x04 \x01(\v2\x10.SavedViewFilterR\x06filter\x12\"\n" +
+	"\x04sort\x18\x05 \x01(\v2\x0e.SavedViewSortR\x04sort\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"D\n" +
+	"\x10SavedViewRequest\x12\x17\n" +
+	"\aview_id\x18\x01 \x01(\tR\x06viewId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"0\n" +
+	"\x15ListSavedViewsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"P\n" +
+	"\x16ListSavedViewsResponse\x12 \n" +
+	"\x05views\x18\x01 \x03(\v2\n" +
+	".SavedViewR\x05views\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"3\n" +
+	"\x17DeleteSavedViewResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x85\x01\n" +
+	"\x10SubtaskBlueprint\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\tR\bpriority\x12\x1d\n" +
+	"\n" +
+	"due_offset\x18\x04 \x01(\tR\tdueOffset\"\x95\x03\n" +
+	"\fTaskTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bpriority\x18\x06 \x01(\tR\bpriority\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12\x1d\n" +
+	"\n" +
+	"due_offset\x18\b \x01(\tR\tdueOffset\x12\x1c\n" +
+	"\tchecklist\x18\t \x03(\tR\tchecklist\x12-\n" +
+	"\bsubtasks\x18\n" +
+	" \x03(\v2\x11.SubtaskBlueprintR\bsubtasks\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"O\n" +
+	"\x13TaskTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"3\n" +
+	"\x18ListTaskTemplatesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"^\n" +
+	"\x19ListTaskTemplatesResponse\x12+\n" +
+	"\ttemplates\x18\x01 \x03(\v2\r.TaskTemplateR\ttemplates\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"6\n" +
+	"\x1aDeleteTaskTemplateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xfa\x01\n" +
+	"\x1aInstantiateTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12H\n" +
+	"\tvariables\x18\x03 \x03(\v2*.InstantiateTemplateRequest.VariablesEntryR\tvariables\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"[\n" +
+	"\x1bInstantiateTemplateResponse\x12\x19\n" +
+	"\x04task\x18\x01 \x01(\v2\x05.TaskR\x04task\x12!\n" +
+	"\bsubtasks\x18\x02 \x03(\v2\x05.TaskR\bsubtasks\"\xc0\x02\n" +
+	"\x15CustomFieldDefinition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
+	"\aproject\x18\x03 \x01(\tR\aproject\x12\x10\n" +
+	"\x03key\x18\x04 \x01(\tR\x03key\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12\x18\n" +
+	"\aoptions\x18\a \x03(\tR\aoptions\x12\x1a\n" +
+	"\brequired\x18\b \x01(\bR\brequired\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"N\n" +
+	"\x14CustomFieldCondition\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x0e\n" +
+	"\x02op\x18\x02 \x01(\tR\x02op\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"H\n" +
+	"\x12CustomFieldRequest\x12\x19\n" +
+	"\bfield_id\x18\x01 \x01(\tR\afieldId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"L\n" +
+	"\x17ListCustomFieldsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\aproject\x18\x02 \x01(\tR\aproject\"`\n" +
+	"\x18ListCustomFieldsResponse\x12.\n" +
+	"\x06fields\x18\x01 \x03(\v2\x16.CustomFieldDefinitionR\x06fields\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"5\n" +
+	"\x19DeleteCustomFieldResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xe8\x01\n" +
+	"\tSLAPolicy\x12\x1a\n" +
+	"\bpriority\x18\x01 \x01(\tR\bpriority\x12)\n" +
+	"\x10response_minutes\x18\x02 \x01(\x05R\x0fresponseMinutes\x12-\n" +
+	"\x12resolution_minutes\x18\x03 \x01(\x05R\x11resolutionMinutes\x12*\n" +
+	"\x11at_risk_threshold\x18\x04 \x01(\x01R\x0fatRiskThreshold\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x18\n" +
+	"\x16ListSLAPoliciesRequest\"A\n" +
+	"\x17ListSLAPoliciesResponse\x12&\n" +
+	"\bpolicies\x18\x01 \x03(\v2\n" +
+	".SLAPolicyR\bpolicies\"\xa5\x02\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
+	"\aproject\x18\x03 \x01(\tR\aproject\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x05 \x03(\tR\n" +
+	"eventTypes\x12\x16\n" +
+	"\x06secret\x18\x06 \x01(\tR\x06secret\x12\x16\n" +
+	"\x06active\x18\a \x01(\bR\x06active\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"H\n" +
+	"\x0eWebhookRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\".\n" +
+	"\x13ListWebhooksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"R\n" +
+	"\x14ListWebhooksResponse\x12$\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\b.WebhookR\bwebhooks\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"1\n" +
+	"\x15DeleteWebhookResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd9\x04\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\tR\apayload\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12'\n" +
+	"\x0fresponse_status\x18\b \x01(\x05R\x0eresponseStatus\x12#\n" +
+	"\rresponse_body\x18\t \x01(\tR\fresponseBody\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\n" +
+	" \x01(\tR\tlastError\x12\x1f\n" +
+	"\vduration_ms\x18\v \x01(\x03R\n" +
+	"durationMs\x12B\n" +
+	"\x0fnext_attempt_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x12=\n" +
+	"\fdelivered_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"l\n" +
+	"\x1cListWebhookDeliveriesRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"g\n" +
+	"\x1dListWebhookDeliveriesResponse\x120\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x10.WebhookDeliveryR\n" +
+	"deliveries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"r\n" +
+	"\x17RedeliverWebhookRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vdelivery_id\x18\x03 \x01(\tR\n" +
+	"deliveryId\"\xfe\x01\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x12\x17\n" +
+	"\atask_id\x18\x06 \x01(\tR\x06taskId\x123\n" +
+	"\aread_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x06readAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x82\x01\n" +
+	"\x18ListNotificationsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vunread_only\x18\x02 \x01(\bR\n" +
+	"unreadOnly\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"\x89\x01\n" +
+	"\x19ListNotificationsResponse\x123\n" +
+	"\rnotifications\x18\x01 \x03(\v2\r.NotificationR\rnotifications\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12!\n" +
+	"\funread_count\x18\x03 \x01(\x05R\vunreadCount\"S\n" +
+	"\x0fMarkReadRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
+	"\x0fnotification_id\x18\x02 \x01(\tR\x0enotificationId\"-\n" +
+	"\x12MarkAllReadRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"O\n" +
+	"\x10MarkReadResponse\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\x05R\aupdated\x12!\n" +
+	"\funread_count\x18\x02 \x01(\x05R\vunreadCount\"\xd7\x01\n" +
+	"\x13GetNextTasksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\aproject\x18\x02 \x01(\tR\aproject\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12;\n" +
+	"\aweights\x18\x04 \x03(\v2!.GetNextTasksRequest.WeightsEntryR\aweights\x1a:\n" +
+	"\fWeightsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x8f\x01\n" +
+	"\vScoreFactor\x12\x16\n" +
+	"\x06factor\x18\x01 \x01(\tR\x06factor\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x01R\x06weight\x12\"\n" +
+	"\fcontribution\x18\x04 \x01(\x01R\fcontribution\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"e\n" +
+	"\n" +
+	"ScoredTask\x12\x19\n" +
+	"\x04task\x18\x01 \x01(\v2\x05.TaskR\x04task\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12&\n" +
+	"\afactors\x18\x03 \x03(\v2\f.ScoreFactorR\afactors\"\xb3\x01\n" +
+	"\x14GetNextTasksResponse\x12!\n" +
+	"\x05tasks\x18\x01 \x03(\v2\v.ScoredTaskR\x05tasks\x12<\n" +
+	"\aweights\x18\x02 \x03(\v2\".GetNextTasksResponse.WeightsEntryR\aweights\x1a:\n" +
+	"\fWeightsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x9d\x01\n" +
+	"\x10MatrixThresholds\x12-\n" +
+	"\x12important_priority\x18\x01 \x01(\tR\x11importantPriority\x12.\n" +
+	"\x13urgent_within_hours\x18\x02 \x01(\x05R\x11urgentWithinHours\x12*\n" +
+	"\x11urgent_sla_status\x18\x03 \x01(\tR\x0furgentSlaStatus\"\x82\x01\n" +
+	"\x1aGetEisenhowerMatrixRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\aproject\x18\x02 \x01(\tR\aproject\x121\n" +
+	"\n" +
+	"thresholds\x18\x03 \x01(\v2\x11.MatrixThresholdsR\n" +
+	"thresholds\"\xb1\x01\n" +
+	"\n" +
+	"MatrixTask\x12\x19\n" +
+	"\x04task\x18\x01 \x01(\v2\x05.TaskR\x04task\x12\x1a\n" +
+	"\bquadrant\x18\x02 \x01(\tR\bquadrant\x12\x1c\n" +
+	"\timportant\x18\x03 \x01(\bR\timportant\x12\x16\n" +
+	"\x06urgent\x18\x04 \x01(\bR\x06urgent\x12\x1e\n" +
+	"\n" +
+	"overridden\x18\x05 \x01(\bR\n" +
+	"overridden\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"\xf5\x01\n" +
+	"\x1bGetEisenhowerMatrixResponse\x12&\n" +
+	"\bdo_first\x18\x01 \x03(\v2\v.MatrixTaskR\adoFirst\x12'\n" +
+	"\bschedule\x18\x02 \x03(\v2\v.MatrixTaskR\bschedule\x12'\n" +
+	"\bdelegate\x18\x03 \x03(\v2\v.MatrixTaskR\bdelegate\x12)\n" +
+	"\teliminate\x18\x04 \x03(\v2\v.MatrixTaskR\teliminate\x121\n" +
+	"\n" +
+	"thresholds\x18\x05 \x01(\v2\x11.MatrixThresholdsR\n" +
+	"thresholds\"j\n" +
+	"\x1aSetQuadrantOverrideRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1a\n" +
+	"\bquadrant\x18\x03 \x01(\tR\bquadrant\"R\n" +
+	"\x1bSetQuadrantOverrideResponse\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1a\n" +
+	"\bquadrant\x18\x02 \x01(\tR\bquadrant\"w\n" +
+	"\x11SnoozeTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05until\x18\x03 \x01(\tR\x05until\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\"\x87\x01\n" +
+	"\x12GetCalendarRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12\x18\n" +
+	"\aproject\x18\x05 \x01(\tR\aproject\"j\n" +
+	"\rCalendarEntry\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12\x19\n" +
+	"\x04task\x18\x03 \x01(\v2\x05.TaskR\x04task\"K\n" +
+	"\vCalendarDay\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12(\n" +
+	"\aentries\x18\x02 \x03(\v2\x0e.CalendarEntryR\aentries\"w\n" +
+	"\x13GetCalendarResponse\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12 \n" +
+	"\x04days\x18\x04 \x03(\v2\f.CalendarDayR\x04days\"=\n" +
+	"\x11ChecklistProgress\x12\x12\n" +
+	"\x04done\x18\x01 \x01(\x05R\x04done\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xf2\x01\n" +
+	"\rChecklistItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x12\n" +
+	"\x04done\x18\x04 \x01(\bR\x04done\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x05R\bposition\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"D\n" +
+	"\x10ChecklistRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"_\n" +
+	"\x17AddChecklistItemRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"a\n" +
+	"\x14ChecklistItemRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\aitem_id\x18\x03 \x01(\tR\x06itemId\"f\n" +
+	"\x17ReorderChecklistRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
+	"\bitem_ids\x18\x03 \x03(\tR\aitemIds\"i\n" +
+	"\x11ChecklistResponse\x12$\n" +
+	"\x05items\x18\x01 \x03(\v2\x0e.ChecklistItemR\x05items\x12.\n" +
+	"\bprogress\x18\x02 \x01(\v2\x12.ChecklistProgressR\bprogress\"\xd0\x01\n" +
+	"\x10CloneTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12)\n" +
+	"\x10include_subtasks\x18\x03 \x01(\bR\x0fincludeSubtasks\x12!\n" +
+	"\freset_status\x18\x04 \x01(\bR\vresetStatus\x12 \n" +
+	"\fshift_due_by\x18\x05 \x01(\tR\n" +
+	"shiftDueBy\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\"\xf8\x01\n" +
+	"\tTaskShare\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1d\n" +
+	"\n" +
+	"grantee_id\x18\x02 \x01(\tR\tgranteeId\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x03 \x01(\tR\n" +
+	"permission\x12\x1d\n" +
+	"\n" +
+	"granted_by\x18\x04 \x01(\tR\tgrantedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x83\x01\n" +
+	"\x10ShareTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"grantee_id\x18\x03 \x01(\tR\tgranteeId\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x04 \x01(\tR\n" +
+	"permission\"e\n" +
+	"\x12UnshareTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"grantee_id\x18\x03 \x01(\tR\tgranteeId\"\x15\n" +
+	"\x13UnshareTaskResponse\"E\n" +
+	"\x11ListSharesRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"8\n" +
+	"\x12ListSharesResponse\x12\"\n" +
+	"\x06shares\x18\x01 \x03(\v2\n" +
+	".TaskShareR\x06shares\"\xdd\x02\n" +
+	"\n" +
+	"PublicLink\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\x06 \x01(\x03R\tviewCount\x12@\n" +
+	"\x0elast_viewed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\flastViewedAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x86\x01\n" +
+	"\x17CreatePublicLinkRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\tR\texpiresAt\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\"J\n" +
+	"\x16ListPublicLinksRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"<\n" +
+	"\x17ListPublicLinksResponse\x12!\n" +
+	"\x05links\x18\x01 \x03(\v2\v.PublicLinkR\x05links\"d\n" +
+	"\x17RevokePublicLinkRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\alink_id\x18\x03 \x01(\tR\x06linkId\",\n" +
+	"\x14GetPublicTaskRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x9f\x03\n" +
+	"\n" +
+	"PublicTask\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\tR\bpriority\x125\n" +
+	"\bdue_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12=\n" +
+	"\fcompleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x120\n" +
+	"\tchecklist\x18\b \x01(\v2\x12.ChecklistProgressR\tchecklist\x12B\n" +
+	"\x0flink_expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\rlinkExpiresAt*H\n" +
+	"\n" +
+	"TaskStatus\x12\v\n" +
+	"\aPENDING\x10\x00\x12\x0f\n" +
+	"\vIN_PROGRESS\x10\x01\x12\r\n" +
+	"\tCOMPLETED\x10\x02\x12\r\n" +
+	"\tCANCELLED\x10\x03*=\n" +
+	"\x0eTaskPriorities\x12\a\n" +
+	"\x03LOW\x10\x00\x12\n" +
+	"\n" +
+	"\x06NORMAL\x10\x01\x12\b\n" +
+	"\x04HIGH\x10\x02\x12\f\n" +
+	"\bCRITICAL\x10\x032\xb1\x1a\n" +
+	"\vTaskService\x12$\n" +
+	"\n" +
+	"CreateTask\x12\x05.Task\x1a\r.TaskResponse\"\x00\x12+\n" +
+	"\aGetTask\x12\x0f.GetTaskRequest\x1a\r.TaskResponse\"\x00\x124\n" +
+	"\tListTasks\x12\x11.ListTasksRequest\x1a\x12.ListTasksResponse\"\x00\x121\n" +
+	"\n" +
+	"UpdateTask\x12\x12.UpdateTaskRequest\x1a\r.TaskResponse\"\x00\x127\n" +
+	"\n" +
+	"DeleteTask\x12\x12.DeleteTaskRequest\x1a\x13.DeleteTaskResponse\"\x00\x124\n" +
+	"\tImportICS\x12\x11.ImportICSRequest\x1a\x12.ImportICSResponse\"\x00\x12=\n" +
+	"\fQuickAddTask\x12\x14.QuickAddTaskRequest\x1a\x15.QuickAddTaskResponse\"\x00\x122\n" +
+	"\fGetTaskStats\x12\x14.GetTaskStatsRequest\x1a\n" +
+	".TaskStats\"\x00\x128\n" +
+	"\vGetBurndown\x12\x14.GetTaskStatsRequest\x1a\x11.BurndownResponse\"\x00\x12D\n" +
+	"\x11GetCumulativeFlow\x12\x14.GetTaskStatsRequest\x1a\x17.CumulativeFlowResponse\"\x00\x12+\n" +
+	"\x0fCreateSavedView\x12\n" +
+	".SavedView\x1a\n" +
+	".SavedView\"\x00\x12/\n" +
+	"\fGetSavedView\x12\x11.SavedViewRequest\x1a\n" +
+	".SavedView\"\x00\x12C\n" +
+	"\x0eListSavedViews\x12\x16.ListSavedViewsRequest\x1a\x17.ListSavedViewsResponse\"\x00\x12+\n" +
+	"\x0fUpdateSavedView\x12\n" +
+	".SavedView\x1a\n" +
+	".SavedView\"\x00\x12@\n" +
+	"\x0fDeleteSavedView\x12\x11.SavedViewRequest\x1a\x18.DeleteSavedViewResponse\"\x00\x124\n" +
+	"\x12CreateTaskTemplate\x12\r.TaskTemplate\x1a\r.TaskTemplate\"\x00\x128\n" +
+	"\x0fGetTaskTemplate\x12\x14.TaskTemplateRequest\x1a\r.TaskTemplate\"\x00\x12L\n" +
+	"\x11ListTaskTemplates\x12\x19.ListTaskTemplatesRequest\x1a\x1a.ListTaskTemplatesResponse\"\x00\x124\n" +
+	"\x12UpdateTaskTemplate\x12\r.TaskTemplate\x1a\r.TaskTemplate\"\x00\x12I\n" +
+	"\x12DeleteTaskTemplate\x12\x14.TaskTemplateRequest\x1a\x1b.DeleteTaskTemplateResponse\"\x00\x12R\n" +
+	"\x13InstantiateTemplate\x12\x1b.InstantiateTemplateRequest\x1a\x1c.InstantiateTemplateResponse\"\x00\x12E\n" +
+	"\x11CreateCustomField\x12\x16.CustomFieldDefinition\x1a\x16.CustomFieldDefinition\"\x00\x12I\n" +
+	"\x10ListCustomFields\x12\x18.ListCustomFieldsRequest\x1a\x19.ListCustomFieldsResponse\"\x00\x12E\n" +
+	"\x11UpdateCustomField\x12\x16.CustomFieldDefinition\x1a\x16.CustomFieldDefinition\"\x00\x12F\n" +
+	"\x11DeleteCustomField\x12\x13.CustomFieldRequest\x1a\x1a.DeleteCustomFieldResponse\"\x00\x12F\n" +
+	"\x0fListSLAPolicies\x12\x17.ListSLAPoliciesRequest\x1a\x18.ListSLAPoliciesResponse\"\x00\x12+\n" +
+	"\x0fUpdateSLAPolicy\x12\n" +
+	".SLAPolicy\x1a\n" +
+	".SLAPolicy\"\x00\x12%\n" +
+	"\rCreateWebhook\x12\b.Webhook\x1a\b.Webhook\"\x00\x12=\n" +
+	"\fListWebhooks\x12\x14.ListWebhooksRequest\x1a\x15.ListWebhooksResponse\"\x00\x12%\n" +
+	"\rUpdateWebhook\x12\b.Webhook\x1a\b.Webhook\"\x00\x12:\n" +
+	"\rDeleteWebhook\x12\x0f.WebhookRequest\x1a\x16.DeleteWebhookResponse\"\x00\x12X\n" +
+	"\x15ListWebhookDeliveries\x12\x1d.ListWebhookDeliveriesRequest\x1a\x1e.ListWebhookDeliveriesResponse\"\x00\x12@\n" +
+	"\x10RedeliverWebhook\x12\x18.RedeliverWebhookRequest\x1a\x10.WebhookDelivery\"\x00\x12L\n" +
+	"\x11ListNotifications\x12\x19.ListNotificationsRequest\x1a\x1a.ListNotificationsResponse\"\x00\x121\n" +
+	"\bMarkRead\x12\x10.MarkReadRequest\x1a\x11.MarkReadResponse\"\x00\x127\n" +
+	"\vMarkAllRead\x12\x13.MarkAllReadRequest\x1a\x11.MarkReadResponse\"\x00\x12=\n" +
+	"\fGetNextTasks\x12\x14.GetNextTasksRequest\x1a\x15.GetNextTasksResponse\"\x00\x12R\n" +
+	"\x13GetEisenhowerMatrix\x12\x1b.GetEisenhowerMatrixRequest\x1a\x1c.GetEisenhowerMatrixResponse\"\x00\x12R\n" +
+	"\x13SetQuadrantOverride\x12\x1b.SetQuadrantOverrideRequest\x1a\x1c.SetQuadrantOverrideResponse\"\x00\x121\n" +
+	"\n" +
+	"SnoozeTask\x12\x12.SnoozeTaskRequest\x1a\r.TaskResponse\"\x00\x12:\n" +
+	"\vGetCalendar\x12\x13.GetCalendarRequest\x1a\x14.GetCalendarResponse\"\x00\x127\n" +
+	"\fGetChecklist\x12\x11.ChecklistRequest\x1a\x12.ChecklistResponse\"\x00\x12B\n" +
+	"\x10AddChecklistItem\x12\x18.AddChecklistItemRequest\x1a\x12.ChecklistResponse\"\x00\x12B\n" +
+	"\x13ToggleChecklistItem\x12\x15.ChecklistItemRequest\x1a\x12.ChecklistResponse\"\x00\x12B\n" +
+	"\x10ReorderChecklist\x12\x18.ReorderChecklistRequest\x1a\x12.ChecklistResponse\"\x00\x12B\n" +
+	"\x13DeleteChecklistItem\x12\x15.ChecklistItemRequest\x1a\x12.ChecklistResponse\"\x00\x12>\n" +
+	"\x14ConvertChecklistItem\x12\x15.ChecklistItemRequest\x1a\r.TaskResponse\"\x00\x12/\n" +
+	"\tCloneTask\x12\x11.CloneTaskRequest\x1a\r.TaskResponse\"\x00\x12,\n" +
+	"\tShareTask\x12\x11.ShareTaskRequest\x1a\n" +
+	".TaskShare\"\x00\x12:\n" +
+	"\vUnshareTask\x12\x13.UnshareTaskRequest\x1a\x14.UnshareTaskResponse\"\x00\x127\n" +
 	"\n" +
-	"DeleteTask\x12\x12.DeleteTaskRequest\x1a\x13.DeleteTaskResponse\"\x00B\n" +
+	"ListShares\x12\x12.ListSharesRequest\x1a\x13.ListSharesResponse\"\x00\x12;\n" +
+	"\x10CreatePublicLink\x12\x18.CreatePublicLinkRequest\x1a\v.PublicLink\"\x00\x12F\n" +
+	"\x0fListPublicLinks\x12\x17.ListPublicLinksRequest\x1a\x18.ListPublicLinksResponse\"\x00\x12;\n" +
+	"\x10RevokePublicLink\x12\x18.RevokePublicLinkRequest\x1a\v.PublicLink\"\x00\x125\n" +
+	"\rGetPublicTask\x12\x15.GetPublicTaskRequest\x1a\v.PublicTask\"\x00B\n" +
 	"Z\bgen/taskb\x06proto3"
 
 var (
@@ -782,44 +7158,320 @@ func file_proto_task_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_task_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_task_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_task_proto_msgTypes = make([]protoimpl.MessageInfo, 100)
 var file_proto_task_proto_goTypes = []any{
-	(TaskStatus)(0),               // 0: TaskStatus
-	(TaskPriorities)(0),           // 1: TaskPriorities
-	(*Task)(nil),                  // 2: Task
-	(*CreateTaskRequest)(nil),     // 3: CreateTaskRequest
-	(*GetTaskRequest)(nil),        // 4: GetTaskRequest
-	(*ListTasksRequest)(nil),      // 5: ListTasksRequest
-	(*ListTasksResponse)(nil),     // 6: ListTasksResponse
-	(*UpdateTaskRequest)(nil),     // 7: UpdateTaskRequest
-	(*DeleteTaskRequest)(nil),     // 8: DeleteTaskRequest
-	(*TaskResponse)(nil),          // 9: TaskResponse
-	(*DeleteTaskResponse)(nil),    // 10: DeleteTaskResponse
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(TaskStatus)(0),                       // 0: TaskStatus
+	(TaskPriorities)(0),                   // 1: TaskPriorities
+	(*Task)(nil),                          // 2: Task
+	(*CreateTaskRequest)(nil),             // 3: CreateTaskRequest
+	(*GetTaskRequest)(nil),                // 4: GetTaskRequest
+	(*ListTasksRequest)(nil),              // 5: ListTasksRequest
+	(*ListTasksResponse)(nil),             // 6: ListTasksResponse
+	(*UpdateTaskRequest)(nil),             // 7: UpdateTaskRequest
+	(*DeleteTaskRequest)(nil),             // 8: DeleteTaskRequest
+	(*TaskResponse)(nil),                  // 9: TaskResponse
+	(*DeleteTaskResponse)(nil),            // 10: DeleteTaskResponse
+	(*ImportICSRequest)(nil),              // 11: ImportICSRequest
+	(*ImportICSResponse)(nil),             // 12: ImportICSResponse
+	(*QuickAddTaskRequest)(nil),           // 13: QuickAddTaskRequest
+	(*QuickAddTaskResponse)(nil),          // 14: QuickAddTaskResponse
+	(*GetTaskStatsRequest)(nil),           // 15: GetTaskStatsRequest
+	(*StatsBucket)(nil),                   // 16: StatsBucket
+	(*StatusDuration)(nil),                // 17: StatusDuration
+	(*TaskStats)(nil),                     // 18: TaskStats
+	(*BurndownPoint)(nil),                 // 19: BurndownPoint
+	(*BurndownResponse)(nil),              // 20: BurndownResponse
+	(*CumulativeFlowPoint)(nil),           // 21: CumulativeFlowPoint
+	(*CumulativeFlowResponse)(nil),        // 22: CumulativeFlowResponse
+	(*SavedViewFilter)(nil),               // 23: SavedViewFilter
+	(*SavedViewSort)(nil),                 // 24: SavedViewSort
+	(*SavedView)(nil),                     // 25: SavedView
+	(*SavedViewRequest)(nil),              // 26: SavedViewRequest
+	(*ListSavedViewsRequest)(nil),         // 27: ListSavedViewsRequest
+	(*ListSavedViewsResponse)(nil),        // 28: ListSavedViewsResponse
+	(*DeleteSavedViewResponse)(nil),       // 29: DeleteSavedViewResponse
+	(*SubtaskBlueprint)(nil),              // 30: SubtaskBlueprint
+	(*TaskTemplate)(nil),                  // 31: TaskTemplate
+	(*TaskTemplateRequest)(nil),           // 32: TaskTemplateRequest
+	(*ListTaskTemplatesRequest)(nil),      // 33: ListTaskTemplatesRequest
+	(*ListTaskTemplatesResponse)(nil),     // 34: ListTaskTemplatesResponse
+	(*DeleteTaskTemplateResponse)(nil),    // 35: DeleteTaskTemplateResponse
+	(*InstantiateTemplateRequest)(nil),    // 36: InstantiateTemplateRequest
+	(*InstantiateTemplateResponse)(nil),   // 37: InstantiateTemplateResponse
+	(*CustomFieldDefinition)(nil),         // 38: CustomFieldDefinition
+	(*CustomFieldCondition)(nil),          // 39: CustomFieldCondition
+	(*CustomFieldRequest)(nil),            // 40: CustomFieldRequest
+	(*ListCustomFieldsRequest)(nil),       // 41: ListCustomFieldsRequest
+	(*ListCustomFieldsResponse)(nil),      // 42: ListCustomFieldsResponse
+	(*DeleteCustomFieldResponse)(nil),     // 43: DeleteCustomFieldResponse
+	(*SLAPolicy)(nil),                     // 44: SLAPolicy
+	(*ListSLAPoliciesRequest)(nil),        // 45: ListSLAPoliciesRequest
+	(*ListSLAPoliciesResponse)(nil),       // 46: ListSLAPoliciesResponse
+	(*Webhook)(nil),                       // 47: Webhook
+	(*WebhookRequest)(nil),                // 48: WebhookRequest
+	(*ListWebhooksRequest)(nil),           // 49: ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),          // 50: ListWebhooksResponse
+	(*DeleteWebhookResponse)(nil),         // 51: DeleteWebhookResponse
+	(*WebhookDelivery)(nil),               // 52: WebhookDelivery
+	(*ListWebhookDeliveriesRequest)(nil),  // 53: ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil), // 54: ListWebhookDeliveriesResponse
+	(*RedeliverWebhookRequest)(nil),       // 55: RedeliverWebhookRequest
+	(*Notification)(nil),                  // 56: Notification
+	(*ListNotificationsRequest)(nil),      // 57: ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),     // 58: ListNotificationsResponse
+	(*MarkReadRequest)(nil),               // 59: MarkReadRequest
+	(*MarkAllReadRequest)(nil),            // 60: MarkAllReadRequest
+	(*MarkReadResponse)(nil),              // 61: MarkReadResponse
+	(*GetNextTasksRequest)(nil),           // 62: GetNextTasksRequest
+	(*ScoreFactor)(nil),                   // 63: ScoreFactor
+	(*ScoredTask)(nil),                    // 64: ScoredTask
+	(*GetNextTasksResponse)(nil),          // 65: GetNextTasksResponse
+	(*MatrixThresholds)(nil),              // 66: MatrixThresholds
+	(*GetEisenhowerMatrixRequest)(nil),    // 67: GetEisenhowerMatrixRequest
+	(*MatrixTask)(nil),                    // 68: MatrixTask
+	(*GetEisenhowerMatrixResponse)(nil),   // 69: GetEisenhowerMatrixResponse
+	(*SetQuadrantOverrideRequest)(nil),    // 70: SetQuadrantOverrideRequest
+	(*SetQuadrantOverrideResponse)(nil),   // 71: SetQuadrantOverrideResponse
+	(*SnoozeTaskRequest)(nil),             // 72: SnoozeTaskRequest
+	(*GetCalendarRequest)(nil),            // 73: GetCalendarRequest
+	(*CalendarEntry)(nil),                 // 74: CalendarEntry
+	(*CalendarDay)(nil),                   // 75: CalendarDay
+	(*GetCalendarResponse)(nil),           // 76: GetCalendarResponse
+	(*ChecklistProgress)(nil),             // 77: ChecklistProgress
+	(*ChecklistItem)(nil),                 // 78: ChecklistItem
+	(*ChecklistRequest)(nil),              // 79: ChecklistRequest
+	(*AddChecklistItemRequest)(nil),       // 80: AddChecklistItemRequest
+	(*ChecklistItemRequest)(nil),          // 81: ChecklistItemRequest
+	(*ReorderChecklistRequest)(nil),       // 82: ReorderChecklistRequest
+	(*ChecklistResponse)(nil),             // 83: ChecklistResponse
+	(*CloneTaskRequest)(nil),              // 84: CloneTaskRequest
+	(*TaskShare)(nil),                     // 85: TaskShare
+	(*ShareTaskRequest)(nil),              // 86: ShareTaskRequest
+	(*UnshareTaskRequest)(nil),            // 87: UnshareTaskRequest
+	(*UnshareTaskResponse)(nil),           // 88: UnshareTaskResponse
+	(*ListSharesRequest)(nil),             // 89: ListSharesRequest
+	(*ListSharesResponse)(nil),            // 90: ListSharesResponse
+	(*PublicLink)(nil),                    // 91: PublicLink
+	(*CreatePublicLinkRequest)(nil),       // 92: CreatePublicLinkRequest
+	(*ListPublicLinksRequest)(nil),        // 93: ListPublicLinksRequest
+	(*ListPublicLinksResponse)(nil),       // 94: ListPublicLinksResponse
+	(*RevokePublicLinkRequest)(nil),       // 95: RevokePublicLinkRequest
+	(*GetPublicTaskRequest)(nil),          // 96: GetPublicTaskRequest
+	(*PublicTask)(nil),                    // 97: PublicTask
+	nil,                                   // 98: CumulativeFlowPoint.CountsEntry
+	nil,                                   // 99: InstantiateTemplateRequest.VariablesEntry
+	nil,                                   // 100: GetNextTasksRequest.WeightsEntry
+	nil,                                   // 101: GetNextTasksResponse.WeightsEntry
+	(*timestamppb.Timestamp)(nil),         // 102: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),               // 103: google.protobuf.Struct
 }
 var file_proto_task_proto_depIdxs = []int32{
-	11, // 0: Task.created_at:type_name -> google.protobuf.Timestamp
-	11, // 1: Task.updated_at:type_name -> google.protobuf.Timestamp
-	11, // 2: Task.due_date:type_name -> google.protobuf.Timestamp
-	11, // 3: CreateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
-	2,  // 4: ListTasksResponse.tasks:type_name -> Task
-	11, // 5: UpdateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
-	2,  // 6: TaskResponse.task:type_name -> Task
-	2,  // 7: TaskService.CreateTask:input_type -> Task
-	4,  // 8: TaskService.GetTask:input_type -> GetTaskRequest
-	5,  // 9: TaskService.ListTasks:input_type -> ListTasksRequest
-	7,  // 10: TaskService.UpdateTask:input_type -> UpdateTaskRequest
-	8,  // 11: TaskService.DeleteTask:input_type -> DeleteTaskRequest
-	9,  // 12: TaskService.CreateTask:output_type -> TaskResponse
-	9,  // 13: TaskService.GetTask:output_type -> TaskResponse
-	6,  // 14: TaskService.ListTasks:output_type -> ListTasksResponse
-	9,  // 15: TaskService.UpdateTask:output_type -> TaskResponse
-	10, // 16: TaskService.DeleteTask:output_type -> DeleteTaskResponse
-	12, // [12:17] is the sub-list for method output_type
-	7,  // [7:12] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	102, // 0: Task.created_at:type_name -> google.protobuf.Timestamp
+	102, // 1: Task.updated_at:type_name -> google.protobuf.Timestamp
+	102, // 2: Task.due_date:type_name -> google.protobuf.Timestamp
+	103, // 3: Task.custom_fields:type_name -> google.protobuf.Struct
+	102, // 4: Task.sla_response_due_at:type_name -> google.protobuf.Timestamp
+	102, // 5: Task.sla_due_at:type_name -> google.protobuf.Timestamp
+	102, // 6: Task.responded_at:type_name -> google.protobuf.Timestamp
+	102, // 7: Task.start_at:type_name -> google.protobuf.Timestamp
+	102, // 8: Task.snoozed_until:type_name -> google.protobuf.Timestamp
+	77,  // 9: Task.checklist:type_name -> ChecklistProgress
+	102, // 10: CreateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
+	39,  // 11: ListTasksRequest.custom_fields:type_name -> CustomFieldCondition
+	2,   // 12: ListTasksResponse.tasks:type_name -> Task
+	102, // 13: UpdateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
+	103, // 14: UpdateTaskRequest.custom_fields:type_name -> google.protobuf.Struct
+	102, // 15: UpdateTaskRequest.start_at:type_name -> google.protobuf.Timestamp
+	2,   // 16: TaskResponse.task:type_name -> Task
+	2,   // 17: ImportICSResponse.tasks:type_name -> Task
+	2,   // 18: QuickAddTaskResponse.task:type_name -> Task
+	102, // 19: GetTaskStatsRequest.from:type_name -> google.protobuf.Timestamp
+	102, // 20: GetTaskStatsRequest.to:type_name -> google.protobuf.Timestamp
+	102, // 21: TaskStats.from:type_name -> google.protobuf.Timestamp
+	102, // 22: TaskStats.to:type_name -> google.protobuf.Timestamp
+	16,  // 23: TaskStats.daily:type_name -> StatsBucket
+	16,  // 24: TaskStats.weekly:type_name -> StatsBucket
+	17,  // 25: TaskStats.time_in_status:type_name -> StatusDuration
+	19,  // 26: BurndownResponse.points:type_name -> BurndownPoint
+	98,  // 27: CumulativeFlowPoint.counts:type_name -> CumulativeFlowPoint.CountsEntry
+	21,  // 28: CumulativeFlowResponse.points:type_name -> CumulativeFlowPoint
+	39,  // 29: SavedViewFilter.custom_fields:type_name -> CustomFieldCondition
+	23,  // 30: SavedView.filter:type_name -> SavedViewFilter
+	24,  // 31: SavedView.sort:type_name -> SavedViewSort
+	102, // 32: SavedView.created_at:type_name -> google.protobuf.Timestamp
+	102, // 33: SavedView.updated_at:type_name -> google.protobuf.Timestamp
+	25,  // 34: ListSavedViewsResponse.views:type_name -> SavedView
+	30,  // 35: TaskTemplate.subtasks:type_name -> SubtaskBlueprint
+	102, // 36: TaskTemplate.created_at:type_name -> google.protobuf.Timestamp
+	102, // 37: TaskTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	31,  // 38: ListTaskTemplatesResponse.templates:type_name -> TaskTemplate
+	99,  // 39: InstantiateTemplateRequest.variables:type_name -> InstantiateTemplateRequest.VariablesEntry
+	2,   // 40: InstantiateTemplateResponse.task:type_name -> Task
+	2,   // 41: InstantiateTemplateResponse.subtasks:type_name -> Task
+	102, // 42: CustomFieldDefinition.created_at:type_name -> google.protobuf.Timestamp
+	102, // 43: CustomFieldDefinition.updated_at:type_name -> google.protobuf.Timestamp
+	38,  // 44: ListCustomFieldsResponse.fields:type_name -> CustomFieldDefinition
+	102, // 45: SLAPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	44,  // 46: ListSLAPoliciesResponse.policies:type_name -> SLAPolicy
+	102, // 47: Webhook.created_at:type_name -> google.protobuf.Timestamp
+	102, // 48: Webhook.updated_at:type_name -> google.protobuf.Timestamp
+	47,  // 49: ListWebhooksResponse.webhooks:type_name -> Webhook
+	102, // 50: WebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	102, // 51: WebhookDelivery.delivered_at:type_name -> google.protobuf.Timestamp
+	102, // 52: WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	102, // 53: WebhookDelivery.updated_at:type_name -> google.protobuf.Timestamp
+	52,  // 54: ListWebhookDeliveriesResponse.deliveries:type_name -> WebhookDelivery
+	102, // 55: Notification.read_at:type_name -> google.protobuf.Timestamp
+	102, // 56: Notification.created_at:type_name -> google.protobuf.Timestamp
+	56,  // 57: ListNotificationsResponse.notifications:type_name -> Notification
+	100, // 58: GetNextTasksRequest.weights:type_name -> GetNextTasksRequest.WeightsEntry
+	2,   // 59: ScoredTask.task:type_name -> Task
+	63,  // 60: ScoredTask.factors:type_name -> ScoreFactor
+	64,  // 61: GetNextTasksResponse.tasks:type_name -> ScoredTask
+	101, // 62: GetNextTasksResponse.weights:type_name -> GetNextTasksResponse.WeightsEntry
+	66,  // 63: GetEisenhowerMatrixRequest.thresholds:type_name -> MatrixThresholds
+	2,   // 64: MatrixTask.task:type_name -> Task
+	68,  // 65: GetEisenhowerMatrixResponse.do_first:type_name -> MatrixTask
+	68,  // 66: GetEisenhowerMatrixResponse.schedule:type_name -> MatrixTask
+	68,  // 67: GetEisenhowerMatrixResponse.delegate:type_name -> MatrixTask
+	68,  // 68: GetEisenhowerMatrixResponse.eliminate:type_name -> MatrixTask
+	66,  // 69: GetEisenhowerMatrixResponse.thresholds:type_name -> MatrixThresholds
+	102, // 70: CalendarEntry.at:type_name -> google.protobuf.Timestamp
+	2,   // 71: CalendarEntry.task:type_name -> Task
+	74,  // 72: CalendarDay.entries:type_name -> CalendarEntry
+	75,  // 73: GetCalendarResponse.days:type_name -> CalendarDay
+	102, // 74: ChecklistItem.created_at:type_name -> google.protobuf.Timestamp
+	102, // 75: ChecklistItem.updated_at:type_name -> google.protobuf.Timestamp
+	78,  // 76: ChecklistResponse.items:type_name -> ChecklistItem
+	77,  // 77: ChecklistResponse.progress:type_name -> ChecklistProgress
+	102, // 78: TaskShare.created_at:type_name -> google.protobuf.Timestamp
+	102, // 79: TaskShare.updated_at:type_name -> google.protobuf.Timestamp
+	85,  // 80: ListSharesResponse.shares:type_name -> TaskShare
+	102, // 81: PublicLink.expires_at:type_name -> google.protobuf.Timestamp
+	102, // 82: PublicLink.revoked_at:type_name -> google.protobuf.Timestamp
+	102, // 83: PublicLink.last_viewed_at:type_name -> google.protobuf.Timestamp
+	102, // 84: PublicLink.created_at:type_name -> google.protobuf.Timestamp
+	91,  // 85: ListPublicLinksResponse.links:type_name -> PublicLink
+	102, // 86: PublicTask.due_date:type_name -> google.protobuf.Timestamp
+	102, // 87: PublicTask.completed_at:type_name -> google.protobuf.Timestamp
+	102, // 88: PublicTask.updated_at:type_name -> google.protobuf.Timestamp
+	77,  // 89: PublicTask.checklist:type_name -> ChecklistProgress
+	102, // 90: PublicTask.link_expires_at:type_name -> google.protobuf.Timestamp
+	2,   // 91: TaskService.CreateTask:input_type -> Task
+	4,   // 92: TaskService.GetTask:input_type -> GetTaskRequest
+	5,   // 93: TaskService.ListTasks:input_type -> ListTasksRequest
+	7,   // 94: TaskService.UpdateTask:input_type -> UpdateTaskRequest
+	8,   // 95: TaskService.DeleteTask:input_type -> DeleteTaskRequest
+	11,  // 96: TaskService.ImportICS:input_type -> ImportICSRequest
+	13,  // 97: TaskService.QuickAddTask:input_type -> QuickAddTaskRequest
+	15,  // 98: TaskService.GetTaskStats:input_type -> GetTaskStatsRequest
+	15,  // 99: TaskService.GetBurndown:input_type -> GetTaskStatsRequest
+	15,  // 100: TaskService.GetCumulativeFlow:input_type -> GetTaskStatsRequest
+	25,  // 101: TaskService.CreateSavedView:input_type -> SavedView
+	26,  // 102: TaskService.GetSavedView:input_type -> SavedViewRequest
+	27,  // 103: TaskService.ListSavedViews:input_type -> ListSavedViewsRequest
+	25,  // 104: TaskService.UpdateSavedView:input_type -> SavedView
+	26,  // 105: TaskService.DeleteSavedView:input_type -> SavedViewRequest
+	31,  // 106: TaskService.CreateTaskTemplate:input_type -> TaskTemplate
+	32,  // 107: TaskService.GetTaskTemplate:input_type -> TaskTemplateRequest
+	33,  // 108: TaskService.ListTaskTemplates:input_type -> ListTaskTemplatesRequest
+	31,  // 109: TaskService.UpdateTaskTemplate:input_type -> TaskTemplate
+	32,  // 110: TaskService.DeleteTaskTemplate:input_type -> TaskTemplateRequest
+	36,  // 111: TaskService.InstantiateTemplate:input_type -> InstantiateTemplateRequest
+	38,  // 112: TaskService.CreateCustomField:input_type -> CustomFieldDefinition
+	41,  // 113: TaskService.ListCustomFields:input_type -> ListCustomFieldsRequest
+	38,  // 114: TaskService.UpdateCustomField:input_type -> CustomFieldDefinition
+	40,  // 115: TaskService.DeleteCustomField:input_type -> CustomFieldRequest
+	45,  // 116: TaskService.ListSLAPolicies:input_type -> ListSLAPoliciesRequest
+	44,  // 117: TaskService.UpdateSLAPolicy:input_type -> SLAPolicy
+	47,  // 118: TaskService.CreateWebhook:input_type -> Webhook
+	49,  // 119: TaskService.ListWebhooks:input_type -> ListWebhooksRequest
+	47,  // 120: TaskService.UpdateWebhook:input_type -> Webhook
+	48,  // 121: TaskService.DeleteWebhook:input_type -> WebhookRequest
+	53,  // 122: TaskService.ListWebhookDeliveries:input_type -> ListWebhookDeliveriesRequest
+	55,  // 123: TaskService.RedeliverWebhook:input_type -> RedeliverWebhookRequest
+	57,  // 124: TaskService.ListNotifications:input_type -> ListNotificationsRequest
+	59,  // 125: TaskService.MarkRead:input_type -> MarkReadRequest
+	60,  // 126: TaskService.MarkAllRead:input_type -> MarkAllReadRequest
+	62,  // 127: TaskService.GetNextTasks:input_type -> GetNextTasksRequest
+	67,  // 128: TaskService.GetEisenhowerMatrix:input_type -> GetEisenhowerMatrixRequest
+	70,  // 129: TaskService.SetQuadrantOverride:input_type -> SetQuadrantOverrideRequest
+	72,  // 130: TaskService.SnoozeTask:input_type -> SnoozeTaskRequest
+	73,  // 131: TaskService.GetCalendar:input_type -> GetCalendarRequest
+	79,  // 132: TaskService.GetChecklist:input_type -> ChecklistRequest
+	80,  // 133: TaskService.AddChecklistItem:input_type -> AddChecklistItemRequest
+	81,  // 134: TaskService.ToggleChecklistItem:input_type -> ChecklistItemRequest
+	82,  // 135: TaskService.ReorderChecklist:input_type -> ReorderChecklistRequest
+	81,  // 136: TaskService.DeleteChecklistItem:input_type -> ChecklistItemRequest
+	81,  // 137: TaskService.ConvertChecklistItem:input_type -> ChecklistItemRequest
+	84,  // 138: TaskService.CloneTask:input_type -> CloneTaskRequest
+	86,  // 139: TaskService.ShareTask:input_type -> ShareTaskRequest
+	87,  // 140: TaskService.UnshareTask:input_type -> UnshareTaskRequest
+	89,  // 141: TaskService.ListShares:input_type -> ListSharesRequest
+	92,  // 142: TaskService.CreatePublicLink:input_type -> CreatePublicLinkRequest
+	93,  // 143: TaskService.ListPublicLinks:input_type -> ListPublicLinksRequest
+	95,  // 144: TaskService.RevokePublicLink:input_type -> RevokePublicLinkRequest
+	96,  // 145: TaskService.GetPublicTask:input_type -> GetPublicTaskRequest
+	9,   // 146: TaskService.CreateTask:output_type -> TaskResponse
+	9,   // 147: TaskService.GetTask:output_type -> TaskResponse
+	6,   // 148: TaskService.ListTasks:output_type -> ListTasksResponse
+	9,   // 149: TaskService.UpdateTask:output_type -> TaskResponse
+	10,  // 150: TaskService.DeleteTask:output_type -> DeleteTaskResponse
+	12,  // 151: TaskService.ImportICS:output_type -> ImportICSResponse
+	14,  // 152: TaskService.QuickAddTask:output_type -> QuickAddTaskResponse
+	18,  // 153: TaskService.GetTaskStats:output_type -> TaskStats
+	20,  // 154: TaskService.GetBurndown:output_type -> BurndownResponse
+	22,  // 155: TaskService.GetCumulativeFlow:output_type -> CumulativeFlowResponse
+	25,  // 156: TaskService.CreateSavedView:output_type -> SavedView
+	25,  // 157: TaskService.GetSavedView:output_type -> SavedView
+	28,  // 158: TaskService.ListSavedViews:output_type -> ListSavedViewsResponse
+	25,  // 159: TaskService.UpdateSavedView:output_type -> SavedView
+	29,  // 160: TaskService.DeleteSavedView:output_type -> DeleteSavedViewResponse
+	31,  // 161: TaskService.CreateTaskTemplate:output_type -> TaskTemplate
+	31,  // 162: TaskService.GetTaskTemplate:output_type -> TaskTemplate
+	34,  // 163: TaskService.ListTaskTemplates:output_type -> ListTaskTemplatesResponse
+	31,  // 164: TaskService.UpdateTaskTemplate:output_type -> TaskTemplate
+	35,  // 165: TaskService.DeleteTaskTemplate:output_type -> DeleteTaskTemplateResponse
+	37,  // 166: TaskService.InstantiateTemplate:output_type -> InstantiateTemplateResponse
+	38,  // 167: TaskService.CreateCustomField:output_type -> CustomFieldDefinition
+	42,  // 168: TaskService.ListCustomFields:output_type -> ListCustomFieldsResponse
+	38,  // 169: TaskService.UpdateCustomField:output_type -> CustomFieldDefinition
+	43,  // 170: TaskService.DeleteCustomField:output_type -> DeleteCustomFieldResponse
+	46,  // 171: TaskService.ListSLAPolicies:output_type -> ListSLAPoliciesResponse
+	44,  // 172: TaskService.UpdateSLAPolicy:output_type -> SLAPolicy
+	47,  // 173: TaskService.CreateWebhook:output_type -> Webhook
+	50,  // 174: TaskService.ListWebhooks:output_type -> ListWebhooksResponse
+	47,  // 175: TaskService.UpdateWebhook:output_type -> Webhook
+	51,  // 176: TaskService.DeleteWebhook:output_type -> DeleteWebhookResponse
+	54,  // 177: TaskService.ListWebhookDeliveries:output_type -> ListWebhookDeliveriesResponse
+	52,  // 178: TaskService.RedeliverWebhook:output_type -> WebhookDelivery
+	58,  // 179: TaskService.ListNotifications:output_type -> ListNotificationsResponse
+	61,  // 180: TaskService.MarkRead:output_type -> MarkReadResponse
+	61,  // 181: TaskService.MarkAllRead:output_type -> MarkReadResponse
+	65,  // 182: TaskService.GetNextTasks:output_type -> GetNextTasksResponse
+	69,  // 183: TaskService.GetEisenhowerMatrix:output_type -> GetEisenhowerMatrixResponse
+	71,  // 184: TaskService.SetQuadrantOverride:output_type -> SetQuadrantOverrideResponse
+	9,   // 185: TaskService.SnoozeTask:output_type -> TaskResponse
+	76,  // 186: TaskService.GetCalendar:output_type -> GetCalendarResponse
+	83,  // 187: TaskService.GetChecklist:output_type -> ChecklistResponse
+	83,  // 188: TaskService.AddChecklistItem:output_type -> ChecklistResponse
+	83,  // 189: TaskService.ToggleChecklistItem:output_type -> ChecklistResponse
+	83,  // 190: TaskService.ReorderChecklist:output_type -> ChecklistResponse
+	83,  // 191: TaskService.DeleteChecklistItem:output_type -> ChecklistResponse
+	9,   // 192: TaskService.ConvertChecklistItem:output_type -> TaskResponse
+	9,   // 193: TaskService.CloneTask:output_type -> TaskResponse
+	85,  // 194: TaskService.ShareTask:output_type -> TaskShare
+	88,  // 195: TaskService.UnshareTask:output_type -> UnshareTaskResponse
+	90,  // 196: TaskService.ListShares:output_type -> ListSharesResponse
+	91,  // 197: TaskService.CreatePublicLink:output_type -> PublicLink
+	94,  // 198: TaskService.ListPublicLinks:output_type -> ListPublicLinksResponse
+	91,  // 199: TaskService.RevokePublicLink:output_type -> PublicLink
+	97,  // 200: TaskService.GetPublicTask:output_type -> PublicTask
+	146, // [146:201] is the sub-list for method output_type
+	91,  // [91:146] is the sub-list for method input_type
+	91,  // [91:91] is the sub-list for extension type_name
+	91,  // [91:91] is the sub-list for extension extendee
+	0,   // [0:91] is the sub-list for field type_name
 }
 
 func init() { file_proto_task_proto_init() }
@@ -827,13 +7479,14 @@ func file_proto_task_proto_init() {
 	if File_proto_task_proto != nil {
 		return
 	}
+	file_proto_task_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_task_proto_rawDesc), len(file_proto_task_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   9,
+			NumMessages:   100,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
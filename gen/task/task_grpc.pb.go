@@ -27,6 +27,56 @@ type TaskServiceClient interface {
 	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
 	UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*TaskResponse, error)
 	DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*DeleteTaskResponse, error)
+	ImportICS(ctx context.Context, in *ImportICSRequest, opts ...grpc.CallOption) (*ImportICSResponse, error)
+	QuickAddTask(ctx context.Context, in *QuickAddTaskRequest, opts ...grpc.CallOption) (*QuickAddTaskResponse, error)
+	GetTaskStats(ctx context.Context, in *GetTaskStatsRequest, opts ...grpc.CallOption) (*TaskStats, error)
+	GetBurndown(ctx context.Context, in *GetTaskStatsRequest, opts ...grpc.CallOption) (*BurndownResponse, error)
+	GetCumulativeFlow(ctx context.Context, in *GetTaskStatsRequest, opts ...grpc.CallOption) (*CumulativeFlowResponse, error)
+	CreateSavedView(ctx context.Context, in *SavedView, opts ...grpc.CallOption) (*SavedView, error)
+	GetSavedView(ctx context.Context, in *SavedViewRequest, opts ...grpc.CallOption) (*SavedView, error)
+	ListSavedViews(ctx context.Context, in *ListSavedViewsRequest, opts ...grpc.CallOption) (*ListSavedViewsResponse, error)
+	UpdateSavedView(ctx context.Context, in *SavedView, opts ...grpc.CallOption) (*SavedView, error)
+	DeleteSavedView(ctx context.Context, in *SavedViewRequest, opts ...grpc.CallOption) (*DeleteSavedViewResponse, error)
+	CreateTaskTemplate(ctx context.Context, in *TaskTemplate, opts ...grpc.CallOption) (*TaskTemplate, error)
+	GetTaskTemplate(ctx context.Context, in *TaskTemplateRequest, opts ...grpc.CallOption) (*TaskTemplate, error)
+	ListTaskTemplates(ctx context.Context, in *ListTaskTemplatesRequest, opts ...grpc.CallOption) (*ListTaskTemplatesResponse, error)
+	UpdateTaskTemplate(ctx context.Context, in *TaskTemplate, opts ...grpc.CallOption) (*TaskTemplate, error)
+	DeleteTaskTemplate(ctx context.Context, in *TaskTemplateRequest, opts ...grpc.CallOption) (*DeleteTaskTemplateResponse, error)
+	InstantiateTemplate(ctx context.Context, in *InstantiateTemplateRequest, opts ...grpc.CallOption) (*InstantiateTemplateResponse, error)
+	CreateCustomField(ctx context.Context, in *CustomFieldDefinition, opts ...grpc.CallOption) (*CustomFieldDefinition, error)
+	ListCustomFields(ctx context.Context, in *ListCustomFieldsRequest, opts ...grpc.CallOption) (*ListCustomFieldsResponse, error)
+	UpdateCustomField(ctx context.Context, in *CustomFieldDefinition, opts ...grpc.CallOption) (*CustomFieldDefinition, error)
+	DeleteCustomField(ctx context.Context, in *CustomFieldRequest, opts ...grpc.CallOption) (*DeleteCustomFieldResponse, error)
+	ListSLAPolicies(ctx context.Context, in *ListSLAPoliciesRequest, opts ...grpc.CallOption) (*ListSLAPoliciesResponse, error)
+	UpdateSLAPolicy(ctx context.Context, in *SLAPolicy, opts ...grpc.CallOption) (*SLAPolicy, error)
+	CreateWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	UpdateWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error)
+	DeleteWebhook(ctx context.Context, in *WebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*WebhookDelivery, error)
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
+	MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*MarkReadResponse, error)
+	MarkAllRead(ctx context.Context, in *MarkAllReadRequest, opts ...grpc.CallOption) (*MarkReadResponse, error)
+	GetNextTasks(ctx context.Context, in *GetNextTasksRequest, opts ...grpc.CallOption) (*GetNextTasksResponse, error)
+	GetEisenhowerMatrix(ctx context.Context, in *GetEisenhowerMatrixRequest, opts ...grpc.CallOption) (*GetEisenhowerMatrixResponse, error)
+	SetQuadrantOverride(ctx context.Context, in *SetQuadrantOverrideRequest, opts ...grpc.CallOption) (*SetQuadrantOverrideResponse, error)
+	SnoozeTask(ctx context.Context, in *SnoozeTaskRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	GetCalendar(ctx context.Context, in *GetCalendarRequest, opts ...grpc.CallOption) (*GetCalendarResponse, error)
+	GetChecklist(ctx context.Context, in *ChecklistRequest, opts ...grpc.CallOption) (*ChecklistResponse, error)
+	AddChecklistItem(ctx context.Context, in *AddChecklistItemRequest, opts ...grpc.CallOption) (*ChecklistResponse, error)
+	ToggleChecklistItem(ctx context.Context, in *ChecklistItemRequest, opts ...grpc.CallOption) (*ChecklistResponse, error)
+	ReorderChecklist(ctx context.Context, in *ReorderChecklistRequest, opts ...grpc.CallOption) (*ChecklistResponse, error)
+	DeleteChecklistItem(ctx context.Context, in *ChecklistItemRequest, opts ...grpc.CallOption) (*ChecklistResponse, error)
+	ConvertChecklistItem(ctx context.Context, in *ChecklistItemRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	CloneTask(ctx context.Context, in *CloneTaskRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	ShareTask(ctx context.Context, in *ShareTaskRequest, opts ...grpc.CallOption) (*TaskShare, error)
+	UnshareTask(ctx context.Context, in *UnshareTaskRequest, opts ...grpc.CallOption) (*UnshareTaskResponse, error)
+	ListShares(ctx context.Context, in *ListSharesRequest, opts ...grpc.CallOption) (*ListSharesResponse, error)
+	CreatePublicLink(ctx context.Context, in *CreatePublicLinkRequest, opts ...grpc.CallOption) (*PublicLink, error)
+	ListPublicLinks(ctx context.Context, in *ListPublicLinksRequest, opts ...grpc.CallOption) (*ListPublicLinksResponse, error)
+	RevokePublicLink(ctx context.Context, in *RevokePublicLinkRequest, opts ...grpc.CallOption) (*PublicLink, error)
+	GetPublicTask(ctx context.Context, in *GetPublicTaskRequest, opts ...grpc.CallOption) (*PublicTask, error)
 }
 
 type taskServiceClient struct {
@@ -82,6 +132,456 @@ func (c *taskServiceClient) DeleteTask(ctx context.Context, in *DeleteTaskReques
 	return out, nil
 }
 
+func (c *taskServiceClient) ImportICS(ctx context.Context, in *ImportICSRequest, opts ...grpc.CallOption) (*ImportICSResponse, error) {
+	out := new(ImportICSResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/ImportICS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) QuickAddTask(ctx context.Context, in *QuickAddTaskRequest, opts ...grpc.CallOption) (*QuickAddTaskResponse, error) {
+	out := new(QuickAddTaskResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/QuickAddTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetTaskStats(ctx context.Context, in *GetTaskStatsRequest, opts ...grpc.CallOption) (*TaskStats, error) {
+	out := new(TaskStats)
+	err := c.cc.Invoke(ctx, "/TaskService/GetTaskStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetBurndown(ctx context.Context, in *GetTaskStatsRequest, opts ...grpc.CallOption) (*BurndownResponse, error) {
+	out := new(BurndownResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/GetBurndown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetCumulativeFlow(ctx context.Context, in *GetTaskStatsRequest, opts ...grpc.CallOption) (*CumulativeFlowResponse, error) {
+	out := new(CumulativeFlowResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/GetCumulativeFlow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CreateSavedView(ctx context.Context, in *SavedView, opts ...grpc.CallOption) (*SavedView, error) {
+	out := new(SavedView)
+	err := c.cc.Invoke(ctx, "/TaskService/CreateSavedView", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetSavedView(ctx context.Context, in *SavedViewRequest, opts ...grpc.CallOption) (*SavedView, error) {
+	out := new(SavedView)
+	err := c.cc.Invoke(ctx, "/TaskService/GetSavedView", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListSavedViews(ctx context.Context, in *ListSavedViewsRequest, opts ...grpc.CallOption) (*ListSavedViewsResponse, error) {
+	out := new(ListSavedViewsResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/ListSavedViews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UpdateSavedView(ctx context.Context, in *SavedView, opts ...grpc.CallOption) (*SavedView, error) {
+	out := new(SavedView)
+	err := c.cc.Invoke(ctx, "/TaskService/UpdateSavedView", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteSavedView(ctx context.Context, in *SavedViewRequest, opts ...grpc.CallOption) (*DeleteSavedViewResponse, error) {
+	out := new(DeleteSavedViewResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/DeleteSavedView", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CreateTaskTemplate(ctx context.Context, in *TaskTemplate, opts ...grpc.CallOption) (*TaskTemplate, error) {
+	out := new(TaskTemplate)
+	err := c.cc.Invoke(ctx, "/TaskService/CreateTaskTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetTaskTemplate(ctx context.Context, in *TaskTemplateRequest, opts ...grpc.CallOption) (*TaskTemplate, error) {
+	out := new(TaskTemplate)
+	err := c.cc.Invoke(ctx, "/TaskService/GetTaskTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListTaskTemplates(ctx context.Context, in *ListTaskTemplatesRequest, opts ...grpc.CallOption) (*ListTaskTemplatesResponse, error) {
+	out := new(ListTaskTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/ListTaskTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UpdateTaskTemplate(ctx context.Context, in *TaskTemplate, opts ...grpc.CallOption) (*TaskTemplate, error) {
+	out := new(TaskTemplate)
+	err := c.cc.Invoke(ctx, "/TaskService/UpdateTaskTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteTaskTemplate(ctx context.Context, in *TaskTemplateRequest, opts ...grpc.CallOption) (*DeleteTaskTemplateResponse, error) {
+	out := new(DeleteTaskTemplateResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/DeleteTaskTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) InstantiateTemplate(ctx context.Context, in *InstantiateTemplateRequest, opts ...grpc.CallOption) (*InstantiateTemplateResponse, error) {
+	out := new(InstantiateTemplateResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/InstantiateTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CreateCustomField(ctx context.Context, in *CustomFieldDefinition, opts ...grpc.CallOption) (*CustomFieldDefinition, error) {
+	out := new(CustomFieldDefinition)
+	err := c.cc.Invoke(ctx, "/TaskService/CreateCustomField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListCustomFields(ctx context.Context, in *ListCustomFieldsRequest, opts ...grpc.CallOption) (*ListCustomFieldsResponse, error) {
+	out := new(ListCustomFieldsResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/ListCustomFields", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UpdateCustomField(ctx context.Context, in *CustomFieldDefinition, opts ...grpc.CallOption) (*CustomFieldDefinition, error) {
+	out := new(CustomFieldDefinition)
+	err := c.cc.Invoke(ctx, "/TaskService/UpdateCustomField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteCustomField(ctx context.Context, in *CustomFieldRequest, opts ...grpc.CallOption) (*DeleteCustomFieldResponse, error) {
+	out := new(DeleteCustomFieldResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/DeleteCustomField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListSLAPolicies(ctx context.Context, in *ListSLAPoliciesRequest, opts ...grpc.CallOption) (*ListSLAPoliciesResponse, error) {
+	out := new(ListSLAPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/ListSLAPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UpdateSLAPolicy(ctx context.Context, in *SLAPolicy, opts ...grpc.CallOption) (*SLAPolicy, error) {
+	out := new(SLAPolicy)
+	err := c.cc.Invoke(ctx, "/TaskService/UpdateSLAPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CreateWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, "/TaskService/CreateWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/ListWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UpdateWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, "/TaskService/UpdateWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteWebhook(ctx context.Context, in *WebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/DeleteWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/ListWebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*WebhookDelivery, error) {
+	out := new(WebhookDelivery)
+	err := c.cc.Invoke(ctx, "/TaskService/RedeliverWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error) {
+	out := new(ListNotificationsResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/ListNotifications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*MarkReadResponse, error) {
+	out := new(MarkReadResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/MarkRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) MarkAllRead(ctx context.Context, in *MarkAllReadRequest, opts ...grpc.CallOption) (*MarkReadResponse, error) {
+	out := new(MarkReadResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/MarkAllRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetNextTasks(ctx context.Context, in *GetNextTasksRequest, opts ...grpc.CallOption) (*GetNextTasksResponse, error) {
+	out := new(GetNextTasksResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/GetNextTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetEisenhowerMatrix(ctx context.Context, in *GetEisenhowerMatrixRequest, opts ...grpc.CallOption) (*GetEisenhowerMatrixResponse, error) {
+	out := new(GetEisenhowerMatrixResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/GetEisenhowerMatrix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) SetQuadrantOverride(ctx context.Context, in *SetQuadrantOverrideRequest, opts ...grpc.CallOption) (*SetQuadrantOverrideResponse, error) {
+	out := new(SetQuadrantOverrideResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/SetQuadrantOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) SnoozeTask(ctx context.Context, in *SnoozeTaskRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/SnoozeTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetCalendar(ctx context.Context, in *GetCalendarRequest, opts ...grpc.CallOption) (*GetCalendarResponse, error) {
+	out := new(GetCalendarResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/GetCalendar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetChecklist(ctx context.Context, in *ChecklistRequest, opts ...grpc.CallOption) (*ChecklistResponse, error) {
+	out := new(ChecklistResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/GetChecklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) AddChecklistItem(ctx context.Context, in *AddChecklistItemRequest, opts ...grpc.CallOption) (*ChecklistResponse, error) {
+	out := new(ChecklistResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/AddChecklistItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ToggleChecklistItem(ctx context.Context, in *ChecklistItemRequest, opts ...grpc.CallOption) (*ChecklistResponse, error) {
+	out := new(ChecklistResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/ToggleChecklistItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ReorderChecklist(ctx context.Context, in *ReorderChecklistRequest, opts ...grpc.CallOption) (*ChecklistResponse, error) {
+	out := new(ChecklistResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/ReorderChecklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteChecklistItem(ctx context.Context, in *ChecklistItemRequest, opts ...grpc.CallOption) (*ChecklistResponse, error) {
+	out := new(ChecklistResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/DeleteChecklistItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ConvertChecklistItem(ctx context.Context, in *ChecklistItemRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/ConvertChecklistItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CloneTask(ctx context.Context, in *CloneTaskRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/CloneTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ShareTask(ctx context.Context, in *ShareTaskRequest, opts ...grpc.CallOption) (*TaskShare, error) {
+	out := new(TaskShare)
+	err := c.cc.Invoke(ctx, "/TaskService/ShareTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UnshareTask(ctx context.Context, in *UnshareTaskRequest, opts ...grpc.CallOption) (*UnshareTaskResponse, error) {
+	out := new(UnshareTaskResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/UnshareTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListShares(ctx context.Context, in *ListSharesRequest, opts ...grpc.CallOption) (*ListSharesResponse, error) {
+	out := new(ListSharesResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/ListShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CreatePublicLink(ctx context.Context, in *CreatePublicLinkRequest, opts ...grpc.CallOption) (*PublicLink, error) {
+	out := new(PublicLink)
+	err := c.cc.Invoke(ctx, "/TaskService/CreatePublicLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListPublicLinks(ctx context.Context, in *ListPublicLinksRequest, opts ...grpc.CallOption) (*ListPublicLinksResponse, error) {
+	out := new(ListPublicLinksResponse)
+	err := c.cc.Invoke(ctx, "/TaskService/ListPublicLinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RevokePublicLink(ctx context.Context, in *RevokePublicLinkRequest, opts ...grpc.CallOption) (*PublicLink, error) {
+	out := new(PublicLink)
+	err := c.cc.Invoke(ctx, "/TaskService/RevokePublicLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetPublicTask(ctx context.Context, in *GetPublicTaskRequest, opts ...grpc.CallOption) (*PublicTask, error) {
+	out := new(PublicTask)
+	err := c.cc.Invoke(ctx, "/TaskService/GetPublicTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaskServiceServer is the server API for TaskService service.
 // All implementations must embed UnimplementedTaskServiceServer
 // for forward compatibility
@@ -91,127 +591,1227 @@ type TaskServiceServer interface {
 	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
 	UpdateTask(context.Context, *UpdateTaskRequest) (*TaskResponse, error)
 	DeleteTask(context.Context, *DeleteTaskRequest) (*DeleteTaskResponse, error)
+	ImportICS(context.Context, *ImportICSRequest) (*ImportICSResponse, error)
+	QuickAddTask(context.Context, *QuickAddTaskRequest) (*QuickAddTaskResponse, error)
+	GetTaskStats(context.Context, *GetTaskStatsRequest) (*TaskStats, error)
+	GetBurndown(context.Context, *GetTaskStatsRequest) (*BurndownResponse, error)
+	GetCumulativeFlow(context.Context, *GetTaskStatsRequest) (*CumulativeFlowResponse, error)
+	CreateSavedView(context.Context, *SavedView) (*SavedView, error)
+	GetSavedView(context.Context, *SavedViewRequest) (*SavedView, error)
+	ListSavedViews(context.Context, *ListSavedViewsRequest) (*ListSavedViewsResponse, error)
+	UpdateSavedView(context.Context, *SavedView) (*SavedView, error)
+	DeleteSavedView(context.Context, *SavedViewRequest) (*DeleteSavedViewResponse, error)
+	CreateTaskTemplate(context.Context, *TaskTemplate) (*TaskTemplate, error)
+	GetTaskTemplate(context.Context, *TaskTemplateRequest) (*TaskTemplate, error)
+	ListTaskTemplates(context.Context, *ListTaskTemplatesRequest) (*ListTaskTemplatesResponse, error)
+	UpdateTaskTemplate(context.Context, *TaskTemplate) (*TaskTemplate, error)
+	DeleteTaskTemplate(context.Context, *TaskTemplateRequest) (*DeleteTaskTemplateResponse, error)
+	InstantiateTemplate(context.Context, *InstantiateTemplateRequest) (*InstantiateTemplateResponse, error)
+	CreateCustomField(context.Context, *CustomFieldDefinition) (*CustomFieldDefinition, error)
+	ListCustomFields(context.Context, *ListCustomFieldsRequest) (*ListCustomFieldsResponse, error)
+	UpdateCustomField(context.Context, *CustomFieldDefinition) (*CustomFieldDefinition, error)
+	DeleteCustomField(context.Context, *CustomFieldRequest) (*DeleteCustomFieldResponse, error)
+	ListSLAPolicies(context.Context, *ListSLAPoliciesRequest) (*ListSLAPoliciesResponse, error)
+	UpdateSLAPolicy(context.Context, *SLAPolicy) (*SLAPolicy, error)
+	CreateWebhook(context.Context, *Webhook) (*Webhook, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	UpdateWebhook(context.Context, *Webhook) (*Webhook, error)
+	DeleteWebhook(context.Context, *WebhookRequest) (*DeleteWebhookResponse, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*WebhookDelivery, error)
+	ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
+	MarkRead(context.Context, *MarkReadRequest) (*MarkReadResponse, error)
+	MarkAllRead(context.Context, *MarkAllReadRequest) (*MarkReadResponse, error)
+	GetNextTasks(context.Context, *GetNextTasksRequest) (*GetNextTasksResponse, error)
+	GetEisenhowerMatrix(context.Context, *GetEisenhowerMatrixRequest) (*GetEisenhowerMatrixResponse, error)
+	SetQuadrantOverride(context.Context, *SetQuadrantOverrideRequest) (*SetQuadrantOverrideResponse, error)
+	SnoozeTask(context.Context, *SnoozeTaskRequest) (*TaskResponse, error)
+	GetCalendar(context.Context, *GetCalendarRequest) (*GetCalendarResponse, error)
+	GetChecklist(context.Context, *ChecklistRequest) (*ChecklistResponse, error)
+	AddChecklistItem(context.Context, *AddChecklistItemRequest) (*ChecklistResponse, error)
+	ToggleChecklistItem(context.Context, *ChecklistItemRequest) (*ChecklistResponse, error)
+	ReorderChecklist(context.Context, *ReorderChecklistRequest) (*ChecklistResponse, error)
+	DeleteChecklistItem(context.Context, *ChecklistItemRequest) (*ChecklistResponse, error)
+	ConvertChecklistItem(context.Context, *ChecklistItemRequest) (*TaskResponse, error)
+	CloneTask(context.Context, *CloneTaskRequest) (*TaskResponse, error)
+	ShareTask(context.Context, *ShareTaskRequest) (*TaskShare, error)
+	UnshareTask(context.Context, *UnshareTaskRequest) (*UnshareTaskResponse, error)
+	ListShares(context.Context, *ListSharesRequest) (*ListSharesResponse, error)
+	CreatePublicLink(context.Context, *CreatePublicLinkRequest) (*PublicLink, error)
+	ListPublicLinks(context.Context, *ListPublicLinksRequest) (*ListPublicLinksResponse, error)
+	RevokePublicLink(context.Context, *RevokePublicLinkRequest) (*PublicLink, error)
+	GetPublicTask(context.Context, *GetPublicTaskRequest) (*PublicTask, error)
+	mustEmbedUnimplementedTaskServiceServer()
+}
+
+// UnimplementedTaskServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTaskServiceServer struct {
+}
+
+func (UnimplementedTaskServiceServer) CreateTask(context.Context, *Task) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTask not implemented")
+}
+func (UnimplementedTaskServiceServer) GetTask(context.Context, *GetTaskRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTask not implemented")
+}
+func (UnimplementedTaskServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) UpdateTask(context.Context, *UpdateTaskRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTask not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteTask(context.Context, *DeleteTaskRequest) (*DeleteTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTask not implemented")
+}
+func (UnimplementedTaskServiceServer) ImportICS(context.Context, *ImportICSRequest) (*ImportICSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportICS not implemented")
+}
+func (UnimplementedTaskServiceServer) QuickAddTask(context.Context, *QuickAddTaskRequest) (*QuickAddTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuickAddTask not implemented")
+}
+func (UnimplementedTaskServiceServer) GetTaskStats(context.Context, *GetTaskStatsRequest) (*TaskStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskStats not implemented")
+}
+func (UnimplementedTaskServiceServer) GetBurndown(context.Context, *GetTaskStatsRequest) (*BurndownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBurndown not implemented")
+}
+func (UnimplementedTaskServiceServer) GetCumulativeFlow(context.Context, *GetTaskStatsRequest) (*CumulativeFlowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCumulativeFlow not implemented")
+}
+func (UnimplementedTaskServiceServer) CreateSavedView(context.Context, *SavedView) (*SavedView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSavedView not implemented")
+}
+func (UnimplementedTaskServiceServer) GetSavedView(context.Context, *SavedViewRequest) (*SavedView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSavedView not implemented")
+}
+func (UnimplementedTaskServiceServer) ListSavedViews(context.Context, *ListSavedViewsRequest) (*ListSavedViewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedViews not implemented")
+}
+func (UnimplementedTaskServiceServer) UpdateSavedView(context.Context, *SavedView) (*SavedView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSavedView not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteSavedView(context.Context, *SavedViewRequest) (*DeleteSavedViewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedView not implemented")
+}
+func (UnimplementedTaskServiceServer) CreateTaskTemplate(context.Context, *TaskTemplate) (*TaskTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTaskTemplate not implemented")
+}
+func (UnimplementedTaskServiceServer) GetTaskTemplate(context.Context, *TaskTemplateRequest) (*TaskTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskTemplate not implemented")
+}
+func (UnimplementedTaskServiceServer) ListTaskTemplates(context.Context, *ListTaskTemplatesRequest) (*ListTaskTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskTemplates not implemented")
+}
+func (UnimplementedTaskServiceServer) UpdateTaskTemplate(context.Context, *TaskTemplate) (*TaskTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskTemplate not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteTaskTemplate(context.Context, *TaskTemplateRequest) (*DeleteTaskTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTaskTemplate not implemented")
+}
+func (UnimplementedTaskServiceServer) InstantiateTemplate(context.Context, *InstantiateTemplateRequest) (*InstantiateTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantiateTemplate not implemented")
+}
+func (UnimplementedTaskServiceServer) CreateCustomField(context.Context, *CustomFieldDefinition) (*CustomFieldDefinition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCustomField not implemented")
+}
+func (UnimplementedTaskServiceServer) ListCustomFields(context.Context, *ListCustomFieldsRequest) (*ListCustomFieldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCustomFields not implemented")
+}
+func (UnimplementedTaskServiceServer) UpdateCustomField(context.Context, *CustomFieldDefinition) (*CustomFieldDefinition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomField not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteCustomField(context.Context, *CustomFieldRequest) (*DeleteCustomFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomField not implemented")
+}
+func (UnimplementedTaskServiceServer) ListSLAPolicies(context.Context, *ListSLAPoliciesRequest) (*ListSLAPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSLAPolicies not implemented")
+}
+func (UnimplementedTaskServiceServer) UpdateSLAPolicy(context.Context, *SLAPolicy) (*SLAPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSLAPolicy not implemented")
+}
+func (UnimplementedTaskServiceServer) CreateWebhook(context.Context, *Webhook) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedTaskServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedTaskServiceServer) UpdateWebhook(context.Context, *Webhook) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteWebhook(context.Context, *WebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedTaskServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedTaskServiceServer) RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*WebhookDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhook not implemented")
+}
+func (UnimplementedTaskServiceServer) ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedTaskServiceServer) MarkRead(context.Context, *MarkReadRequest) (*MarkReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkRead not implemented")
+}
+func (UnimplementedTaskServiceServer) MarkAllRead(context.Context, *MarkAllReadRequest) (*MarkReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAllRead not implemented")
+}
+func (UnimplementedTaskServiceServer) GetNextTasks(context.Context, *GetNextTasksRequest) (*GetNextTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNextTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) GetEisenhowerMatrix(context.Context, *GetEisenhowerMatrixRequest) (*GetEisenhowerMatrixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEisenhowerMatrix not implemented")
+}
+func (UnimplementedTaskServiceServer) SetQuadrantOverride(context.Context, *SetQuadrantOverrideRequest) (*SetQuadrantOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQuadrantOverride not implemented")
+}
+func (UnimplementedTaskServiceServer) SnoozeTask(context.Context, *SnoozeTaskRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnoozeTask not implemented")
+}
+func (UnimplementedTaskServiceServer) GetCalendar(context.Context, *GetCalendarRequest) (*GetCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendar not implemented")
+}
+func (UnimplementedTaskServiceServer) GetChecklist(context.Context, *ChecklistRequest) (*ChecklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChecklist not implemented")
+}
+func (UnimplementedTaskServiceServer) AddChecklistItem(context.Context, *AddChecklistItemRequest) (*ChecklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddChecklistItem not implemented")
+}
+func (UnimplementedTaskServiceServer) ToggleChecklistItem(context.Context, *ChecklistItemRequest) (*ChecklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToggleChecklistItem not implemented")
+}
+func (UnimplementedTaskServiceServer) ReorderChecklist(context.Context, *ReorderChecklistRequest) (*ChecklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderChecklist not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteChecklistItem(context.Context, *ChecklistItemRequest) (*ChecklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChecklistItem not implemented")
+}
+func (UnimplementedTaskServiceServer) ConvertChecklistItem(context.Context, *ChecklistItemRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertChecklistItem not implemented")
+}
+func (UnimplementedTaskServiceServer) CloneTask(context.Context, *CloneTaskRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneTask not implemented")
+}
+func (UnimplementedTaskServiceServer) ShareTask(context.Context, *ShareTaskRequest) (*TaskShare, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareTask not implemented")
+}
+func (UnimplementedTaskServiceServer) UnshareTask(context.Context, *UnshareTaskRequest) (*UnshareTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshareTask not implemented")
+}
+func (UnimplementedTaskServiceServer) ListShares(context.Context, *ListSharesRequest) (*ListSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShares not implemented")
+}
+func (UnimplementedTaskServiceServer) CreatePublicLink(context.Context, *CreatePublicLinkRequest) (*PublicLink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePublicLink not implemented")
+}
+func (UnimplementedTaskServiceServer) ListPublicLinks(context.Context, *ListPublicLinksRequest) (*ListPublicLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPublicLinks not implemented")
+}
+func (UnimplementedTaskServiceServer) RevokePublicLink(context.Context, *RevokePublicLinkRequest) (*PublicLink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePublicLink not implemented")
+}
+func (UnimplementedTaskServiceServer) GetPublicTask(context.Context, *GetPublicTaskRequest) (*PublicTask, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicTask not implemented")
+}
+func (UnimplementedTaskServiceServer) mustEmbedUnimplementedTaskServiceServer() {}
+
+// UnsafeTaskServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaskServiceServer will
+// result in compilation errors.
+type UnsafeTaskServiceServer interface {
 	mustEmbedUnimplementedTaskServiceServer()
 }
 
-// UnimplementedTaskServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedTaskServiceServer struct {
+func RegisterTaskServiceServer(s grpc.ServiceRegistrar, srv TaskServiceServer) {
+	s.RegisterService(&TaskService_ServiceDesc, srv)
+}
+
+func _TaskService_CreateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Task)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).CreateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/CreateTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CreateTask(ctx, req.(*Task))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/GetTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTask(ctx, req.(*GetTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/ListTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTasks(ctx, req.(*ListTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).UpdateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/UpdateTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateTask(ctx, req.(*UpdateTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).DeleteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/DeleteTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteTask(ctx, req.(*DeleteTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ImportICS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportICSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ImportICS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/ImportICS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ImportICS(ctx, req.(*ImportICSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_QuickAddTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuickAddTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).QuickAddTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/QuickAddTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).QuickAddTask(ctx, req.(*QuickAddTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetTaskStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetTaskStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/GetTaskStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTaskStats(ctx, req.(*GetTaskStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetBurndown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetBurndown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/GetBurndown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetBurndown(ctx, req.(*GetTaskStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetCumulativeFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetCumulativeFlow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/GetCumulativeFlow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetCumulativeFlow(ctx, req.(*GetTaskStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_CreateSavedView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavedView)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).CreateSavedView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/CreateSavedView",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CreateSavedView(ctx, req.(*SavedView))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetSavedView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavedViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetSavedView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/GetSavedView",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetSavedView(ctx, req.(*SavedViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListSavedViews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedViewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListSavedViews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/ListSavedViews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListSavedViews(ctx, req.(*ListSavedViewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_UpdateSavedView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavedView)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).UpdateSavedView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/UpdateSavedView",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateSavedView(ctx, req.(*SavedView))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_DeleteSavedView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavedViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).DeleteSavedView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/DeleteSavedView",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteSavedView(ctx, req.(*SavedViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_CreateTaskTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).CreateTaskTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/CreateTaskTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CreateTaskTemplate(ctx, req.(*TaskTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetTaskTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetTaskTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/GetTaskTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTaskTemplate(ctx, req.(*TaskTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListTaskTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListTaskTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/ListTaskTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTaskTemplates(ctx, req.(*ListTaskTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_UpdateTaskTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).UpdateTaskTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/UpdateTaskTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateTaskTemplate(ctx, req.(*TaskTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_DeleteTaskTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).DeleteTaskTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/DeleteTaskTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteTaskTemplate(ctx, req.(*TaskTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_InstantiateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstantiateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).InstantiateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/InstantiateTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).InstantiateTemplate(ctx, req.(*InstantiateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_CreateCustomField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CustomFieldDefinition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).CreateCustomField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/CreateCustomField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CreateCustomField(ctx, req.(*CustomFieldDefinition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListCustomFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCustomFieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListCustomFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/ListCustomFields",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListCustomFields(ctx, req.(*ListCustomFieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_UpdateCustomField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CustomFieldDefinition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).UpdateCustomField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/UpdateCustomField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateCustomField(ctx, req.(*CustomFieldDefinition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_DeleteCustomField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CustomFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).DeleteCustomField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/DeleteCustomField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteCustomField(ctx, req.(*CustomFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListSLAPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSLAPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListSLAPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/ListSLAPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListSLAPolicies(ctx, req.(*ListSLAPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_UpdateSLAPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SLAPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).UpdateSLAPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/UpdateSLAPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateSLAPolicy(ctx, req.(*SLAPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Webhook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/CreateWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CreateWebhook(ctx, req.(*Webhook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/ListWebhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Webhook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).UpdateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/UpdateWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateWebhook(ctx, req.(*Webhook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/DeleteWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteWebhook(ctx, req.(*WebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/ListWebhookDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_RedeliverWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).RedeliverWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/RedeliverWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RedeliverWebhook(ctx, req.(*RedeliverWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/ListNotifications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_MarkRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).MarkRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/MarkRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).MarkRead(ctx, req.(*MarkReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_MarkAllRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAllReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).MarkAllRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/MarkAllRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).MarkAllRead(ctx, req.(*MarkAllReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetNextTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNextTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetNextTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/GetNextTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetNextTasks(ctx, req.(*GetNextTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetEisenhowerMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEisenhowerMatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetEisenhowerMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/GetEisenhowerMatrix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetEisenhowerMatrix(ctx, req.(*GetEisenhowerMatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_SetQuadrantOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQuadrantOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).SetQuadrantOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/SetQuadrantOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).SetQuadrantOverride(ctx, req.(*SetQuadrantOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_SnoozeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnoozeTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).SnoozeTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/SnoozeTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).SnoozeTask(ctx, req.(*SnoozeTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/GetCalendar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetCalendar(ctx, req.(*GetCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetChecklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChecklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetChecklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/GetChecklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetChecklist(ctx, req.(*ChecklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedTaskServiceServer) CreateTask(context.Context, *Task) (*TaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTask not implemented")
+func _TaskService_AddChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).AddChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/AddChecklistItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).AddChecklistItem(ctx, req.(*AddChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTaskServiceServer) GetTask(context.Context, *GetTaskRequest) (*TaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTask not implemented")
+
+func _TaskService_ToggleChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ToggleChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/ToggleChecklistItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ToggleChecklistItem(ctx, req.(*ChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTaskServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
+
+func _TaskService_ReorderChecklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderChecklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ReorderChecklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/ReorderChecklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ReorderChecklist(ctx, req.(*ReorderChecklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTaskServiceServer) UpdateTask(context.Context, *UpdateTaskRequest) (*TaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTask not implemented")
+
+func _TaskService_DeleteChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).DeleteChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/DeleteChecklistItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteChecklistItem(ctx, req.(*ChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTaskServiceServer) DeleteTask(context.Context, *DeleteTaskRequest) (*DeleteTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTask not implemented")
+
+func _TaskService_ConvertChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ConvertChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/ConvertChecklistItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ConvertChecklistItem(ctx, req.(*ChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTaskServiceServer) mustEmbedUnimplementedTaskServiceServer() {}
 
-// UnsafeTaskServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to TaskServiceServer will
-// result in compilation errors.
-type UnsafeTaskServiceServer interface {
-	mustEmbedUnimplementedTaskServiceServer()
+func _TaskService_CloneTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).CloneTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/CloneTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CloneTask(ctx, req.(*CloneTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterTaskServiceServer(s grpc.ServiceRegistrar, srv TaskServiceServer) {
-	s.RegisterService(&TaskService_ServiceDesc, srv)
+func _TaskService_ShareTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ShareTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/ShareTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ShareTask(ctx, req.(*ShareTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _TaskService_CreateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Task)
+func _TaskService_UnshareTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareTaskRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaskServiceServer).CreateTask(ctx, in)
+		return srv.(TaskServiceServer).UnshareTask(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/TaskService/CreateTask",
+		FullMethod: "/TaskService/UnshareTask",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).CreateTask(ctx, req.(*Task))
+		return srv.(TaskServiceServer).UnshareTask(ctx, req.(*UnshareTaskRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaskService_GetTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTaskRequest)
+func _TaskService_ListShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSharesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaskServiceServer).GetTask(ctx, in)
+		return srv.(TaskServiceServer).ListShares(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/TaskService/GetTask",
+		FullMethod: "/TaskService/ListShares",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).GetTask(ctx, req.(*GetTaskRequest))
+		return srv.(TaskServiceServer).ListShares(ctx, req.(*ListSharesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaskService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTasksRequest)
+func _TaskService_CreatePublicLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePublicLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaskServiceServer).ListTasks(ctx, in)
+		return srv.(TaskServiceServer).CreatePublicLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/TaskService/ListTasks",
+		FullMethod: "/TaskService/CreatePublicLink",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).ListTasks(ctx, req.(*ListTasksRequest))
+		return srv.(TaskServiceServer).CreatePublicLink(ctx, req.(*CreatePublicLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaskService_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTaskRequest)
+func _TaskService_ListPublicLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPublicLinksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaskServiceServer).UpdateTask(ctx, in)
+		return srv.(TaskServiceServer).ListPublicLinks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/TaskService/UpdateTask",
+		FullMethod: "/TaskService/ListPublicLinks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).UpdateTask(ctx, req.(*UpdateTaskRequest))
+		return srv.(TaskServiceServer).ListPublicLinks(ctx, req.(*ListPublicLinksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaskService_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTaskRequest)
+func _TaskService_RevokePublicLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokePublicLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaskServiceServer).DeleteTask(ctx, in)
+		return srv.(TaskServiceServer).RevokePublicLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/TaskService/DeleteTask",
+		FullMethod: "/TaskService/RevokePublicLink",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).DeleteTask(ctx, req.(*DeleteTaskRequest))
+		return srv.(TaskServiceServer).RevokePublicLink(ctx, req.(*RevokePublicLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetPublicTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetPublicTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TaskService/GetPublicTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetPublicTask(ctx, req.(*GetPublicTaskRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -243,6 +1843,206 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTask",
 			Handler:    _TaskService_DeleteTask_Handler,
 		},
+		{
+			MethodName: "ImportICS",
+			Handler:    _TaskService_ImportICS_Handler,
+		},
+		{
+			MethodName: "QuickAddTask",
+			Handler:    _TaskService_QuickAddTask_Handler,
+		},
+		{
+			MethodName: "GetTaskStats",
+			Handler:    _TaskService_GetTaskStats_Handler,
+		},
+		{
+			MethodName: "GetBurndown",
+			Handler:    _TaskService_GetBurndown_Handler,
+		},
+		{
+			MethodName: "GetCumulativeFlow",
+			Handler:    _TaskService_GetCumulativeFlow_Handler,
+		},
+		{
+			MethodName: "CreateSavedView",
+			Handler:    _TaskService_CreateSavedView_Handler,
+		},
+		{
+			MethodName: "GetSavedView",
+			Handler:    _TaskService_GetSavedView_Handler,
+		},
+		{
+			MethodName: "ListSavedViews",
+			Handler:    _TaskService_ListSavedViews_Handler,
+		},
+		{
+			MethodName: "UpdateSavedView",
+			Handler:    _TaskService_UpdateSavedView_Handler,
+		},
+		{
+			MethodName: "DeleteSavedView",
+			Handler:    _TaskService_DeleteSavedView_Handler,
+		},
+		{
+			MethodName: "CreateTaskTemplate",
+			Handler:    _TaskService_CreateTaskTemplate_Handler,
+		},
+		{
+			MethodName: "GetTaskTemplate",
+			Handler:    _TaskService_GetTaskTemplate_Handler,
+		},
+		{
+			MethodName: "ListTaskTemplates",
+			Handler:    _TaskService_ListTaskTemplates_Handler,
+		},
+		{
+			MethodName: "UpdateTaskTemplate",
+			Handler:    _TaskService_UpdateTaskTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteTaskTemplate",
+			Handler:    _TaskService_DeleteTaskTemplate_Handler,
+		},
+		{
+			MethodName: "InstantiateTemplate",
+			Handler:    _TaskService_InstantiateTemplate_Handler,
+		},
+		{
+			MethodName: "CreateCustomField",
+			Handler:    _TaskService_CreateCustomField_Handler,
+		},
+		{
+			MethodName: "ListCustomFields",
+			Handler:    _TaskService_ListCustomFields_Handler,
+		},
+		{
+			MethodName: "UpdateCustomField",
+			Handler:    _TaskService_UpdateCustomField_Handler,
+		},
+		{
+			MethodName: "DeleteCustomField",
+			Handler:    _TaskService_DeleteCustomField_Handler,
+		},
+		{
+			MethodName: "ListSLAPolicies",
+			Handler:    _TaskService_ListSLAPolicies_Handler,
+		},
+		{
+			MethodName: "UpdateSLAPolicy",
+			Handler:    _TaskService_UpdateSLAPolicy_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _TaskService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _TaskService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _TaskService_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _TaskService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _TaskService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhook",
+			Handler:    _TaskService_RedeliverWebhook_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _TaskService_ListNotifications_Handler,
+		},
+		{
+			MethodName: "MarkRead",
+			Handler:    _TaskService_MarkRead_Handler,
+		},
+		{
+			MethodName: "MarkAllRead",
+			Handler:    _TaskService_MarkAllRead_Handler,
+		},
+		{
+			MethodName: "GetNextTasks",
+			Handler:    _TaskService_GetNextTasks_Handler,
+		},
+		{
+			MethodName: "GetEisenhowerMatrix",
+			Handler:    _TaskService_GetEisenhowerMatrix_Handler,
+		},
+		{
+			MethodName: "SetQuadrantOverride",
+			Handler:    _TaskService_SetQuadrantOverride_Handler,
+		},
+		{
+			MethodName: "SnoozeTask",
+			Handler:    _TaskService_SnoozeTask_Handler,
+		},
+		{
+			MethodName: "GetCalendar",
+			Handler:    _TaskService_GetCalendar_Handler,
+		},
+		{
+			MethodName: "GetChecklist",
+			Handler:    _TaskService_GetChecklist_Handler,
+		},
+		{
+			MethodName: "AddChecklistItem",
+			Handler:    _TaskService_AddChecklistItem_Handler,
+		},
+		{
+			MethodName: "ToggleChecklistItem",
+			Handler:    _TaskService_ToggleChecklistItem_Handler,
+		},
+		{
+			MethodName: "ReorderChecklist",
+			Handler:    _TaskService_ReorderChecklist_Handler,
+		},
+		{
+			MethodName: "DeleteChecklistItem",
+			Handler:    _TaskService_DeleteChecklistItem_Handler,
+		},
+		{
+			MethodName: "ConvertChecklistItem",
+			Handler:    _TaskService_ConvertChecklistItem_Handler,
+		},
+		{
+			MethodName: "CloneTask",
+			Handler:    _TaskService_CloneTask_Handler,
+		},
+		{
+			MethodName: "ShareTask",
+			Handler:    _TaskService_ShareTask_Handler,
+		},
+		{
+			MethodName: "UnshareTask",
+			Handler:    _TaskService_UnshareTask_Handler,
+		},
+		{
+			MethodName: "ListShares",
+			Handler:    _TaskService_ListShares_Handler,
+		},
+		{
+			MethodName: "CreatePublicLink",
+			Handler:    _TaskService_CreatePublicLink_Handler,
+		},
+		{
+			MethodName: "ListPublicLinks",
+			Handler:    _TaskService_ListPublicLinks_Handler,
+		},
+		{
+			MethodName: "RevokePublicLink",
+			Handler:    _TaskService_RevokePublicLink_Handler,
+		},
+		{
+			MethodName: "GetPublicTask",
+			Handler:    _TaskService_GetPublicTask_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/task.proto",
@@ -0,0 +1,71 @@
+package apierror
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Стабильные коды ErrorResponse.error для ошибок сервисов. Клиенты опираются на них,
+// поэтому менять существующие значения нельзя
+const (
+	CodeInvalidArgument    = "INVALID_ARGUMENT"
+	CodeUnauthorized       = "UNAUTHORIZED"
+	CodeForbidden          = "FORBIDDEN"
+	CodeNotFound           = "NOT_FOUND"
+	CodeAlreadyExists      = "ALREADY_EXISTS"
+	CodeInternal           = "INTERNAL_ERROR"
+	CodeServiceUnavailable = "SERVICE_UNAVAILABLE"
+	CodeTimeout            = "TIMEOUT"
+)
+
+type mapping struct {
+	status int
+	code   string
+	// message заменяет текст статуса gRPC, чтобы наружу не попадали детали серверных ошибок
+	message string
+}
+
+var mappings = map[codes.Code]mapping{
+	codes.InvalidArgument:  {status: http.StatusBadRequest, code: CodeInvalidArgument},
+	codes.Unauthenticated:  {status: http.StatusUnauthorized, code: CodeUnauthorized},
+	codes.PermissionDenied: {status: http.StatusForbidden, code: CodeForbidden},
+	codes.NotFound:         {status: http.StatusNotFound, code: CodeNotFound},
+	codes.AlreadyExists:    {status: http.StatusConflict, code: CodeAlreadyExists},
+	codes.Unavailable:      {status: http.StatusServiceUnavailable, code: CodeServiceUnavailable, message: "Service is temporarily unavailable"},
+	codes.DeadlineExceeded: {status: http.StatusGatewayTimeout, code: CodeTimeout, message: "Service did not respond in time"},
+}
+
+var internal = mapping{status: http.StatusInternalServerError, code: CodeInternal, message: "Internal server error"}
+
+// FromGRPC переводит ошибку вызова сервиса в HTTP статус и тело ответа. Для ошибок клиента
+// сообщение берется из статуса gRPC, остальные коды, включая ошибки не из gRPC, дают 500
+func FromGRPC(err error) (int, entity.ErrorResponse) {
+	st := status.Convert(err)
+	m, ok := mappings[st.Code()]
+	if !ok {
+		m = internal
+	}
+	message := m.message
+	if message == "" {
+		message = st.Message()
+	}
+	return m.status, entity.ErrorResponse{Error: m.code, Message: message}
+}
+
+// Respond отвечает клиенту ошибкой вызова сервиса
+func Respond(c *gin.Context, err error) {
+	c.JSON(FromGRPC(err))
+}
+
+// Codes возвращает коды ошибок сервисов по HTTP статусам для документации API
+func Codes() map[int][]string {
+	result := map[int][]string{internal.status: {internal.code}}
+	for _, m := range mappings {
+		result[m.status] = append(result[m.status], m.code)
+	}
+	return result
+}
@@ -107,3 +107,44 @@ func (c *Client) GetUserProfile(userID string) (*auth.GetUserProfileResponse, er
 
 	return resp, nil
 }
+
+func (c *Client) GetDigestSettings(userID string) (*auth.DigestSettings, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return c.client.GetDigestSettings(ctx, &auth.GetDigestSettingsRequest{UserId: userID})
+}
+
+// UpdateDigestSettings меняет только переданные (не nil) настройки дайджеста
+func (c *Client) UpdateDigestSettings(userID string, enabled *bool, hour *int32) (*auth.DigestSettings, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req := &auth.UpdateDigestSettingsRequest{
+		UserId:  userID,
+		Enabled: enabled,
+		Hour:    hour,
+	}
+	return c.client.UpdateDigestSettings(ctx, req)
+}
+
+func (c *Client) GetNotificationPreferences(userID string) (*auth.NotificationPreferences, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return c.client.GetNotificationPreferences(ctx, &auth.GetNotificationPreferencesRequest{UserId: userID})
+}
+
+func (c *Client) UpdateNotificationPreferences(req *auth.UpdateNotificationPreferencesRequest) (*auth.NotificationPreferences, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return c.client.UpdateNotificationPreferences(ctx, req)
+}
+
+func (c *Client) UpdateTimezone(userID, timezone string) (*auth.GetUserProfileResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return c.client.UpdateTimezone(ctx, &auth.UpdateTimezoneRequest{UserId: userID, Timezone: timezone})
+}
@@ -6,7 +6,9 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/oogway93/taskmanager/config"
+	"github.com/oogway93/taskmanager/gen/auth"
 	// middlewares "github.com/oogway93/taskmanager/internal/api-gateway"
+	"github.com/oogway93/taskmanager/internal/api-gateway/apierror"
 	"github.com/oogway93/taskmanager/internal/entity"
 	"go.uber.org/zap"
 )
@@ -52,10 +54,7 @@ func (h *Handler) Register(c *gin.Context) {
 	resp, err := h.AuthClient.Register(req.Email, req.Password, req.Username)
 	if err != nil {
 		h.Log.Error("Error caused after calling func Register in api-gateway auth's handlers", zap.Error(err))
-		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
-			Error:   "Request isnt successful",
-			Message: "Something goes wrong in app",
-		})
+		apierror.Respond(c, err)
 		return
 	}
 
@@ -80,7 +79,7 @@ func (h *Handler) Register(c *gin.Context) {
 func (h *Handler) Login(c *gin.Context) {
 	var req entity.LoginRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		h.Log.Fatal("Invalid login request", zap.Error(err))
+		h.Log.Error("Invalid login request", zap.Error(err))
 
 		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
 			Error:   "VALIDATION_ERROR",
@@ -91,7 +90,8 @@ func (h *Handler) Login(c *gin.Context) {
 
 	resp, err := h.AuthClient.Login(req.Email, req.Password)
 	if err != nil {
-		h.Log.Fatal("Error caused after calling auth's client func Login in api-gateway auth's handlers", zap.Error(err))
+		h.Log.Error("Error caused after calling auth's client func Login in api-gateway auth's handlers", zap.Error(err))
+		apierror.Respond(c, err)
 		return
 	}
 
@@ -124,7 +124,8 @@ func (h *Handler) GetProfile(c *gin.Context) {
 
 	resp, err := h.AuthClient.GetUserProfile(userID.(string))
 	if err != nil {
-		h.Log.Fatal("Error caused after calling auth's client func GetUserProfile in api-gateway auth's handlers", zap.Error(err))
+		h.Log.Error("Error caused after calling auth's client func GetUserProfile in api-gateway auth's handlers", zap.Error(err))
+		apierror.Respond(c, err)
 		return
 	}
 
@@ -133,13 +134,197 @@ func (h *Handler) GetProfile(c *gin.Context) {
 		Email:     resp.User.Email,
 		Username:  resp.User.Username,
 		Role:      resp.User.Role,
+		Timezone:  resp.User.Timezone,
 		CreatedAt: resp.User.CreatedAt.AsTime(),
 	}
 
 	c.JSON(http.StatusOK, response)
 }
 
+// UpdateTimezone меняет часовой пояс пользователя (IANA, например "Europe/Berlin"), по которому
+// строятся календарь, дайджест и тихие часы
+func (h *Handler) UpdateTimezone(c *gin.Context) {
+	var req entity.UpdateTimezoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Log.Error("Invalid timezone request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+			Error:   "VALIDATION_ERROR",
+			Message: "Field 'timezone' is required",
+		})
+		return
+	}
+
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, entity.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	resp, err := h.AuthClient.UpdateTimezone(userID, req.Timezone)
+	if err != nil {
+		h.Log.Error("Error caused after calling auth's client func UpdateTimezone in api-gateway auth's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, entity.UserResponse{
+		ID:        resp.User.Id,
+		Email:     resp.User.Email,
+		Username:  resp.User.Username,
+		Role:      resp.User.Role,
+		Timezone:  resp.User.Timezone,
+		CreatedAt: resp.User.CreatedAt.AsTime(),
+	})
+}
 
+// GetDigestSettings возвращает настройки ежедневного дайджеста текущего пользователя
+func (h *Handler) GetDigestSettings(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, entity.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	resp, err := h.AuthClient.GetDigestSettings(userID)
+	if err != nil {
+		h.Log.Error("Error caused after calling auth's client func GetDigestSettings in api-gateway auth's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, digestSettingsFromProto(resp))
+}
+
+// UpdateDigestSettings включает/отключает дайджест и меняет час отправки; часовой пояс - в UpdateTimezone
+func (h *Handler) UpdateDigestSettings(c *gin.Context) {
+	var req entity.UpdateDigestSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Log.Error("Invalid digest settings request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+			Error:   "VALIDATION_ERROR",
+			Message: "Invalid request data",
+		})
+		return
+	}
+
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, entity.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	resp, err := h.AuthClient.UpdateDigestSettings(userID, req.Enabled, req.Hour)
+	if err != nil {
+		h.Log.Error("Error caused after calling auth's client func UpdateDigestSettings in api-gateway auth's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, digestSettingsFromProto(resp))
+}
+
+func digestSettingsFromProto(settings *auth.DigestSettings) entity.DigestSettings {
+	return entity.DigestSettings{
+		Enabled:  settings.Enabled,
+		Timezone: settings.Timezone,
+		Hour:     settings.Hour,
+	}
+}
+
+// GetNotificationPreferences возвращает каналы уведомлений, тихие часы и накопление писем до дайджеста
+func (h *Handler) GetNotificationPreferences(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, entity.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	resp, err := h.AuthClient.GetNotificationPreferences(userID)
+	if err != nil {
+		h.Log.Error("Error caused after calling auth's client func GetNotificationPreferences in api-gateway auth's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, notificationPreferencesFromProto(resp))
+}
+
+// UpdateNotificationPreferences меняет переданные настройки уведомлений; каналы заменяются по типам
+func (h *Handler) UpdateNotificationPreferences(c *gin.Context) {
+	var req entity.UpdateNotificationPreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Log.Error("Invalid notification preferences request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+			Error:   "VALIDATION_ERROR",
+			Message: "Invalid request data",
+		})
+		return
+	}
+
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, entity.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	update := &auth.UpdateNotificationPreferencesRequest{
+		UserId:         userID,
+		DigestBatching: req.DigestBatching,
+	}
+	if len(req.Channels) > 0 {
+		update.Channels = make(map[string]*auth.NotificationChannels, len(req.Channels))
+		for notificationType, channels := range req.Channels {
+			update.Channels[notificationType] = &auth.NotificationChannels{Channels: channels}
+		}
+	}
+	if req.QuietHours != nil {
+		update.QuietHours = &auth.QuietHours{
+			Enabled: req.QuietHours.Enabled,
+			Start:   req.QuietHours.Start,
+			End:     req.QuietHours.End,
+		}
+	}
+
+	resp, err := h.AuthClient.UpdateNotificationPreferences(update)
+	if err != nil {
+		h.Log.Error("Error caused after calling auth's client func UpdateNotificationPreferences in api-gateway auth's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, notificationPreferencesFromProto(resp))
+}
+
+func notificationPreferencesFromProto(preferences *auth.NotificationPreferences) entity.NotificationPreferences {
+	channels := make(map[string][]string, len(preferences.Channels))
+	for notificationType, list := range preferences.Channels {
+		channels[notificationType] = list.GetChannels()
+		if channels[notificationType] == nil {
+			channels[notificationType] = []string{}
+		}
+	}
+	return entity.NotificationPreferences{
+		Channels: channels,
+		QuietHours: entity.QuietHours{
+			Enabled: preferences.QuietHours.GetEnabled(),
+			Start:   preferences.QuietHours.GetStart(),
+			End:     preferences.QuietHours.GetEnd(),
+		},
+		Timezone:       preferences.Timezone,
+		DigestBatching: preferences.DigestBatching,
+	}
+}
 
 func (h *Handler) Close() {
 	h.AuthClient.Close()
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -163,10 +164,21 @@ func validateTokenLocally(tokenString, secretKey string) (*AuthUser, error) {
 	}, nil
 }
 
+// publicTaskLinkRe - публичная ссылка на задачу. Сравнение по префиксу открыло бы без JWT любой путь
+// под /api/v1/public/, поэтому допускается только один сегмент с токеном из 43 символов base64url
+var publicTaskLinkRe = regexp.MustCompile(`^/api/v1/public/tasks/[A-Za-z0-9_-]{43}$`)
+
 // isPublicRoute проверяет, является ли маршрут публичным
 func isPublicRoute(path string) bool {
+	if publicTaskLinkRe.MatchString(path) {
+		return true
+	}
+
 	publicRoutes := []string{
 		"/health",
+		"/api/v1/health",
+		"/api/v1/openapi.json",
+		"/api/v1/docs",
 		"/api/v1/auth/registration",
 		"/api/v1/auth/login",
 		"/api/v1/auth/refresh",
@@ -349,50 +361,50 @@ func PrometheusMiddleware() gin.HandlerFunc {
 
 // ==================== ROLE-BASED ACCESS CONTROL ====================
 
-// // RequireRole middleware для проверки ролей
-// func RequireRole(roles ...string) gin.HandlerFunc {
-// 	return func(c *gin.Context) {
-// 		user, err := GetUserFromContext(c)
-// 		if err != nil {
-// 			c.JSON(http.StatusUnauthorized, gin.H{
-// 				"error":   "UNAUTHORIZED",
-// 				"message": "Authentication required",
-// 			})
-// 			c.Abort()
-// 			return
-// 		}
-
-// 		// Проверяем, есть ли у пользователя необходимая роль
-// 		hasRole := false
-// 		for _, role := range roles {
-// 			if user.Role == role {
-// 				hasRole = true
-// 				break
-// 			}
-// 		}
-
-// 		if !hasRole {
-// 			// logger.WithFields(logger.Fields{
-// 			// 	"user_id":        user.UserID,
-// 			// 	"email":          user.Email,
-// 			// 	"role":           user.Role,
-// 			// 	"required_roles": roles,
-// 			// }).Warn("Access denied - insufficient permissions")
-// 			log.Println("Access denied - insufficient permissions")
-
-// 			c.JSON(http.StatusForbidden, gin.H{
-// 				"error":   "FORBIDDEN",
-// 				"message": "Insufficient permissions",
-// 			})
-// 			c.Abort()
-// 			return
-// 		}
-
-// 		c.Next()
-// 	}
-// }
+// RequireRole middleware для проверки ролей
+func RequireRole(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, err := GetUserFromContext(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error":   "UNAUTHORIZED",
+				"message": "Authentication required",
+			})
+			c.Abort()
+			return
+		}
 
-// // AdminOnly middleware для проверки административных прав
-// func AdminOnly() gin.HandlerFunc {
-// 	return RequireRole("admin", "superadmin")
-// }
+		// Проверяем, есть ли у пользователя необходимая роль
+		hasRole := false
+		for _, role := range roles {
+			if user.Role == role {
+				hasRole = true
+				break
+			}
+		}
+
+		if !hasRole {
+			// logger.WithFields(logger.Fields{
+			// 	"user_id":        user.UserID,
+			// 	"email":          user.Email,
+			// 	"role":           user.Role,
+			// 	"required_roles": roles,
+			// }).Warn("Access denied - insufficient permissions")
+			log.Println("Access denied - insufficient permissions")
+
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":   "FORBIDDEN",
+				"message": "Insufficient permissions",
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// AdminOnly middleware для проверки административных прав
+func AdminOnly() gin.HandlerFunc {
+	return RequireRole("admin", "superadmin")
+}
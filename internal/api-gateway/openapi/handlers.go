@@ -0,0 +1,48 @@
+package openapi
+
+import (
+	"embed"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oogway93/taskmanager/internal/entity"
+)
+
+//go:embed swagger.html
+var swaggerPage []byte
+
+// swaggerAssets - файлы swagger-ui-dist, встроенные в бинарник, чтобы страница документации
+// не исполняла код со стороннего CDN
+//
+//go:embed swagger-ui/swagger-ui.css swagger-ui/swagger-ui-bundle.js
+var swaggerAssets embed.FS
+
+// spec собирает документ один раз при первом запросе
+var spec = sync.OnceValues(func() ([]byte, error) {
+	return json.Marshal(Build(Operations))
+})
+
+// Spec отдает документ OpenAPI
+func Spec(c *gin.Context) {
+	data, err := spec()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, entity.ErrorResponse{
+			Error:   "SPEC_FAILED",
+			Message: "Failed to build OpenAPI document",
+		})
+		return
+	}
+	c.Data(http.StatusOK, "application/json; charset=utf-8", data)
+}
+
+// SwaggerUI отдает страницу Swagger UI, которая загружает документ из /api/v1/openapi.json
+func SwaggerUI(c *gin.Context) {
+	c.Data(http.StatusOK, "text/html; charset=utf-8", swaggerPage)
+}
+
+// SwaggerAsset отдает встроенные файлы Swagger UI
+func SwaggerAsset(c *gin.Context) {
+	c.FileFromFS("swagger-ui/"+c.Param("file"), http.FS(swaggerAssets))
+}
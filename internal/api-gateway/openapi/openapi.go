@@ -0,0 +1,319 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/api-gateway/apierror"
+	"github.com/oogway93/taskmanager/internal/entity"
+)
+
+// Operation описывает один маршрут шлюза. Operations должен совпадать с маршрутами,
+// зарегистрированными в cmd/api-gateway, это проверяет тест в cmd/api-gateway
+type Operation struct {
+	Method string
+	// Path - полный путь в нотации gin: /api/v1/task/:id
+	Path    string
+	Tag     string
+	Summary string
+	// Public - маршрут открыт без JWT (isPublicRoute), Admin - нужна роль admin или superadmin
+	Public bool
+	Admin  bool
+	// Static - маршрут не обращается к сервисам и не отвечает их ошибками
+	Static bool
+	Query  []Param
+	// Request - значение типа тела запроса, nil - запрос без тела
+	Request         any
+	RequestOptional bool
+	// RequestMedia заменяет application/json для тел не в JSON
+	RequestMedia []string
+	// Status - код успешного ответа, Response - значение типа его тела; nil - ответ без тела
+	Status   int
+	Response any
+	// ResponseMedia - типы ответа кроме application/json
+	ResponseMedia []string
+	// AltStatus - второй успешный код с тем же телом, например 200 для предпросмотра
+	AltStatus int
+	// Errors - коды ErrorResponse.error по HTTP статусам, которые задает сам шлюз. Коды ошибок
+	// сервисов из apierror, 401 для закрытых маршрутов и 403 для Admin добавляются автоматически
+	Errors map[int][]string
+}
+
+type Param struct {
+	Name        string
+	Type        string
+	Description string
+}
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+	// authErrors - коды, которыми отвечают JWTMiddleware и currentUserID
+	authErrors = []string{"UNAUTHORIZED", "INVALID_TOKEN", "Unauthorized"}
+)
+
+// Build собирает документ OpenAPI 3 по списку операций; схемы тел строятся из типов entity
+// по тем же json тегам, что использует encoding/json
+func Build(operations []Operation) map[string]any {
+	b := &schemaBuilder{components: make(map[string]any)}
+	errorSchema := b.schema(reflect.TypeOf(entity.ErrorResponse{}))
+
+	paths := make(map[string]any)
+	for _, op := range operations {
+		path, pathParams := openAPIPath(op.Path)
+		item, ok := paths[path].(map[string]any)
+		if !ok {
+			item = make(map[string]any)
+			paths[path] = item
+		}
+
+		parameters := make([]any, 0, len(pathParams)+len(op.Query))
+		for _, name := range pathParams {
+			parameters = append(parameters, map[string]any{
+				"name": name, "in": "path", "required": true, "schema": map[string]any{"type": "string"},
+			})
+		}
+		for _, param := range op.Query {
+			paramType := param.Type
+			if paramType == "" {
+				paramType = "string"
+			}
+			parameters = append(parameters, map[string]any{
+				"name": param.Name, "in": "query", "description": param.Description,
+				"schema": map[string]any{"type": paramType},
+			})
+		}
+
+		operation := map[string]any{
+			"operationId": operationID(op),
+			"summary":     op.Summary,
+			"tags":        []string{op.Tag},
+			"parameters":  parameters,
+			"responses":   b.responses(op, errorSchema),
+		}
+		if op.Public {
+			operation["security"] = []any{}
+		}
+		if body := b.requestBody(op); body != nil {
+			operation["requestBody"] = body
+		}
+		item[strings.ToLower(op.Method)] = operation
+	}
+
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":       "Task Manager API",
+			"version":     "1.0.0",
+			"description": "REST API of the task manager gateway. Errors are returned as ErrorResponse with a machine-readable 'error' code.",
+		},
+		"servers":  []any{map[string]any{"url": "/"}},
+		"security": []any{map[string]any{"bearerAuth": []string{}}},
+		"paths":    paths,
+		"components": map[string]any{
+			"schemas": b.components,
+			"securitySchemes": map[string]any{
+				"bearerAuth": map[string]any{"type": "http", "scheme": "bearer", "bearerFormat": "JWT"},
+			},
+		},
+	}
+}
+
+func (b *schemaBuilder) requestBody(op Operation) map[string]any {
+	content := make(map[string]any)
+	switch {
+	case op.Request != nil:
+		content["application/json"] = map[string]any{"schema": b.schema(reflect.TypeOf(op.Request))}
+	case len(op.RequestMedia) == 0:
+		return nil
+	}
+	for _, media := range op.RequestMedia {
+		schema := map[string]any{"type": "string", "format": "binary"}
+		if media == "multipart/form-data" {
+			schema = map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"file": schema},
+			}
+		}
+		content[media] = map[string]any{"schema": schema}
+	}
+	return map[string]any{"required": !op.RequestOptional, "content": content}
+}
+
+func (b *schemaBuilder) responses(op Operation, errorSchema map[string]any) map[string]any {
+	success := map[string]any{"description": http.StatusText(op.Status)}
+	content := make(map[string]any)
+	if op.Response != nil {
+		content["application/json"] = map[string]any{"schema": b.schema(reflect.TypeOf(op.Response))}
+	}
+	for _, media := range op.ResponseMedia {
+		content[media] = map[string]any{"schema": map[string]any{"type": "string"}}
+	}
+	if len(content) > 0 {
+		success["content"] = content
+	}
+
+	responses := map[string]any{fmt.Sprint(op.Status): success}
+	if op.AltStatus != 0 {
+		responses[fmt.Sprint(op.AltStatus)] = success
+	}
+
+	errors := make(map[int][]string)
+	addErrors(errors, op.Errors)
+	if !op.Static {
+		addErrors(errors, apierror.Codes())
+	}
+	if !op.Public {
+		addErrors(errors, map[int][]string{http.StatusUnauthorized: authErrors})
+	}
+	if op.Admin {
+		addErrors(errors, map[int][]string{http.StatusForbidden: {apierror.CodeForbidden}})
+	}
+	for status, codes := range errors {
+		responses[fmt.Sprint(status)] = map[string]any{
+			"description": strings.Join(codes, ", "),
+			"content": map[string]any{"application/json": map[string]any{"schema": map[string]any{
+				"allOf": []any{errorSchema, map[string]any{
+					"type":       "object",
+					"properties": map[string]any{"error": map[string]any{"type": "string", "enum": codes}},
+				}},
+			}}},
+		}
+	}
+	return responses
+}
+
+// addErrors дописывает коды в errors без повторов
+func addErrors(errors map[int][]string, add map[int][]string) {
+	for status, codes := range add {
+		for _, code := range codes {
+			if !slices.Contains(errors[status], code) {
+				errors[status] = append(errors[status], code)
+			}
+		}
+	}
+}
+
+// openAPIPath переводит /task/:id в /task/{id} и возвращает имена параметров пути
+func openAPIPath(path string) (string, []string) {
+	segments := strings.Split(path, "/")
+	var params []string
+	for i, segment := range segments {
+		if name, ok := strings.CutPrefix(segment, ":"); ok {
+			params = append(params, name)
+			segments[i] = "{" + name + "}"
+		}
+	}
+	return strings.Join(segments, "/"), params
+}
+
+func operationID(op Operation) string {
+	var id strings.Builder
+	id.WriteString(strings.ToLower(op.Method))
+	for _, part := range strings.FieldsFunc(op.Path, func(r rune) bool {
+		return r == '/' || r == ':' || r == '.' || r == '_'
+	}) {
+		if part == "api" || part == "v1" {
+			continue
+		}
+		id.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return id.String()
+}
+
+type schemaBuilder struct {
+	components map[string]any
+}
+
+// schema возвращает схему типа; именованные структуры попадают в components.schemas и
+// подставляются ссылкой, поэтому рекурсивные типы не зацикливаются
+func (b *schemaBuilder) schema(t reflect.Type) map[string]any {
+	if t == timeType {
+		return map[string]any{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return b.schema(t.Elem())
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case reflect.Int64, reflect.Uint, reflect.Uint64:
+		return map[string]any{"type": "integer", "format": "int64"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]any{"type": "string", "format": "byte"}
+		}
+		return map[string]any{"type": "array", "items": b.schema(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": b.schema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return b.object(t)
+		}
+		if _, ok := b.components[t.Name()]; !ok {
+			// заглушка до обхода полей обрывает рекурсию
+			b.components[t.Name()] = map[string]any{}
+			b.components[t.Name()] = b.object(t)
+		}
+		return map[string]any{"$ref": "#/components/schemas/" + t.Name()}
+	default:
+		// any и интерфейсы: значение любого типа
+		return map[string]any{}
+	}
+}
+
+func (b *schemaBuilder) object(t reflect.Type) map[string]any {
+	properties := make(map[string]any)
+	var required []string
+	b.fields(t, properties, &required)
+
+	object := map[string]any{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		object["required"] = required
+	}
+	return object
+}
+
+// fields повторяет правила encoding/json: встроенные структуры без тега раскрываются,
+// неэкспортируемые поля и тег "-" пропускаются, без тега используется имя поля
+func (b *schemaBuilder) fields(t reflect.Type, properties map[string]any, required *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				b.fields(embedded, properties, required)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		properties[name] = b.schema(field.Type)
+		if strings.Contains(field.Tag.Get("binding"), "required") {
+			*required = append(*required, name)
+		}
+	}
+}
@@ -0,0 +1,235 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+)
+
+const validationError = "VALIDATION_ERROR"
+
+var (
+	projectParam = Param{Name: "project", Description: "Only tasks of this project"}
+	tzParam      = Param{Name: "tz", Description: "IANA time zone, e.g. Europe/Moscow; defaults to the profile time zone"}
+	statsParams  = []Param{
+		{Name: "from", Description: "Period start: RFC3339 or YYYY-MM-DD"},
+		{Name: "to", Description: "Period end: RFC3339 or YYYY-MM-DD"},
+		{Name: "tags", Description: "Comma-separated tags"},
+		tzParam,
+	}
+)
+
+// Operations - все маршруты шлюза в порядке регистрации в cmd/api-gateway
+var Operations = []Operation{
+	{Method: http.MethodGet, Path: "/metrics", Tag: "system", Summary: "Prometheus metrics",
+		Public: true, Static: true, Status: http.StatusOK, ResponseMedia: []string{"text/plain"}},
+	{Method: http.MethodGet, Path: "/api/v1/openapi.json", Tag: "system", Summary: "This OpenAPI document",
+		Public: true, Static: true, Status: http.StatusOK, Response: map[string]any{}},
+	{Method: http.MethodGet, Path: "/api/v1/docs", Tag: "system", Summary: "Swagger UI",
+		Public: true, Static: true, Status: http.StatusOK, ResponseMedia: []string{"text/html"}},
+	{Method: http.MethodGet, Path: "/api/v1/docs/assets/:file", Tag: "system", Summary: "Swagger UI scripts and styles",
+		Public: true, Static: true, Status: http.StatusOK, ResponseMedia: []string{"text/css", "text/javascript"}},
+
+	{Method: http.MethodGet, Path: "/api/v1/health", Tag: "system", Summary: "Health check",
+		Public: true, Static: true, Status: http.StatusOK, Response: ""},
+	{Method: http.MethodPost, Path: "/api/v1/auth/login", Tag: "auth", Summary: "Log in with email and password",
+		Public: true, Request: entity.LoginRequest{}, Status: http.StatusOK, Response: entity.LoginResponse{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodPost, Path: "/api/v1/auth/registration", Tag: "auth", Summary: "Register a new user",
+		Public: true, Request: entity.RegisterRequest{}, Status: http.StatusCreated, Response: entity.RegisterResponse{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodGet, Path: "/api/v1/public/tasks/:token", Tag: "public links",
+		Summary: "Read-only task view by public link; HTML with ?format=html or Accept: text/html",
+		Public:  true, Query: []Param{{Name: "format", Description: "json (default) or html"}},
+		Status: http.StatusOK, Response: entity.PublicTaskView{}, ResponseMedia: []string{"text/html"},
+		Errors: map[int][]string{http.StatusNotFound: {"LINK_NOT_FOUND"}}},
+
+	{Method: http.MethodGet, Path: "/api/v1/auth/profile", Tag: "auth", Summary: "Current user profile",
+		Status: http.StatusOK, Response: entity.UserResponse{}},
+	{Method: http.MethodGet, Path: "/api/v1/auth/digest", Tag: "auth", Summary: "Digest email settings",
+		Status: http.StatusOK, Response: entity.DigestSettings{}},
+	{Method: http.MethodPut, Path: "/api/v1/auth/digest", Tag: "auth", Summary: "Update digest email settings",
+		Request: entity.UpdateDigestSettingsRequest{}, Status: http.StatusOK, Response: entity.DigestSettings{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodGet, Path: "/api/v1/auth/preferences", Tag: "auth", Summary: "Notification preferences",
+		Status: http.StatusOK, Response: entity.NotificationPreferences{}},
+	{Method: http.MethodPut, Path: "/api/v1/auth/preferences", Tag: "auth", Summary: "Update notification preferences",
+		Request: entity.UpdateNotificationPreferencesRequest{}, Status: http.StatusOK, Response: entity.NotificationPreferences{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodPut, Path: "/api/v1/auth/timezone", Tag: "auth", Summary: "Set profile time zone",
+		Request: entity.UpdateTimezoneRequest{}, Status: http.StatusOK, Response: entity.UserResponse{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+
+	{Method: http.MethodPost, Path: "/api/v1/task", Tag: "tasks", Summary: "Create a task",
+		Request: entity.TaskRequest{}, Status: http.StatusCreated, Response: entity.TaskResponse{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodGet, Path: "/api/v1/task", Tag: "tasks",
+		Summary: "List tasks; custom fields are filtered with cf.<key>[.<op>]=value",
+		Query: []Param{
+			{Name: "view_id", Description: "Apply a saved view"},
+			tzParam, projectParam,
+			{Name: "sort", Description: "Sort field"},
+			{Name: "order", Description: "asc or desc"},
+			{Name: "include_deferred", Type: "boolean", Description: "Include not yet started and snoozed tasks"},
+			{Name: "shared", Type: "boolean", Description: "Tasks shared with the current user"},
+		},
+		Status: http.StatusOK, Response: entity.TaskListResponse{}},
+	{Method: http.MethodGet, Path: "/api/v1/task/next", Tag: "tasks",
+		Summary: "Tasks to work on next; scoring weights are overridden with weight.<factor>=value",
+		Query:   []Param{{Name: "limit", Type: "integer"}, projectParam},
+		Status:  http.StatusOK, Response: entity.NextTasksResponse{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodGet, Path: "/api/v1/task/:id", Tag: "tasks", Summary: "Get an own or shared task",
+		Status: http.StatusOK, Response: entity.TaskResponse{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodPatch, Path: "/api/v1/task/:id", Tag: "tasks", Summary: "Update a task",
+		Request: entity.UpdateTaskRequest{}, Status: http.StatusOK, Response: entity.TaskResponse{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodPut, Path: "/api/v1/task/:id/quadrant", Tag: "matrix", Summary: "Pin a task to an Eisenhower quadrant",
+		Request: entity.QuadrantOverrideRequest{}, Status: http.StatusOK, Response: entity.QuadrantOverrideResponse{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodDelete, Path: "/api/v1/task/:id/quadrant", Tag: "matrix", Summary: "Unpin a task from its quadrant",
+		Status: http.StatusOK, Response: entity.QuadrantOverrideResponse{}},
+	{Method: http.MethodPost, Path: "/api/v1/task/:id/snooze", Tag: "tasks", Summary: "Snooze a task",
+		Request: entity.SnoozeTaskRequest{}, Status: http.StatusOK, Response: entity.TaskResponse{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodDelete, Path: "/api/v1/task/:id/snooze", Tag: "tasks", Summary: "Unsnooze a task",
+		Status: http.StatusOK, Response: entity.TaskResponse{}},
+	{Method: http.MethodPost, Path: "/api/v1/task/:id/clone", Tag: "tasks", Summary: "Clone a task, optionally with subtasks",
+		Request: entity.CloneTaskOptions{}, RequestOptional: true, Status: http.StatusCreated, Response: entity.TaskResponse{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodGet, Path: "/api/v1/task/:id/shares", Tag: "sharing", Summary: "List users a task is shared with",
+		Status: http.StatusOK, Response: entity.TaskSharesResponse{}},
+	{Method: http.MethodPost, Path: "/api/v1/task/:id/shares", Tag: "sharing", Summary: "Share a task with READ or EDIT permission",
+		Request: entity.ShareTaskRequest{}, Status: http.StatusOK, Response: entity.TaskShare{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodDelete, Path: "/api/v1/task/:id/shares/:user", Tag: "sharing", Summary: "Revoke a user's access",
+		Status: http.StatusNoContent},
+	{Method: http.MethodGet, Path: "/api/v1/task/:id/links", Tag: "public links", Summary: "List public links with view counters",
+		Status: http.StatusOK, Response: entity.PublicLinksResponse{}},
+	{Method: http.MethodPost, Path: "/api/v1/task/:id/links", Tag: "public links", Summary: "Create an expiring public link; the token is shown only once",
+		Request: entity.CreatePublicLinkRequest{}, RequestOptional: true, Status: http.StatusCreated, Response: entity.PublicLink{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodDelete, Path: "/api/v1/task/:id/links/:link", Tag: "public links", Summary: "Revoke a public link",
+		Status: http.StatusOK, Response: entity.PublicLink{}},
+	{Method: http.MethodGet, Path: "/api/v1/task/:id/checklist", Tag: "checklists", Summary: "Get a task checklist",
+		Status: http.StatusOK, Response: entity.Checklist{}},
+	{Method: http.MethodPost, Path: "/api/v1/task/:id/checklist", Tag: "checklists", Summary: "Add a checklist item",
+		Request: entity.AddChecklistItemRequest{}, Status: http.StatusCreated, Response: entity.Checklist{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodPut, Path: "/api/v1/task/:id/checklist/order", Tag: "checklists", Summary: "Reorder all checklist items",
+		Request: entity.ReorderChecklistRequest{}, Status: http.StatusOK, Response: entity.Checklist{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodPost, Path: "/api/v1/task/:id/checklist/:item/toggle", Tag: "checklists", Summary: "Toggle a checklist item",
+		Status: http.StatusOK, Response: entity.Checklist{}},
+	{Method: http.MethodPost, Path: "/api/v1/task/:id/checklist/:item/convert", Tag: "checklists", Summary: "Convert a checklist item to a subtask",
+		Status: http.StatusCreated, Response: entity.TaskResponse{}},
+	{Method: http.MethodDelete, Path: "/api/v1/task/:id/checklist/:item", Tag: "checklists", Summary: "Delete a checklist item",
+		Status: http.StatusOK, Response: entity.Checklist{}},
+	{Method: http.MethodPost, Path: "/api/v1/task/import/ics", Tag: "tasks", Summary: "Import tasks from an iCalendar file (up to 3 MB)",
+		RequestMedia: []string{"multipart/form-data", "text/calendar"}, Status: http.StatusOK, Response: entity.ImportResult{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodPost, Path: "/api/v1/task/quick", Tag: "tasks", Summary: "Create a task from a text line; 200 with preview",
+		Request: entity.QuickAddRequest{}, Status: http.StatusCreated, AltStatus: http.StatusOK, Response: entity.QuickAddResponse{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+
+	{Method: http.MethodGet, Path: "/api/v1/matrix", Tag: "matrix", Summary: "Eisenhower matrix",
+		Query: []Param{
+			{Name: "important_priority", Description: "Lowest priority treated as important"},
+			{Name: "urgent_sla_status", Description: "Lowest SLA status treated as urgent"},
+			{Name: "urgent_within_hours", Type: "integer", Description: "Tasks due within this many hours are urgent"},
+			projectParam,
+		},
+		Status: http.StatusOK, Response: entity.EisenhowerMatrix{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodGet, Path: "/api/v1/calendar", Tag: "calendar", Summary: "Tasks grouped by local day; up to 92 days",
+		Query: []Param{
+			{Name: "from", Description: "First day, YYYY-MM-DD"},
+			{Name: "to", Description: "Last day, YYYY-MM-DD"},
+			{Name: "tz", Description: "IANA time zone; defaults to the profile time zone"},
+			projectParam,
+		},
+		Status: http.StatusOK, Response: entity.Calendar{}},
+	{Method: http.MethodGet, Path: "/api/v1/stats", Tag: "stats", Summary: "Task statistics",
+		Query: statsParams, Status: http.StatusOK, Response: entity.TaskStats{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodGet, Path: "/api/v1/stats/burndown", Tag: "stats", Summary: "Burndown chart",
+		Query: statsParams, Status: http.StatusOK, Response: entity.BurndownResponse{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodGet, Path: "/api/v1/stats/cfd", Tag: "stats", Summary: "Cumulative flow diagram",
+		Query: statsParams, Status: http.StatusOK, Response: entity.CumulativeFlowResponse{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+
+	{Method: http.MethodPost, Path: "/api/v1/views", Tag: "views", Summary: "Create a saved view",
+		Request: entity.SavedView{}, Status: http.StatusCreated, Response: entity.SavedView{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodGet, Path: "/api/v1/views", Tag: "views", Summary: "List saved views",
+		Status: http.StatusOK, Response: entity.SavedViewListResponse{}},
+	{Method: http.MethodGet, Path: "/api/v1/views/:id", Tag: "views", Summary: "Get a saved view",
+		Status: http.StatusOK, Response: entity.SavedView{}},
+	{Method: http.MethodPut, Path: "/api/v1/views/:id", Tag: "views", Summary: "Update a saved view",
+		Request: entity.SavedView{}, Status: http.StatusOK, Response: entity.SavedView{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodDelete, Path: "/api/v1/views/:id", Tag: "views", Summary: "Delete a saved view",
+		Status: http.StatusNoContent},
+
+	{Method: http.MethodPost, Path: "/api/v1/templates", Tag: "templates", Summary: "Create a task template",
+		Request: entity.TaskTemplate{}, Status: http.StatusCreated, Response: entity.TaskTemplate{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodGet, Path: "/api/v1/templates", Tag: "templates", Summary: "List task templates",
+		Status: http.StatusOK, Response: entity.TaskTemplateListResponse{}},
+	{Method: http.MethodGet, Path: "/api/v1/templates/:id", Tag: "templates", Summary: "Get a task template",
+		Status: http.StatusOK, Response: entity.TaskTemplate{}},
+	{Method: http.MethodPut, Path: "/api/v1/templates/:id", Tag: "templates", Summary: "Update a task template",
+		Request: entity.TaskTemplate{}, Status: http.StatusOK, Response: entity.TaskTemplate{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodDelete, Path: "/api/v1/templates/:id", Tag: "templates", Summary: "Delete a task template",
+		Status: http.StatusNoContent},
+	{Method: http.MethodPost, Path: "/api/v1/templates/:id/instantiate", Tag: "templates", Summary: "Create tasks from a template",
+		Request: entity.InstantiateTemplateRequest{}, Status: http.StatusCreated, Response: entity.InstantiateTemplateResponse{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+
+	{Method: http.MethodPost, Path: "/api/v1/fields", Tag: "custom fields", Summary: "Define a custom field",
+		Request: entity.CustomFieldDefinition{}, Status: http.StatusCreated, Response: entity.CustomFieldDefinition{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodGet, Path: "/api/v1/fields", Tag: "custom fields", Summary: "List custom fields",
+		Query: []Param{projectParam}, Status: http.StatusOK, Response: entity.CustomFieldListResponse{}},
+	{Method: http.MethodPut, Path: "/api/v1/fields/:id", Tag: "custom fields", Summary: "Update a custom field",
+		Request: entity.CustomFieldDefinition{}, Status: http.StatusOK, Response: entity.CustomFieldDefinition{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodDelete, Path: "/api/v1/fields/:id", Tag: "custom fields", Summary: "Delete a custom field",
+		Status: http.StatusNoContent},
+
+	{Method: http.MethodGet, Path: "/api/v1/sla/policies", Tag: "sla", Summary: "List SLA policies",
+		Status: http.StatusOK, Response: entity.SLAPolicyListResponse{}},
+	{Method: http.MethodPut, Path: "/api/v1/sla/policies/:priority", Tag: "sla", Summary: "Update the SLA policy of a priority",
+		Admin: true, Request: entity.SLAPolicy{}, Status: http.StatusOK, Response: entity.SLAPolicy{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+
+	{Method: http.MethodPost, Path: "/api/v1/webhooks", Tag: "webhooks", Summary: "Subscribe a webhook",
+		Request: entity.WebhookSubscription{}, Status: http.StatusCreated, Response: entity.WebhookSubscription{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodGet, Path: "/api/v1/webhooks", Tag: "webhooks", Summary: "List webhooks",
+		Status: http.StatusOK, Response: entity.WebhookListResponse{}},
+	{Method: http.MethodPut, Path: "/api/v1/webhooks/:id", Tag: "webhooks", Summary: "Update a webhook",
+		Request: entity.WebhookSubscription{}, Status: http.StatusOK, Response: entity.WebhookSubscription{},
+		Errors: map[int][]string{http.StatusBadRequest: {validationError}}},
+	{Method: http.MethodDelete, Path: "/api/v1/webhooks/:id", Tag: "webhooks", Summary: "Delete a webhook",
+		Status: http.StatusNoContent},
+	{Method: http.MethodGet, Path: "/api/v1/webhooks/:id/deliveries", Tag: "webhooks", Summary: "Recent webhook deliveries",
+		Query: []Param{{Name: "limit", Type: "integer"}}, Status: http.StatusOK, Response: entity.WebhookDeliveryListResponse{}},
+	{Method: http.MethodPost, Path: "/api/v1/webhooks/:id/deliveries/:delivery_id/redeliver", Tag: "webhooks", Summary: "Redeliver a webhook event",
+		Status: http.StatusAccepted, Response: entity.WebhookDelivery{}},
+
+	{Method: http.MethodGet, Path: "/api/v1/notifications", Tag: "notifications", Summary: "List notifications",
+		Query: []Param{
+			{Name: "unread", Type: "boolean", Description: "Only unread notifications"},
+			{Name: "limit", Type: "integer"},
+			{Name: "offset", Type: "integer"},
+		},
+		Status: http.StatusOK, Response: entity.NotificationListResponse{}},
+	{Method: http.MethodPost, Path: "/api/v1/notifications/read", Tag: "notifications", Summary: "Mark all notifications read",
+		Status: http.StatusOK, Response: entity.MarkNotificationsReadResponse{}},
+	{Method: http.MethodPost, Path: "/api/v1/notifications/:id/read", Tag: "notifications", Summary: "Mark a notification read",
+		Status: http.StatusOK, Response: entity.MarkNotificationsReadResponse{}},
+}
@@ -0,0 +1,58 @@
+package task
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/api-gateway/apierror"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+// Calendar возвращает задачи по локальным дням: каждая задача попадает в день срока и в день начала.
+// Параметры: from, to (YYYY-MM-DD, включительно, по умолчанию неделя с сегодняшнего дня),
+// tz (IANA часовой пояс, по умолчанию из профиля пользователя), project
+func (h *Handler) Calendar(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	timezone, ok := h.userTimezone(c, userID, c.Query("tz"))
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.GetCalendar(&task.GetCalendarRequest{
+		UserId:   userID,
+		From:     c.Query("from"),
+		To:       c.Query("to"),
+		Timezone: timezone,
+		Project:  c.Query("project"),
+	})
+	if err != nil {
+		h.Log.Error("Error caused after calling func GetCalendar in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	calendar := entity.Calendar{
+		From:     resp.From,
+		To:       resp.To,
+		Timezone: resp.Timezone,
+		Days:     make([]entity.CalendarDay, 0, len(resp.Days)),
+	}
+	for _, day := range resp.Days {
+		calendarDay := entity.CalendarDay{Date: day.Date, Entries: []entity.CalendarEntry{}}
+		for _, entry := range day.Entries {
+			calendarDay.Entries = append(calendarDay.Entries, entity.CalendarEntry{
+				Kind: entry.Kind,
+				At:   protoTime(entry.At),
+				Task: taskFromProto(entry.Task),
+			})
+		}
+		calendar.Days = append(calendar.Days, calendarDay)
+	}
+	c.JSON(http.StatusOK, calendar)
+}
@@ -0,0 +1,155 @@
+package task
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/api-gateway/apierror"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+// GetChecklist возвращает пункты чек-листа задачи по порядку и прогресс
+func (h *Handler) GetChecklist(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.GetChecklist(userID, c.Param("id"))
+	if err != nil {
+		h.Log.Error("Error caused after calling func GetChecklist in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, checklistFromProto(resp))
+}
+
+// AddChecklistItem добавляет пункт в конец чек-листа
+func (h *Handler) AddChecklistItem(c *gin.Context) {
+	var req entity.AddChecklistItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Log.Error("Invalid AddChecklistItem request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+			Error:   "VALIDATION_ERROR",
+			Message: "Field 'text' is required",
+		})
+		return
+	}
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.AddChecklistItem(userID, c.Param("id"), req.Text)
+	if err != nil {
+		h.Log.Error("Error caused after calling func AddChecklistItem in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, checklistFromProto(resp))
+}
+
+// ToggleChecklistItem переключает отметку о выполнении пункта
+func (h *Handler) ToggleChecklistItem(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.ToggleChecklistItem(userID, c.Param("id"), c.Param("item"))
+	if err != nil {
+		h.Log.Error("Error caused after calling func ToggleChecklistItem in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, checklistFromProto(resp))
+}
+
+// ReorderChecklist принимает новый порядок всех пунктов чек-листа
+func (h *Handler) ReorderChecklist(c *gin.Context) {
+	var req entity.ReorderChecklistRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Log.Error("Invalid ReorderChecklist request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+			Error:   "VALIDATION_ERROR",
+			Message: "Field 'item_ids' is required",
+		})
+		return
+	}
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.ReorderChecklist(userID, c.Param("id"), req.ItemIDs)
+	if err != nil {
+		h.Log.Error("Error caused after calling func ReorderChecklist in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, checklistFromProto(resp))
+}
+
+func (h *Handler) DeleteChecklistItem(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.DeleteChecklistItem(userID, c.Param("id"), c.Param("item"))
+	if err != nil {
+		h.Log.Error("Error caused after calling func DeleteChecklistItem in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, checklistFromProto(resp))
+}
+
+// ConvertChecklistItem превращает пункт чек-листа в подзадачу и возвращает созданную задачу
+func (h *Handler) ConvertChecklistItem(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.ConvertChecklistItem(userID, c.Param("id"), c.Param("item"))
+	if err != nil {
+		h.Log.Error("Error caused after calling func ConvertChecklistItem in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, entity.TaskResponse{Task: taskFromProto(resp.Task)})
+}
+
+func checklistFromProto(resp *task.ChecklistResponse) entity.Checklist {
+	checklist := entity.Checklist{
+		Items:    make([]entity.ChecklistItem, 0, len(resp.Items)),
+		Progress: checklistProgressFromProto(resp.Progress),
+	}
+	for _, item := range resp.Items {
+		checklist.Items = append(checklist.Items, entity.ChecklistItem{
+			ID:        item.Id,
+			TaskID:    item.TaskId,
+			Text:      item.Text,
+			Done:      item.Done,
+			Position:  int(item.Position),
+			CreatedAt: protoTime(item.CreatedAt),
+			UpdatedAt: protoTime(item.UpdatedAt),
+		})
+	}
+	return checklist
+}
+
+func checklistProgressFromProto(progress *task.ChecklistProgress) entity.ChecklistProgress {
+	if progress == nil {
+		return entity.ChecklistProgress{}
+	}
+	return entity.ChecklistProgress{
+		Done:  int(progress.Done),
+		Total: int(progress.Total),
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Client struct {
@@ -45,26 +47,38 @@ func (c *Client) CreateTask(taskReq entity.TaskRequest) (*task.TaskResponse, err
 		UserId:      taskReq.User_id,
 		Tags:        taskReq.Tags,
 		Status:      taskReq.Status,
+		Estimate:    taskReq.Estimate,
+		Project:     taskReq.Project,
+	}
+	if taskReq.StartAt != nil {
+		req.StartAt = timestamppb.New(*taskReq.StartAt)
+	}
+	if taskReq.CustomFields != nil {
+		customFields, err := structpb.NewStruct(taskReq.CustomFields)
+		if err != nil {
+			return nil, err
+		}
+		req.CustomFields = customFields
 	}
 
 	resp, err := c.client.CreateTask(ctx, req)
 	if err != nil {
-		c.Log.Fatal("Error caused in Create task client", zap.Error(err))
+		// значения пользовательских полей проверяет сервис задач, ошибка валидации - штатная ситуация
+		c.Log.Error("Error caused in Create task client", zap.Error(err))
 		return nil, err
 	}
 
 	return resp, nil
 }
 
-func (c *Client) ListTasks(userId string) (*task.ListTasksResponse, error) {
+func (c *Client) ListTasks(req *task.ListTasksRequest) (*task.ListTasksResponse, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
 
-	req := &task.ListTasksRequest{UserId: userId}
-
 	resp, err := c.client.ListTasks(ctx, req)
 	if err != nil {
-		c.Log.Fatal("Error caused in ListTasks task's client", zap.Error(err))
+		// ошибки фильтра (неизвестное поле, неверное значение) не должны останавливать gateway
+		c.Log.Error("Error caused in ListTasks task's client", zap.Error(err))
 		return nil, err
 	}
 	return resp, nil
@@ -76,6 +90,7 @@ func (c *Client) GetTask(taskReq entity.GetTaskRequest) (*task.TaskResponse, err
 
 	req := &task.GetTaskRequest{
 		TaskId: taskReq.TaskId,
+		UserId: taskReq.UserId,
 	}
 
 	resp, err := c.client.GetTask(ctx, req)
@@ -86,9 +101,691 @@ func (c *Client) GetTask(taskReq entity.GetTaskRequest) (*task.TaskResponse, err
 	return resp, nil
 }
 
+func (c *Client) ImportICS(userId string, data []byte) (*task.ImportICSResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	req := &task.ImportICSRequest{
+		UserId: userId,
+		Data:   data,
+	}
+
+	resp, err := c.client.ImportICS(ctx, req)
+	if err != nil {
+		c.Log.Error("Error caused in ImportICS task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) QuickAddTask(userId string, quickReq entity.QuickAddRequest) (*task.QuickAddTaskResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	req := &task.QuickAddTaskRequest{
+		UserId:   userId,
+		Text:     quickReq.Text,
+		Timezone: quickReq.Timezone,
+		Preview:  quickReq.Preview,
+	}
+
+	resp, err := c.client.QuickAddTask(ctx, req)
+	if err != nil {
+		c.Log.Error("Error caused in QuickAddTask task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) UpdateTask(userId, taskId string, updateReq entity.UpdateTaskRequest) (*task.TaskResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	req := &task.UpdateTaskRequest{
+		TaskId:      taskId,
+		UserId:      userId,
+		Title:       updateReq.Title,
+		Description: updateReq.Description,
+		Status:      updateReq.Status,
+		Tags:        updateReq.Tags,
+		Estimate:    updateReq.Estimate,
+		Project:     updateReq.Project,
+	}
+	if updateReq.DueDate != nil {
+		req.DueDate = timestamppb.New(*updateReq.DueDate)
+	}
+	if updateReq.StartAt != nil {
+		req.StartAt = timestamppb.New(*updateReq.StartAt)
+	}
+	if updateReq.CustomFields != nil {
+		customFields, err := structpb.NewStruct(updateReq.CustomFields)
+		if err != nil {
+			return nil, err
+		}
+		req.CustomFields = customFields
+	}
+
+	resp, err := c.client.UpdateTask(ctx, req)
+	if err != nil {
+		c.Log.Error("Error caused in UpdateTask task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) SnoozeTask(userId, taskId, until, timezone string) (*task.TaskResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.SnoozeTask(ctx, &task.SnoozeTaskRequest{
+		TaskId:   taskId,
+		UserId:   userId,
+		Until:    until,
+		Timezone: timezone,
+	})
+	if err != nil {
+		c.Log.Error("Error caused in SnoozeTask task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) CloneTask(userId, taskId string, options entity.CloneTaskOptions) (*task.TaskResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.CloneTask(ctx, &task.CloneTaskRequest{
+		TaskId:          taskId,
+		UserId:          userId,
+		IncludeSubtasks: options.IncludeSubtasks,
+		ResetStatus:     options.ResetStatus,
+		ShiftDueBy:      options.ShiftDueBy,
+		Timezone:        options.Timezone,
+	})
+	if err != nil {
+		c.Log.Error("Error caused in CloneTask task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) GetTaskStats(req *task.GetTaskStatsRequest) (*task.TaskStats, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := c.client.GetTaskStats(ctx, req)
+	if err != nil {
+		c.Log.Error("Error caused in GetTaskStats task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) GetBurndown(req *task.GetTaskStatsRequest) (*task.BurndownResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := c.client.GetBurndown(ctx, req)
+	if err != nil {
+		c.Log.Error("Error caused in GetBurndown task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) GetCumulativeFlow(req *task.GetTaskStatsRequest) (*task.CumulativeFlowResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := c.client.GetCumulativeFlow(ctx, req)
+	if err != nil {
+		c.Log.Error("Error caused in GetCumulativeFlow task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) CreateSavedView(view *task.SavedView) (*task.SavedView, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.CreateSavedView(ctx, view)
+	if err != nil {
+		c.Log.Error("Error caused in CreateSavedView task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) GetSavedView(userId, viewId string) (*task.SavedView, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.GetSavedView(ctx, &task.SavedViewRequest{ViewId: viewId, UserId: userId})
+	if err != nil {
+		c.Log.Error("Error caused in GetSavedView task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) ListSavedViews(userId string) (*task.ListSavedViewsResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.ListSavedViews(ctx, &task.ListSavedViewsRequest{UserId: userId})
+	if err != nil {
+		c.Log.Error("Error caused in ListSavedViews task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) UpdateSavedView(view *task.SavedView) (*task.SavedView, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.UpdateSavedView(ctx, view)
+	if err != nil {
+		c.Log.Error("Error caused in UpdateSavedView task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) DeleteSavedView(userId, viewId string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	_, err := c.client.DeleteSavedView(ctx, &task.SavedViewRequest{ViewId: viewId, UserId: userId})
+	if err != nil {
+		c.Log.Error("Error caused in DeleteSavedView task's client", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (c *Client) CreateTaskTemplate(template *task.TaskTemplate) (*task.TaskTemplate, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.CreateTaskTemplate(ctx, template)
+	if err != nil {
+		c.Log.Error("Error caused in CreateTaskTemplate task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) GetTaskTemplate(userId, templateId string) (*task.TaskTemplate, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.GetTaskTemplate(ctx, &task.TaskTemplateRequest{TemplateId: templateId, UserId: userId})
+	if err != nil {
+		c.Log.Error("Error caused in GetTaskTemplate task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) ListTaskTemplates(userId string) (*task.ListTaskTemplatesResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.ListTaskTemplates(ctx, &task.ListTaskTemplatesRequest{UserId: userId})
+	if err != nil {
+		c.Log.Error("Error caused in ListTaskTemplates task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) UpdateTaskTemplate(template *task.TaskTemplate) (*task.TaskTemplate, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.UpdateTaskTemplate(ctx, template)
+	if err != nil {
+		c.Log.Error("Error caused in UpdateTaskTemplate task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) DeleteTaskTemplate(userId, templateId string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	_, err := c.client.DeleteTaskTemplate(ctx, &task.TaskTemplateRequest{TemplateId: templateId, UserId: userId})
+	if err != nil {
+		c.Log.Error("Error caused in DeleteTaskTemplate task's client", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (c *Client) InstantiateTemplate(userId, templateId string, variables map[string]string, timezone string) (*task.InstantiateTemplateResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	req := &task.InstantiateTemplateRequest{
+		TemplateId: templateId,
+		UserId:     userId,
+		Variables:  variables,
+		Timezone:   timezone,
+	}
+	resp, err := c.client.InstantiateTemplate(ctx, req)
+	if err != nil {
+		c.Log.Error("Error caused in InstantiateTemplate task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) CreateCustomField(field *task.CustomFieldDefinition) (*task.CustomFieldDefinition, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.CreateCustomField(ctx, field)
+	if err != nil {
+		c.Log.Error("Error caused in CreateCustomField task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) ListCustomFields(userId, project string) (*task.ListCustomFieldsResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.ListCustomFields(ctx, &task.ListCustomFieldsRequest{UserId: userId, Project: project})
+	if err != nil {
+		c.Log.Error("Error caused in ListCustomFields task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) UpdateCustomField(field *task.CustomFieldDefinition) (*task.CustomFieldDefinition, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.UpdateCustomField(ctx, field)
+	if err != nil {
+		c.Log.Error("Error caused in UpdateCustomField task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) DeleteCustomField(userId, fieldId string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	_, err := c.client.DeleteCustomField(ctx, &task.CustomFieldRequest{FieldId: fieldId, UserId: userId})
+	if err != nil {
+		c.Log.Error("Error caused in DeleteCustomField task's client", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (c *Client) ListSLAPolicies() (*task.ListSLAPoliciesResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.ListSLAPolicies(ctx, &task.ListSLAPoliciesRequest{})
+	if err != nil {
+		c.Log.Error("Error caused in ListSLAPolicies task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) UpdateSLAPolicy(policy *task.SLAPolicy) (*task.SLAPolicy, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.UpdateSLAPolicy(ctx, policy)
+	if err != nil {
+		c.Log.Error("Error caused in UpdateSLAPolicy task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *Client) Close() error {
 	if c.conn != nil {
 		return c.conn.Close()
 	}
 	return nil
 }
+
+func (c *Client) CreateWebhook(hook *task.Webhook) (*task.Webhook, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.CreateWebhook(ctx, hook)
+	if err != nil {
+		c.Log.Error("Error caused in CreateWebhook task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) ListWebhooks(userId string) (*task.ListWebhooksResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.ListWebhooks(ctx, &task.ListWebhooksRequest{UserId: userId})
+	if err != nil {
+		c.Log.Error("Error caused in ListWebhooks task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) UpdateWebhook(hook *task.Webhook) (*task.Webhook, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.UpdateWebhook(ctx, hook)
+	if err != nil {
+		c.Log.Error("Error caused in UpdateWebhook task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) DeleteWebhook(userId, webhookId string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	_, err := c.client.DeleteWebhook(ctx, &task.WebhookRequest{WebhookId: webhookId, UserId: userId})
+	if err != nil {
+		c.Log.Error("Error caused in DeleteWebhook task's client", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (c *Client) ListWebhookDeliveries(userId, webhookId string, limit int32) (*task.ListWebhookDeliveriesResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	req := &task.ListWebhookDeliveriesRequest{WebhookId: webhookId, UserId: userId, Limit: limit}
+	resp, err := c.client.ListWebhookDeliveries(ctx, req)
+	if err != nil {
+		c.Log.Error("Error caused in ListWebhookDeliveries task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) RedeliverWebhook(userId, webhookId, deliveryId string) (*task.WebhookDelivery, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	req := &task.RedeliverWebhookRequest{WebhookId: webhookId, UserId: userId, DeliveryId: deliveryId}
+	resp, err := c.client.RedeliverWebhook(ctx, req)
+	if err != nil {
+		c.Log.Error("Error caused in RedeliverWebhook task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) ListNotifications(req *task.ListNotificationsRequest) (*task.ListNotificationsResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.ListNotifications(ctx, req)
+	if err != nil {
+		c.Log.Error("Error caused in ListNotifications task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) MarkRead(userId, notificationId string) (*task.MarkReadResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.MarkRead(ctx, &task.MarkReadRequest{UserId: userId, NotificationId: notificationId})
+	if err != nil {
+		c.Log.Error("Error caused in MarkRead task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) MarkAllRead(userId string) (*task.MarkReadResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.MarkAllRead(ctx, &task.MarkAllReadRequest{UserId: userId})
+	if err != nil {
+		c.Log.Error("Error caused in MarkAllRead task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) GetNextTasks(req *task.GetNextTasksRequest) (*task.GetNextTasksResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.GetNextTasks(ctx, req)
+	if err != nil {
+		c.Log.Error("Error caused in GetNextTasks task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) GetEisenhowerMatrix(req *task.GetEisenhowerMatrixRequest) (*task.GetEisenhowerMatrixResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.GetEisenhowerMatrix(ctx, req)
+	if err != nil {
+		c.Log.Error("Error caused in GetEisenhowerMatrix task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) SetQuadrantOverride(userId, taskId, quadrant string) (*task.SetQuadrantOverrideResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.SetQuadrantOverride(ctx, &task.SetQuadrantOverrideRequest{
+		UserId:   userId,
+		TaskId:   taskId,
+		Quadrant: quadrant,
+	})
+	if err != nil {
+		c.Log.Error("Error caused in SetQuadrantOverride task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) GetCalendar(req *task.GetCalendarRequest) (*task.GetCalendarResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.GetCalendar(ctx, req)
+	if err != nil {
+		c.Log.Error("Error caused in GetCalendar task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) GetChecklist(userId, taskId string) (*task.ChecklistResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.GetChecklist(ctx, &task.ChecklistRequest{TaskId: taskId, UserId: userId})
+	if err != nil {
+		c.Log.Error("Error caused in GetChecklist task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) AddChecklistItem(userId, taskId, text string) (*task.ChecklistResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.AddChecklistItem(ctx, &task.AddChecklistItemRequest{TaskId: taskId, UserId: userId, Text: text})
+	if err != nil {
+		c.Log.Error("Error caused in AddChecklistItem task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) ToggleChecklistItem(userId, taskId, itemId string) (*task.ChecklistResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.ToggleChecklistItem(ctx, &task.ChecklistItemRequest{TaskId: taskId, UserId: userId, ItemId: itemId})
+	if err != nil {
+		c.Log.Error("Error caused in ToggleChecklistItem task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) ReorderChecklist(userId, taskId string, itemIds []string) (*task.ChecklistResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.ReorderChecklist(ctx, &task.ReorderChecklistRequest{TaskId: taskId, UserId: userId, ItemIds: itemIds})
+	if err != nil {
+		c.Log.Error("Error caused in ReorderChecklist task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) DeleteChecklistItem(userId, taskId, itemId string) (*task.ChecklistResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.DeleteChecklistItem(ctx, &task.ChecklistItemRequest{TaskId: taskId, UserId: userId, ItemId: itemId})
+	if err != nil {
+		c.Log.Error("Error caused in DeleteChecklistItem task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) ConvertChecklistItem(userId, taskId, itemId string) (*task.TaskResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.ConvertChecklistItem(ctx, &task.ChecklistItemRequest{TaskId: taskId, UserId: userId, ItemId: itemId})
+	if err != nil {
+		c.Log.Error("Error caused in ConvertChecklistItem task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) ShareTask(userId, taskId, granteeId, permission string) (*task.TaskShare, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.ShareTask(ctx, &task.ShareTaskRequest{
+		TaskId:     taskId,
+		UserId:     userId,
+		GranteeId:  granteeId,
+		Permission: permission,
+	})
+	if err != nil {
+		c.Log.Error("Error caused in ShareTask task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) UnshareTask(userId, taskId, granteeId string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	_, err := c.client.UnshareTask(ctx, &task.UnshareTaskRequest{TaskId: taskId, UserId: userId, GranteeId: granteeId})
+	if err != nil {
+		c.Log.Error("Error caused in UnshareTask task's client", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (c *Client) ListShares(userId, taskId string) (*task.ListSharesResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.ListShares(ctx, &task.ListSharesRequest{TaskId: taskId, UserId: userId})
+	if err != nil {
+		c.Log.Error("Error caused in ListShares task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) CreatePublicLink(userId, taskId, expiresAt, timezone string) (*task.PublicLink, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.CreatePublicLink(ctx, &task.CreatePublicLinkRequest{
+		TaskId:    taskId,
+		UserId:    userId,
+		ExpiresAt: expiresAt,
+		Timezone:  timezone,
+	})
+	if err != nil {
+		c.Log.Error("Error caused in CreatePublicLink task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) ListPublicLinks(userId, taskId string) (*task.ListPublicLinksResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.ListPublicLinks(ctx, &task.ListPublicLinksRequest{TaskId: taskId, UserId: userId})
+	if err != nil {
+		c.Log.Error("Error caused in ListPublicLinks task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) RevokePublicLink(userId, taskId, linkId string) (*task.PublicLink, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.RevokePublicLink(ctx, &task.RevokePublicLinkRequest{TaskId: taskId, UserId: userId, LinkId: linkId})
+	if err != nil {
+		c.Log.Error("Error caused in RevokePublicLink task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) GetPublicTask(token string) (*task.PublicTask, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	resp, err := c.client.GetPublicTask(ctx, &task.GetPublicTaskRequest{Token: token})
+	if err != nil {
+		c.Log.Info("Error caused in GetPublicTask task's client", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
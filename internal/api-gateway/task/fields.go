@@ -0,0 +1,188 @@
+package task
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/api-gateway/apierror"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+// customFieldQueryPrefix - параметры вида cf.<key>=value или cf.<key>.<op>=value
+const customFieldQueryPrefix = "cf."
+
+func (h *Handler) CreateField(c *gin.Context) {
+	var req entity.CustomFieldDefinition
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Log.Error("Invalid CreateField request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+			Error:   "VALIDATION_ERROR",
+			Message: "Invalid request data",
+		})
+		return
+	}
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	req.UserID = userID
+
+	resp, err := h.taskClient.CreateCustomField(customFieldToProto(&req))
+	if err != nil {
+		h.Log.Error("Error caused after calling func CreateCustomField in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, customFieldFromProto(resp))
+}
+
+// ListFields возвращает определения пользователя; ?project= - только действующие в проекте
+func (h *Handler) ListFields(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.ListCustomFields(userID, c.Query("project"))
+	if err != nil {
+		h.Log.Error("Error caused after calling func ListCustomFields in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	response := entity.CustomFieldListResponse{Fields: []*entity.CustomFieldDefinition{}, Total: resp.Total}
+	for _, field := range resp.Fields {
+		response.Fields = append(response.Fields, customFieldFromProto(field))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) UpdateField(c *gin.Context) {
+	var req entity.CustomFieldDefinition
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Log.Error("Invalid UpdateField request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+			Error:   "VALIDATION_ERROR",
+			Message: "Invalid request data",
+		})
+		return
+	}
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	req.ID = c.Param("id")
+	req.UserID = userID
+
+	resp, err := h.taskClient.UpdateCustomField(customFieldToProto(&req))
+	if err != nil {
+		h.Log.Error("Error caused after calling func UpdateCustomField in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, customFieldFromProto(resp))
+}
+
+func (h *Handler) DeleteField(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.taskClient.DeleteCustomField(userID, c.Param("id")); err != nil {
+		h.Log.Error("Error caused after calling func DeleteCustomField in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// customFieldQuery собирает условия из параметров cf.<key>[.<op>]=value
+func customFieldQuery(c *gin.Context) []*task.CustomFieldCondition {
+	var conditions []*task.CustomFieldCondition
+	for param, values := range c.Request.URL.Query() {
+		rest, ok := strings.CutPrefix(param, customFieldQueryPrefix)
+		if !ok || rest == "" {
+			continue
+		}
+		key, op, _ := strings.Cut(rest, ".")
+		for _, value := range values {
+			conditions = append(conditions, &task.CustomFieldCondition{Key: key, Op: op, Value: value})
+		}
+	}
+	// порядок параметров в map случаен, а от него зависит текст SQL запроса
+	sort.Slice(conditions, func(i, j int) bool {
+		if conditions[i].Key != conditions[j].Key {
+			return conditions[i].Key < conditions[j].Key
+		}
+		return conditions[i].Op < conditions[j].Op
+	})
+	return conditions
+}
+
+// sortQuery переводит ?sort=cf.<key> в формат сервиса "cf:<key>"
+func sortQuery(field string) string {
+	if key, ok := strings.CutPrefix(field, customFieldQueryPrefix); ok {
+		return entity.SortByCustomFieldPrefix + key
+	}
+	return field
+}
+
+func customFieldToProto(field *entity.CustomFieldDefinition) *task.CustomFieldDefinition {
+	return &task.CustomFieldDefinition{
+		Id:       field.ID,
+		UserId:   field.UserID,
+		Project:  field.Project,
+		Key:      field.Key,
+		Name:     field.Name,
+		Type:     field.Type,
+		Options:  field.Options,
+		Required: field.Required,
+	}
+}
+
+func customFieldFromProto(field *task.CustomFieldDefinition) *entity.CustomFieldDefinition {
+	return &entity.CustomFieldDefinition{
+		ID:        field.Id,
+		UserID:    field.UserId,
+		Project:   field.Project,
+		Key:       field.Key,
+		Name:      field.Name,
+		Type:      field.Type,
+		Options:   field.Options,
+		Required:  field.Required,
+		CreatedAt: field.CreatedAt.AsTime(),
+		UpdatedAt: field.UpdatedAt.AsTime(),
+	}
+}
+
+func conditionsToProto(conditions []entity.CustomFieldCondition) []*task.CustomFieldCondition {
+	var result []*task.CustomFieldCondition
+	for _, condition := range conditions {
+		result = append(result, &task.CustomFieldCondition{
+			Key:   condition.Key,
+			Op:    condition.Op,
+			Value: fmt.Sprint(condition.Value),
+		})
+	}
+	return result
+}
+
+func conditionsFromProto(conditions []*task.CustomFieldCondition) []entity.CustomFieldCondition {
+	var result []entity.CustomFieldCondition
+	for _, condition := range conditions {
+		result = append(result, entity.CustomFieldCondition{
+			Key:   condition.Key,
+			Op:    condition.Op,
+			Value: condition.Value,
+		})
+	}
+	return result
+}
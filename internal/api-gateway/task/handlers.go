@@ -1,12 +1,17 @@
 package task
 
 import (
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/oogway93/taskmanager/config"
+	"github.com/oogway93/taskmanager/gen/task"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/oogway93/taskmanager/internal/api-gateway/apierror"
 	AuthHandler "github.com/oogway93/taskmanager/internal/api-gateway/auth"
 	"github.com/oogway93/taskmanager/internal/entity"
 )
@@ -56,35 +61,18 @@ func (h *Handler) Create(c *gin.Context) {
 		return
 	}
 
-	respAuth, err := h.authClient.GetUserProfile(userID.(string))
-	if err != nil {
-		h.Log.Error("Error caused after calling func GetUserProfile in api-gateway task's handlers", zap.Error(err))
-		return
-	}
-
 	req.User_id = userID.(string)
 
 	// Вызов gRPC сервиса аутентификации
 	respTask, err := h.taskClient.CreateTask(req)
 	if err != nil {
 		h.Log.Error("Error caused after calling func CreateTask in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
 		return
 	}
 
 	// Преобразование gRPC ответа в HTTP ответ
-	response := entity.TaskResponse{
-		Task: &entity.Task{
-			ID:          respTask.Task.Id,
-			Title:       respTask.Task.Title,
-			Description: respTask.Task.Description,
-			Priority:    respTask.Task.Priority,
-			Status:      respTask.Task.Status,
-			Tags:        respTask.Task.Tags,
-			User_id:     respAuth.User.Id,
-			CreatedAt:   respTask.Task.CreatedAt.AsTime(),
-			UpdatedAt:   respTask.Task.UpdatedAt.AsTime(),
-		},
-	}
+	response := entity.TaskResponse{Task: taskFromProto(respTask.Task)}
 
 	c.JSON(http.StatusCreated, response)
 }
@@ -98,7 +86,7 @@ func (h *Handler) ListTasks(c *gin.Context) {
 		})
 		return
 	}
-	
+
 	username, existsUsername := c.Get("username")
 	if !existsUsername {
 		h.Log.Error("Failed error in getting username from header")
@@ -109,27 +97,70 @@ func (h *Handler) ListTasks(c *gin.Context) {
 		return
 	}
 
-	respTask, err := h.taskClient.ListTasks(userID.(string))
+	// ?view_id=&tz= - сохраненное представление (tz по умолчанию из профиля), ?project=&sort=cf.story_points&order=desc,
+	// ?cf.sla_tier=gold&cf.story_points.gte=3 - условия на пользовательские поля,
+	// ?include_deferred=true - вместе с еще не начатыми и отложенными задачами,
+	// ?shared=true - задачи других пользователей, к которым выдан доступ
+	timezone := c.Query("tz")
+	if c.Query("view_id") != "" {
+		var ok bool
+		if timezone, ok = h.userTimezone(c, userID.(string), timezone); !ok {
+			return
+		}
+	}
+	req := &task.ListTasksRequest{
+		UserId:          userID.(string),
+		ViewId:          c.Query("view_id"),
+		Timezone:        timezone,
+		Project:         c.Query("project"),
+		CustomFields:    customFieldQuery(c),
+		SortBy:          sortQuery(c.Query("sort")),
+		SortDesc:        c.Query("order") == "desc",
+		IncludeDeferred: c.Query("include_deferred") == "true",
+		SharedWithMe:    c.Query("shared") == "true",
+	}
+	respTask, err := h.taskClient.ListTasks(req)
 	if err != nil {
-		h.Log.Error("Error caused after calling func CreateTask in api-gateway task's handlers", zap.Error(err))
+		h.Log.Error("Error caused after calling func ListTasks in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
 		return
 	}
 	var tasksEntity []*entity.TaskListData
 	for i := 0; i < len(respTask.Tasks); i++ {
 		taskEntity := &entity.TaskListData{
-			Title:       respTask.Tasks[i].Title,
-			Description: respTask.Tasks[i].Description,
-			Priority:    respTask.Tasks[i].Priority,
-			Status:      respTask.Tasks[i].Status,
-			Tags:        respTask.Tasks[i].Tags,
-			CreatedAt:   respTask.Tasks[i].CreatedAt.AsTime(),
-			UpdatedAt:   respTask.Tasks[i].UpdatedAt.AsTime(),
+			ID:           respTask.Tasks[i].Id,
+			UserID:       respTask.Tasks[i].UserId,
+			Title:        respTask.Tasks[i].Title,
+			Description:  respTask.Tasks[i].Description,
+			Priority:     respTask.Tasks[i].Priority,
+			Status:       respTask.Tasks[i].Status,
+			Tags:         respTask.Tasks[i].Tags,
+			CreatedAt:    respTask.Tasks[i].CreatedAt.AsTime(),
+			UpdatedAt:    respTask.Tasks[i].UpdatedAt.AsTime(),
+			Project:      respTask.Tasks[i].Project,
+			CustomFields: respTask.Tasks[i].CustomFields.AsMap(),
+			SLAStatus:    respTask.Tasks[i].SlaStatus,
+		}
+		if respTask.Tasks[i].SlaDueAt != nil {
+			slaDueAt := respTask.Tasks[i].SlaDueAt.AsTime()
+			taskEntity.SLADueAt = &slaDueAt
+		}
+		if respTask.Tasks[i].StartAt != nil {
+			startAt := respTask.Tasks[i].StartAt.AsTime()
+			taskEntity.StartAt = &startAt
+		}
+		if respTask.Tasks[i].SnoozedUntil != nil {
+			snoozedUntil := respTask.Tasks[i].SnoozedUntil.AsTime()
+			taskEntity.SnoozedUntil = &snoozedUntil
+		}
+		if progress := checklistProgressFromProto(respTask.Tasks[i].Checklist); progress.Total > 0 {
+			taskEntity.Checklist = &progress
 		}
 		tasksEntity = append(tasksEntity, taskEntity)
 	}
 	response := &entity.TaskListResponse{
-		Tasks: tasksEntity,
-		Total: respTask.Total,
+		Tasks:    tasksEntity,
+		Total:    respTask.Total,
 		Username: username.(string),
 	}
 	c.JSON(http.StatusOK, response)
@@ -146,32 +177,295 @@ func (h *Handler) GetTask(c *gin.Context) {
 		})
 	}
 
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
 	req := entity.GetTaskRequest{
 		TaskId: taskId,
+		UserId: userID,
 	}
 
 	respTask, err := h.taskClient.GetTask(req)
 	if err != nil {
-		h.Log.Fatal("Error caused after calling func CreateTask in api-gateway task's handlers", zap.Error(err))
+		// чужая задача без выданного доступа неотличима от несуществующей
+		h.Log.Error("Error caused after calling func GetTask in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
 		return
 	}
 
 	response := &entity.TaskResponse{
 		Task: &entity.Task{
-			ID:          respTask.Task.Id,
-			Title:       respTask.Task.Title,
-			Description: respTask.Task.Description,
-			Priority:    respTask.Task.Priority,
-			Status:      respTask.Task.Status,
-			Tags:        respTask.Task.Tags,
-			User_id:     respTask.Task.UserId,
-			CreatedAt:   respTask.Task.CreatedAt.AsTime(),
-			UpdatedAt:   respTask.Task.UpdatedAt.AsTime(),
+			ID:           respTask.Task.Id,
+			Title:        respTask.Task.Title,
+			Description:  respTask.Task.Description,
+			Priority:     respTask.Task.Priority,
+			Status:       respTask.Task.Status,
+			Tags:         respTask.Task.Tags,
+			User_id:      respTask.Task.UserId,
+			CreatedAt:    respTask.Task.CreatedAt.AsTime(),
+			UpdatedAt:    respTask.Task.UpdatedAt.AsTime(),
+			Project:      respTask.Task.Project,
+			CustomFields: respTask.Task.CustomFields.AsMap(),
+			SLADueAt:     protoTime(respTask.Task.SlaDueAt),
+			SLAStatus:    respTask.Task.SlaStatus,
+			StartAt:      protoTime(respTask.Task.StartAt),
+			SnoozedUntil: protoTime(respTask.Task.SnoozedUntil),
+			Checklist:    checklistProgressFromProto(respTask.Task.Checklist),
 		},
 	}
 	c.JSON(http.StatusOK, response)
 }
 
+// UpdateTask частично обновляет задачу: пустые поля запроса не меняются
+func (h *Handler) UpdateTask(c *gin.Context) {
+	var req entity.UpdateTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Log.Error("Invalid UpdateTask request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+			Error:   "VALIDATION_ERROR",
+			Message: "Invalid request data",
+		})
+		return
+	}
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, entity.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	respTask, err := h.taskClient.UpdateTask(userID.(string), c.Param("id"), req)
+	if err != nil {
+		h.Log.Error("Error caused after calling func UpdateTask in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, entity.TaskResponse{Task: taskFromProto(respTask.Task)})
+}
+
+// SnoozeTask откладывает задачу до момента "until": RFC3339 или выражение вида "now+2h",
+// "tomorrow+9h" в часовом поясе "timezone" (по умолчанию из профиля)
+func (h *Handler) SnoozeTask(c *gin.Context) {
+	var req entity.SnoozeTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Log.Error("Invalid SnoozeTask request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+			Error:   "VALIDATION_ERROR",
+			Message: "Field 'until' is required",
+		})
+		return
+	}
+	h.snoozeTask(c, req.Until, req.Timezone)
+}
+
+// UnsnoozeTask возвращает отложенную задачу в список
+func (h *Handler) UnsnoozeTask(c *gin.Context) {
+	h.snoozeTask(c, "", "")
+}
+
+func (h *Handler) snoozeTask(c *gin.Context, until, timezone string) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	if until != "" {
+		if timezone, ok = h.userTimezone(c, userID, timezone); !ok {
+			return
+		}
+	}
+
+	respTask, err := h.taskClient.SnoozeTask(userID, c.Param("id"), until, timezone)
+	if err != nil {
+		h.Log.Error("Error caused after calling func SnoozeTask in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, entity.TaskResponse{Task: taskFromProto(respTask.Task)})
+}
+
+// CloneTask копирует задачу с тегами и чек-листом. Тело запроса необязательно:
+// include_subtasks - копировать дерево подзадач, reset_status - начать копии с PENDING,
+// shift_due_by - сдвинуть сроки ("+7d", "-2h", "+1w") в часовом поясе timezone (по умолчанию из профиля)
+func (h *Handler) CloneTask(c *gin.Context) {
+	var req entity.CloneTaskOptions
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			h.Log.Error("Invalid CloneTask request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+				Error:   "VALIDATION_ERROR",
+				Message: "Invalid request data",
+			})
+			return
+		}
+	}
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	if req.ShiftDueBy != "" {
+		if req.Timezone, ok = h.userTimezone(c, userID, req.Timezone); !ok {
+			return
+		}
+	}
+
+	respTask, err := h.taskClient.CloneTask(userID, c.Param("id"), req)
+	if err != nil {
+		h.Log.Error("Error caused after calling func CloneTask in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, entity.TaskResponse{Task: taskFromProto(respTask.Task)})
+}
+
+// QuickAdd создает задачу из строки вида "Call client tomorrow 17:00 #support !high".
+// С "preview": true задача только распознается и возвращается клиенту без сохранения.
+// Относительные даты считаются в часовом поясе "timezone", по умолчанию - из профиля
+func (h *Handler) QuickAdd(c *gin.Context) {
+	var req entity.QuickAddRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Log.Error("Invalid QuickAdd request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+			Error:   "VALIDATION_ERROR",
+			Message: "Invalid request data",
+		})
+		return
+	}
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, entity.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	timezone, ok := h.userTimezone(c, userID.(string), req.Timezone)
+	if !ok {
+		return
+	}
+	req.Timezone = timezone
+
+	respTask, err := h.taskClient.QuickAddTask(userID.(string), req)
+	if err != nil {
+		h.Log.Error("Error caused after calling func QuickAddTask in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	status := http.StatusOK
+	if respTask.Saved {
+		status = http.StatusCreated
+	}
+	c.JSON(status, entity.QuickAddResponse{
+		Task:  taskFromProto(respTask.Task),
+		Saved: respTask.Saved,
+	})
+}
+
+// maxICSSize ограничивает размер загружаемого .ics файла. Файл целиком уходит в task service
+// одним gRPC сообщением, поэтому лимит оставляет запас до 4 МБ - размера сообщения gRPC по умолчанию
+const maxICSSize = 3 << 20
+
+// ImportICS принимает .ics файл (multipart поле "file" или тело запроса text/calendar)
+func (h *Handler) ImportICS(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, entity.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	data, err := readICS(c)
+	if err != nil || len(data) == 0 {
+		h.Log.Error("Invalid ImportICS request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+			Error:   "VALIDATION_ERROR",
+			Message: "iCalendar file is required",
+		})
+		return
+	}
+
+	respTask, err := h.taskClient.ImportICS(userID.(string), data)
+	if err != nil {
+		h.Log.Error("Error caused after calling func ImportICS in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	response := &entity.ImportResult{
+		Created: respTask.Created,
+		Updated: respTask.Updated,
+		Skipped: respTask.Skipped,
+	}
+	for _, t := range respTask.Tasks {
+		response.Tasks = append(response.Tasks, taskFromProto(t))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+func readICS(c *gin.Context) ([]byte, error) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxICSSize)
+
+	if file, err := c.FormFile("file"); err == nil {
+		f, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return io.ReadAll(f)
+	}
+	return io.ReadAll(c.Request.Body)
+}
+
+func taskFromProto(t *task.Task) *entity.Task {
+	return &entity.Task{
+		ID:           t.Id,
+		Title:        t.Title,
+		Description:  t.Description,
+		Priority:     t.Priority,
+		Status:       t.Status,
+		Tags:         t.Tags,
+		User_id:      t.UserId,
+		CreatedAt:    t.CreatedAt.AsTime(),
+		UpdatedAt:    t.UpdatedAt.AsTime(),
+		DueDate:      protoTime(t.DueDate),
+		Estimate:     t.Estimate,
+		ParentID:     t.ParentId,
+		Project:      t.Project,
+		CustomFields: t.CustomFields.AsMap(),
+
+		SLAResponseDueAt: protoTime(t.SlaResponseDueAt),
+		SLADueAt:         protoTime(t.SlaDueAt),
+		RespondedAt:      protoTime(t.RespondedAt),
+		SLAStatus:        t.SlaStatus,
+		StartAt:          protoTime(t.StartAt),
+		SnoozedUntil:     protoTime(t.SnoozedUntil),
+		Checklist:        checklistProgressFromProto(t.Checklist),
+	}
+}
+
+// protoTime возвращает нулевое время для незаданного timestamp
+func protoTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
 func (h *Handler) Close() {
 	h.taskClient.Close()
 }
@@ -0,0 +1,111 @@
+package task
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/api-gateway/apierror"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+// EisenhowerMatrix раскладывает открытые задачи по квадрантам матрицы Эйзенхауэра.
+// ?project= - только задачи проекта; пороги: ?important_priority=, ?urgent_within_hours=, ?urgent_sla_status=
+func (h *Handler) EisenhowerMatrix(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	thresholds := &task.MatrixThresholds{
+		ImportantPriority: c.Query("important_priority"),
+		UrgentSlaStatus:   c.Query("urgent_sla_status"),
+	}
+	if value := c.Query("urgent_within_hours"); value != "" {
+		hours, err := strconv.Atoi(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+				Error:   "VALIDATION_ERROR",
+				Message: "Invalid 'urgent_within_hours' query parameter",
+			})
+			return
+		}
+		thresholds.UrgentWithinHours = int32(hours)
+	}
+
+	resp, err := h.taskClient.GetEisenhowerMatrix(&task.GetEisenhowerMatrixRequest{
+		UserId:     userID,
+		Project:    c.Query("project"),
+		Thresholds: thresholds,
+	})
+	if err != nil {
+		h.Log.Error("Error caused after calling func GetEisenhowerMatrix in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	matrix := entity.EisenhowerMatrix{
+		DoFirst:   matrixTasksFromProto(resp.DoFirst),
+		Schedule:  matrixTasksFromProto(resp.Schedule),
+		Delegate:  matrixTasksFromProto(resp.Delegate),
+		Eliminate: matrixTasksFromProto(resp.Eliminate),
+	}
+	if resp.Thresholds != nil {
+		matrix.Thresholds = entity.MatrixThresholds{
+			ImportantPriority: resp.Thresholds.ImportantPriority,
+			UrgentWithinHours: int(resp.Thresholds.UrgentWithinHours),
+			UrgentSLAStatus:   resp.Thresholds.UrgentSlaStatus,
+		}
+	}
+	c.JSON(http.StatusOK, matrix)
+}
+
+// SetQuadrant закрепляет задачу в квадранте матрицы независимо от приоритета и срока
+func (h *Handler) SetQuadrant(c *gin.Context) {
+	var req entity.QuadrantOverrideRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Quadrant == "" {
+		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+			Error:   "VALIDATION_ERROR",
+			Message: "Field 'quadrant' is required",
+		})
+		return
+	}
+	h.setQuadrant(c, req.Quadrant)
+}
+
+// ClearQuadrant снимает ручное закрепление, задача возвращается в вычисленный квадрант
+func (h *Handler) ClearQuadrant(c *gin.Context) {
+	h.setQuadrant(c, "")
+}
+
+func (h *Handler) setQuadrant(c *gin.Context, quadrant string) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.SetQuadrantOverride(userID, c.Param("id"), quadrant)
+	if err != nil {
+		h.Log.Error("Error caused after calling func SetQuadrantOverride in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, entity.QuadrantOverrideResponse{TaskID: resp.TaskId, Quadrant: resp.Quadrant})
+}
+
+func matrixTasksFromProto(items []*task.MatrixTask) []entity.MatrixTask {
+	result := make([]entity.MatrixTask, 0, len(items))
+	for _, item := range items {
+		result = append(result, entity.MatrixTask{
+			Task:       taskFromProto(item.Task),
+			Quadrant:   item.Quadrant,
+			Important:  item.Important,
+			Urgent:     item.Urgent,
+			Overridden: item.Overridden,
+			Reason:     item.Reason,
+		})
+	}
+	return result
+}
@@ -0,0 +1,71 @@
+package task
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/api-gateway/apierror"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+// weightQueryPrefix - префикс параметров с весами факторов: ?weight.due_date=5
+const weightQueryPrefix = "weight."
+
+// NextTasks возвращает открытые задачи в порядке, в котором их стоит делать, с объяснением оценки.
+// ?project= - только задачи проекта, ?limit= - размер списка, ?weight.<factor>= - вес фактора
+func (h *Handler) NextTasks(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	weights := make(map[string]float64)
+	for param, values := range c.Request.URL.Query() {
+		factor, ok := strings.CutPrefix(param, weightQueryPrefix)
+		if !ok || len(values) == 0 {
+			continue
+		}
+		weight, err := strconv.ParseFloat(values[0], 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+				Error:   "VALIDATION_ERROR",
+				Message: "Invalid '" + param + "' query parameter",
+			})
+			return
+		}
+		weights[factor] = weight
+	}
+
+	resp, err := h.taskClient.GetNextTasks(&task.GetNextTasksRequest{
+		UserId:  userID,
+		Project: c.Query("project"),
+		Limit:   int32(limit),
+		Weights: weights,
+	})
+	if err != nil {
+		h.Log.Error("Error caused after calling func GetNextTasks in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	response := entity.NextTasksResponse{Tasks: []entity.ScoredTask{}, Weights: resp.Weights}
+	for _, scored := range resp.Tasks {
+		item := entity.ScoredTask{Task: taskFromProto(scored.Task), Score: scored.Score, Factors: []entity.ScoreFactor{}}
+		for _, factor := range scored.Factors {
+			item.Factors = append(item.Factors, entity.ScoreFactor{
+				Factor:       factor.Factor,
+				Value:        factor.Value,
+				Weight:       factor.Weight,
+				Contribution: factor.Contribution,
+				Reason:       factor.Reason,
+			})
+		}
+		response.Tasks = append(response.Tasks, item)
+	}
+	c.JSON(http.StatusOK, response)
+}
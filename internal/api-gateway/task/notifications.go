@@ -0,0 +1,90 @@
+package task
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/api-gateway/apierror"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+// ListNotifications возвращает уведомления пользователя и число непрочитанных.
+// ?unread=true - только непрочитанные, ?limit= и ?offset= - страница списка
+func (h *Handler) ListNotifications(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+
+	resp, err := h.taskClient.ListNotifications(&task.ListNotificationsRequest{
+		UserId:     userID,
+		UnreadOnly: c.Query("unread") == "true",
+		Limit:      int32(limit),
+		Offset:     int32(offset),
+	})
+	if err != nil {
+		h.Log.Error("Error caused after calling func ListNotifications in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	response := entity.NotificationListResponse{
+		Notifications: []*entity.Notification{},
+		Total:         resp.Total,
+		UnreadCount:   resp.UnreadCount,
+	}
+	for _, notification := range resp.Notifications {
+		response.Notifications = append(response.Notifications, notificationFromProto(notification))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) MarkNotificationRead(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.MarkRead(userID, c.Param("id"))
+	if err != nil {
+		h.Log.Error("Error caused after calling func MarkRead in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, entity.MarkNotificationsReadResponse{Updated: resp.Updated, UnreadCount: resp.UnreadCount})
+}
+
+func (h *Handler) MarkAllNotificationsRead(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.MarkAllRead(userID)
+	if err != nil {
+		h.Log.Error("Error caused after calling func MarkAllRead in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, entity.MarkNotificationsReadResponse{Updated: resp.Updated, UnreadCount: resp.UnreadCount})
+}
+
+func notificationFromProto(notification *task.Notification) *entity.Notification {
+	readAt := optionalProtoTime(notification.ReadAt)
+	return &entity.Notification{
+		ID:        notification.Id,
+		UserID:    notification.UserId,
+		Type:      notification.Type,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		TaskID:    notification.TaskId,
+		Read:      readAt != nil,
+		ReadAt:    readAt,
+		CreatedAt: protoTime(notification.CreatedAt),
+	}
+}
@@ -0,0 +1,182 @@
+package task
+
+import (
+	"html/template"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/api-gateway/apierror"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+// publicTaskPath - префикс публичной ссылки; совпадает с маршрутом, открытым в isPublicRoute
+const publicTaskPath = "/api/v1/public/tasks/"
+
+// publicTaskPage экранирует все поля задачи через html/template: описание пишет владелец задачи,
+// а страницу открывают люди вне системы
+var publicTaskPage = template.Must(template.New("public_task").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="robots" content="noindex">
+<title>{{.Title}}</title>
+<style>body{font-family:sans-serif;max-width:40em;margin:2em auto;padding:0 1em}dt{color:#666}</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<dl>
+<dt>Status</dt><dd>{{.Status}}</dd>
+<dt>Priority</dt><dd>{{.Priority}}</dd>
+{{with .DueDate}}<dt>Due</dt><dd>{{.Format "2006-01-02 15:04 MST"}}</dd>{{end}}
+{{with .CompletedAt}}<dt>Completed</dt><dd>{{.Format "2006-01-02 15:04 MST"}}</dd>{{end}}
+{{with .Checklist}}<dt>Checklist</dt><dd>{{.Done}} / {{.Total}}</dd>{{end}}
+<dt>Updated</dt><dd>{{.UpdatedAt.Format "2006-01-02 15:04 MST"}}</dd>
+</dl>
+{{with .Description}}<p style="white-space:pre-wrap">{{.}}</p>{{end}}
+<p><small>Read-only link, valid until {{.LinkExpiresAt.Format "2006-01-02 15:04 MST"}}</small></p>
+</body>
+</html>
+`))
+
+// CreatePublicLink создает ссылку только для чтения. Тело необязательно: "expires_at" - RFC3339
+// или выражение вроде "now+7d" (по умолчанию неделя, не больше 90 дней). Токен виден только в этом ответе
+func (h *Handler) CreatePublicLink(c *gin.Context) {
+	var req entity.CreatePublicLinkRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			h.Log.Error("Invalid CreatePublicLink request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+				Error:   "VALIDATION_ERROR",
+				Message: "Invalid request data",
+			})
+			return
+		}
+	}
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	if req.ExpiresAt != "" {
+		if req.Timezone, ok = h.userTimezone(c, userID, req.Timezone); !ok {
+			return
+		}
+	}
+
+	resp, err := h.taskClient.CreatePublicLink(userID, c.Param("id"), req.ExpiresAt, req.Timezone)
+	if err != nil {
+		h.Log.Error("Error caused after calling func CreatePublicLink in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, publicLinkFromProto(resp))
+}
+
+// ListPublicLinks возвращает ссылки задачи владельца со счетчиками просмотров, без токенов
+func (h *Handler) ListPublicLinks(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.ListPublicLinks(userID, c.Param("id"))
+	if err != nil {
+		h.Log.Error("Error caused after calling func ListPublicLinks in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	links := entity.PublicLinksResponse{Links: make([]entity.PublicLink, 0, len(resp.Links))}
+	for _, link := range resp.Links {
+		links.Links = append(links.Links, publicLinkFromProto(link))
+	}
+	c.JSON(http.StatusOK, links)
+}
+
+func (h *Handler) RevokePublicLink(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.RevokePublicLink(userID, c.Param("id"), c.Param("link"))
+	if err != nil {
+		h.Log.Error("Error caused after calling func RevokePublicLink in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, publicLinkFromProto(resp))
+}
+
+// GetPublicTask отдает задачу по публичной ссылке без JWT: JSON по умолчанию, HTML при ?format=html
+// или Accept: text/html. Неизвестная, истекшая и отозванная ссылки неотличимы друг от друга
+func (h *Handler) GetPublicTask(c *gin.Context) {
+	c.Header("Cache-Control", "no-store")
+	c.Header("Referrer-Policy", "no-referrer")
+	c.Header("X-Robots-Tag", "noindex")
+
+	resp, err := h.taskClient.GetPublicTask(c.Param("token"))
+	if err != nil {
+		// сбой сервиса не выдаем за недействительную ссылку
+		if httpStatus, _ := apierror.FromGRPC(err); httpStatus >= http.StatusInternalServerError {
+			h.Log.Error("Error caused after calling func GetPublicTask in api-gateway task's handlers", zap.Error(err))
+			apierror.Respond(c, err)
+			return
+		}
+		c.JSON(http.StatusNotFound, entity.ErrorResponse{
+			Error:   "LINK_NOT_FOUND",
+			Message: "Link does not exist, has expired or was revoked",
+		})
+		return
+	}
+	view := publicTaskFromProto(resp)
+
+	if c.Query("format") == "html" || (c.Query("format") == "" && c.NegotiateFormat(gin.MIMEJSON, gin.MIMEHTML) == gin.MIMEHTML) {
+		c.Header("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'")
+		c.Header("Content-Type", "text/html; charset=utf-8")
+		c.Status(http.StatusOK)
+		if err := publicTaskPage.Execute(c.Writer, view); err != nil {
+			h.Log.Error("Error caused after rendering public task page in api-gateway task's handlers", zap.Error(err))
+		}
+		return
+	}
+	c.JSON(http.StatusOK, view)
+}
+
+func publicLinkFromProto(link *task.PublicLink) entity.PublicLink {
+	result := entity.PublicLink{
+		ID:           link.Id,
+		TaskID:       link.TaskId,
+		Token:        link.Token,
+		ExpiresAt:    protoTime(link.ExpiresAt),
+		RevokedAt:    optionalProtoTime(link.RevokedAt),
+		ViewCount:    link.ViewCount,
+		LastViewedAt: optionalProtoTime(link.LastViewedAt),
+		CreatedAt:    protoTime(link.CreatedAt),
+	}
+	if link.Token != "" {
+		result.URL = publicTaskPath + link.Token
+	}
+	return result
+}
+
+func publicTaskFromProto(resp *task.PublicTask) entity.PublicTaskView {
+	view := entity.PublicTaskView{
+		Title:         resp.Title,
+		Description:   resp.Description,
+		Status:        resp.Status,
+		Priority:      resp.Priority,
+		DueDate:       optionalProtoTime(resp.DueDate),
+		CompletedAt:   optionalProtoTime(resp.CompletedAt),
+		UpdatedAt:     protoTime(resp.UpdatedAt),
+		LinkExpiresAt: protoTime(resp.LinkExpiresAt),
+	}
+	if resp.Checklist != nil {
+		progress := checklistProgressFromProto(resp.Checklist)
+		view.Checklist = &progress
+	}
+	return view
+}
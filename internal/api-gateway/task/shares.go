@@ -0,0 +1,85 @@
+package task
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/api-gateway/apierror"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+// ShareTask выдает пользователю доступ к задаче: "permission": "READ" (по умолчанию) или "EDIT".
+// Повторный вызов меняет уровень доступа
+func (h *Handler) ShareTask(c *gin.Context) {
+	var req entity.ShareTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Log.Error("Invalid ShareTask request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+			Error:   "VALIDATION_ERROR",
+			Message: "Field 'user_id' is required",
+		})
+		return
+	}
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.ShareTask(userID, c.Param("id"), req.UserID, req.Permission)
+	if err != nil {
+		h.Log.Error("Error caused after calling func ShareTask in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, shareFromProto(resp))
+}
+
+// ListShares возвращает выданные доступы к задаче владельца
+func (h *Handler) ListShares(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.ListShares(userID, c.Param("id"))
+	if err != nil {
+		h.Log.Error("Error caused after calling func ListShares in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	shares := entity.TaskSharesResponse{Shares: make([]entity.TaskShare, 0, len(resp.Shares))}
+	for _, share := range resp.Shares {
+		shares.Shares = append(shares.Shares, shareFromProto(share))
+	}
+	c.JSON(http.StatusOK, shares)
+}
+
+// UnshareTask отзывает доступ пользователя :user; получатель может отозвать и свой доступ
+func (h *Handler) UnshareTask(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.taskClient.UnshareTask(userID, c.Param("id"), c.Param("user")); err != nil {
+		h.Log.Error("Error caused after calling func UnshareTask in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func shareFromProto(share *task.TaskShare) entity.TaskShare {
+	return entity.TaskShare{
+		TaskID:     share.TaskId,
+		GranteeID:  share.GranteeId,
+		Permission: share.Permission,
+		GrantedBy:  share.GrantedBy,
+		CreatedAt:  protoTime(share.CreatedAt),
+		UpdatedAt:  protoTime(share.UpdatedAt),
+	}
+}
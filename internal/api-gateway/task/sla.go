@@ -0,0 +1,62 @@
+package task
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/api-gateway/apierror"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) ListSLAPolicies(c *gin.Context) {
+	resp, err := h.taskClient.ListSLAPolicies()
+	if err != nil {
+		h.Log.Error("Error caused after calling func ListSLAPolicies in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	response := entity.SLAPolicyListResponse{Policies: []*entity.SLAPolicy{}}
+	for _, policy := range resp.Policies {
+		response.Policies = append(response.Policies, slaPolicyFromProto(policy))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateSLAPolicy задает цели SLA для приоритета из пути, например PUT /sla/policies/HIGH
+func (h *Handler) UpdateSLAPolicy(c *gin.Context) {
+	var req entity.SLAPolicy
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Log.Error("Invalid UpdateSLAPolicy request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+			Error:   "VALIDATION_ERROR",
+			Message: "Invalid request data",
+		})
+		return
+	}
+
+	resp, err := h.taskClient.UpdateSLAPolicy(&task.SLAPolicy{
+		Priority:          c.Param("priority"),
+		ResponseMinutes:   req.ResponseMinutes,
+		ResolutionMinutes: req.ResolutionMinutes,
+		AtRiskThreshold:   req.AtRiskThreshold,
+	})
+	if err != nil {
+		h.Log.Error("Error caused after calling func UpdateSLAPolicy in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, slaPolicyFromProto(resp))
+}
+
+func slaPolicyFromProto(policy *task.SLAPolicy) *entity.SLAPolicy {
+	return &entity.SLAPolicy{
+		Priority:          policy.Priority,
+		ResponseMinutes:   policy.ResponseMinutes,
+		ResolutionMinutes: policy.ResolutionMinutes,
+		AtRiskThreshold:   policy.AtRiskThreshold,
+		UpdatedAt:         policy.UpdatedAt.AsTime(),
+	}
+}
@@ -0,0 +1,173 @@
+package task
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/api-gateway/apierror"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Stats возвращает аналитику по задачам пользователя.
+// Параметры: from, to (RFC3339 или YYYY-MM-DD), tags (через запятую),
+// tz (IANA часовой пояс, по умолчанию из профиля пользователя)
+func (h *Handler) Stats(c *gin.Context) {
+	req, ok := h.statsRequest(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.GetTaskStats(req)
+	if err != nil {
+		h.Log.Error("Error caused after calling func GetTaskStats in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	stats := entity.TaskStats{
+		From:              resp.From.AsTime(),
+		To:                resp.To.AsTime(),
+		Created:           resp.Created,
+		Completed:         resp.Completed,
+		Daily:             bucketsFromProto(resp.Daily),
+		Weekly:            bucketsFromProto(resp.Weekly),
+		AvgCycleTimeHours: resp.AvgCycleTimeHours,
+		P90CycleTimeHours: resp.P90CycleTimeHours,
+		OverdueRate:       resp.OverdueRate,
+		TimeInStatus:      []entity.StatusDuration{},
+	}
+	for _, d := range resp.TimeInStatus {
+		stats.TimeInStatus = append(stats.TimeInStatus, entity.StatusDuration{
+			Status:     d.Status,
+			TotalHours: d.TotalHours,
+			AvgHours:   d.AvgHours,
+			Tasks:      d.Tasks,
+		})
+	}
+	c.JSON(http.StatusOK, stats)
+}
+
+// Burndown возвращает дневной ряд оставшихся открытых задач и оценок (параметры как у Stats)
+func (h *Handler) Burndown(c *gin.Context) {
+	req, ok := h.statsRequest(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.GetBurndown(req)
+	if err != nil {
+		h.Log.Error("Error caused after calling func GetBurndown in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	burndown := entity.BurndownResponse{Points: []entity.BurndownPoint{}}
+	for _, p := range resp.Points {
+		burndown.Points = append(burndown.Points, entity.BurndownPoint{
+			Date:              p.Date,
+			OpenTasks:         p.OpenTasks,
+			RemainingEstimate: p.RemainingEstimate,
+			IdealEstimate:     p.IdealEstimate,
+		})
+	}
+	c.JSON(http.StatusOK, burndown)
+}
+
+// CumulativeFlow возвращает дневной ряд количества задач по статусам (параметры как у Stats)
+func (h *Handler) CumulativeFlow(c *gin.Context) {
+	req, ok := h.statsRequest(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.GetCumulativeFlow(req)
+	if err != nil {
+		h.Log.Error("Error caused after calling func GetCumulativeFlow in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	flow := entity.CumulativeFlowResponse{
+		Statuses: resp.Statuses,
+		Points:   []entity.CumulativeFlowPoint{},
+	}
+	for _, p := range resp.Points {
+		flow.Points = append(flow.Points, entity.CumulativeFlowPoint{
+			Date:   p.Date,
+			Counts: p.Counts,
+		})
+	}
+	c.JSON(http.StatusOK, flow)
+}
+
+// statsRequest собирает фильтр аналитики из query-параметров; при ошибке сам отвечает клиенту
+func (h *Handler) statsRequest(c *gin.Context) (*task.GetTaskStatsRequest, bool) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, entity.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return nil, false
+	}
+
+	timezone, ok := h.userTimezone(c, userID.(string), c.Query("tz"))
+	if !ok {
+		return nil, false
+	}
+	req := &task.GetTaskStatsRequest{
+		UserId:   userID.(string),
+		Tags:     splitQueryList(c.Query("tags")),
+		Timezone: timezone,
+	}
+	for param, target := range map[string]**timestamppb.Timestamp{"from": &req.From, "to": &req.To} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		t, err := parseQueryTime(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+				Error:   "VALIDATION_ERROR",
+				Message: "Invalid '" + param + "' query parameter",
+			})
+			return nil, false
+		}
+		*target = timestamppb.New(t)
+	}
+	return req, true
+}
+
+func bucketsFromProto(buckets []*task.StatsBucket) []entity.StatsBucket {
+	result := make([]entity.StatsBucket, 0, len(buckets))
+	for _, b := range buckets {
+		result = append(result, entity.StatsBucket{
+			Period:    b.Period,
+			Created:   b.Created,
+			Completed: b.Completed,
+		})
+	}
+	return result
+}
+
+func parseQueryTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
+
+func splitQueryList(value string) []string {
+	var result []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
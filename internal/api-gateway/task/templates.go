@@ -0,0 +1,199 @@
+package task
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/api-gateway/apierror"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) CreateTemplate(c *gin.Context) {
+	var req entity.TaskTemplate
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Log.Error("Invalid CreateTemplate request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+			Error:   "VALIDATION_ERROR",
+			Message: "Invalid request data",
+		})
+		return
+	}
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	req.UserID = userID
+
+	resp, err := h.taskClient.CreateTaskTemplate(templateToProto(&req))
+	if err != nil {
+		h.Log.Error("Error caused after calling func CreateTaskTemplate in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, templateFromProto(resp))
+}
+
+func (h *Handler) ListTemplates(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.ListTaskTemplates(userID)
+	if err != nil {
+		h.Log.Error("Error caused after calling func ListTaskTemplates in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	response := entity.TaskTemplateListResponse{Templates: []*entity.TaskTemplate{}, Total: resp.Total}
+	for _, template := range resp.Templates {
+		response.Templates = append(response.Templates, templateFromProto(template))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) GetTemplate(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.GetTaskTemplate(userID, c.Param("id"))
+	if err != nil {
+		h.Log.Error("Error caused after calling func GetTaskTemplate in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, templateFromProto(resp))
+}
+
+func (h *Handler) UpdateTemplate(c *gin.Context) {
+	var req entity.TaskTemplate
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Log.Error("Invalid UpdateTemplate request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+			Error:   "VALIDATION_ERROR",
+			Message: "Invalid request data",
+		})
+		return
+	}
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	req.ID = c.Param("id")
+	req.UserID = userID
+
+	resp, err := h.taskClient.UpdateTaskTemplate(templateToProto(&req))
+	if err != nil {
+		h.Log.Error("Error caused after calling func UpdateTaskTemplate in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, templateFromProto(resp))
+}
+
+func (h *Handler) DeleteTemplate(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.taskClient.DeleteTaskTemplate(userID, c.Param("id")); err != nil {
+		h.Log.Error("Error caused after calling func DeleteTaskTemplate in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// InstantiateTemplate создает задачу по шаблону: {"variables": {"client": "ACME"}, "timezone": "Europe/Moscow"}.
+// Без timezone сроки считаются в часовом поясе из профиля
+func (h *Handler) InstantiateTemplate(c *gin.Context) {
+	var req entity.InstantiateTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Log.Error("Invalid InstantiateTemplate request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+			Error:   "VALIDATION_ERROR",
+			Message: "Invalid request data",
+		})
+		return
+	}
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	timezone, ok := h.userTimezone(c, userID, req.Timezone)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.InstantiateTemplate(userID, c.Param("id"), req.Variables, timezone)
+	if err != nil {
+		h.Log.Error("Error caused after calling func InstantiateTemplate in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	response := entity.InstantiateTemplateResponse{Task: taskFromProto(resp.Task), Subtasks: []*entity.Task{}}
+	for _, subtask := range resp.Subtasks {
+		response.Subtasks = append(response.Subtasks, taskFromProto(subtask))
+	}
+	c.JSON(http.StatusCreated, response)
+}
+
+func templateToProto(template *entity.TaskTemplate) *task.TaskTemplate {
+	resp := &task.TaskTemplate{
+		Id:          template.ID,
+		UserId:      template.UserID,
+		Name:        template.Name,
+		Title:       template.Title,
+		Description: template.Description,
+		Priority:    template.Priority,
+		Tags:        template.Tags,
+		DueOffset:   template.DueOffset,
+		Checklist:   template.Checklist,
+	}
+	for _, blueprint := range template.Subtasks {
+		resp.Subtasks = append(resp.Subtasks, &task.SubtaskBlueprint{
+			Title:       blueprint.Title,
+			Description: blueprint.Description,
+			Priority:    blueprint.Priority,
+			DueOffset:   blueprint.DueOffset,
+		})
+	}
+	return resp
+}
+
+func templateFromProto(template *task.TaskTemplate) *entity.TaskTemplate {
+	result := &entity.TaskTemplate{
+		ID:          template.Id,
+		UserID:      template.UserId,
+		Name:        template.Name,
+		Title:       template.Title,
+		Description: template.Description,
+		Priority:    template.Priority,
+		Tags:        template.Tags,
+		DueOffset:   template.DueOffset,
+		Checklist:   template.Checklist,
+		Subtasks:    []entity.SubtaskBlueprint{},
+		CreatedAt:   template.CreatedAt.AsTime(),
+		UpdatedAt:   template.UpdatedAt.AsTime(),
+	}
+	for _, blueprint := range template.Subtasks {
+		result.Subtasks = append(result.Subtasks, entity.SubtaskBlueprint{
+			Title:       blueprint.Title,
+			Description: blueprint.Description,
+			Priority:    blueprint.Priority,
+			DueOffset:   blueprint.DueOffset,
+		})
+	}
+	return result
+}
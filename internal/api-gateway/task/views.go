@@ -0,0 +1,189 @@
+package task
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/api-gateway/apierror"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+// CreateView сохраняет именованный фильтр списка задач.
+// Применяется через GET /api/v1/task?view_id=<id>&tz=<IANA часовой пояс>
+func (h *Handler) CreateView(c *gin.Context) {
+	var req entity.SavedView
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Log.Error("Invalid CreateView request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+			Error:   "VALIDATION_ERROR",
+			Message: "Invalid request data",
+		})
+		return
+	}
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	req.UserID = userID
+
+	resp, err := h.taskClient.CreateSavedView(savedViewToProto(&req))
+	if err != nil {
+		h.Log.Error("Error caused after calling func CreateSavedView in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, savedViewFromProto(resp))
+}
+
+func (h *Handler) ListViews(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.ListSavedViews(userID)
+	if err != nil {
+		h.Log.Error("Error caused after calling func ListSavedViews in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	response := entity.SavedViewListResponse{Views: []*entity.SavedView{}, Total: resp.Total}
+	for _, view := range resp.Views {
+		response.Views = append(response.Views, savedViewFromProto(view))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) GetView(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.GetSavedView(userID, c.Param("id"))
+	if err != nil {
+		h.Log.Error("Error caused after calling func GetSavedView in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, savedViewFromProto(resp))
+}
+
+func (h *Handler) UpdateView(c *gin.Context) {
+	var req entity.SavedView
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Log.Error("Invalid UpdateView request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+			Error:   "VALIDATION_ERROR",
+			Message: "Invalid request data",
+		})
+		return
+	}
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	req.ID = c.Param("id")
+	req.UserID = userID
+
+	resp, err := h.taskClient.UpdateSavedView(savedViewToProto(&req))
+	if err != nil {
+		h.Log.Error("Error caused after calling func UpdateSavedView in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, savedViewFromProto(resp))
+}
+
+func (h *Handler) DeleteView(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.taskClient.DeleteSavedView(userID, c.Param("id")); err != nil {
+		h.Log.Error("Error caused after calling func DeleteSavedView in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// currentUserID достает id пользователя, положенный JWTMiddleware; при отсутствии отвечает 401
+func currentUserID(c *gin.Context) (string, bool) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, entity.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return "", false
+	}
+	return userID.(string), true
+}
+
+// userTimezone возвращает часовой пояс из запроса, а без него - из профиля пользователя, чтобы
+// относительные даты и границы дней считались по его часам, а не в UTC. При ошибке сам отвечает клиенту
+func (h *Handler) userTimezone(c *gin.Context, userID, timezone string) (string, bool) {
+	if timezone != "" {
+		return timezone, true
+	}
+	profile, err := h.authClient.GetUserProfile(userID)
+	if err != nil {
+		h.Log.Error("Error caused after calling auth's client func GetUserProfile in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return "", false
+	}
+	return profile.User.Timezone, true
+}
+
+func savedViewToProto(view *entity.SavedView) *task.SavedView {
+	return &task.SavedView{
+		Id:     view.ID,
+		UserId: view.UserID,
+		Name:   view.Name,
+		Filter: &task.SavedViewFilter{
+			Statuses:     view.Filter.Statuses,
+			Priorities:   view.Filter.Priorities,
+			Tags:         view.Filter.Tags,
+			Search:       view.Filter.Search,
+			DueFrom:      view.Filter.DueFrom,
+			DueTo:        view.Filter.DueTo,
+			Project:      view.Filter.Project,
+			CustomFields: conditionsToProto(view.Filter.CustomFields),
+		},
+		Sort: &task.SavedViewSort{
+			Field: view.Sort.Field,
+			Desc:  view.Sort.Desc,
+		},
+	}
+}
+
+func savedViewFromProto(view *task.SavedView) *entity.SavedView {
+	return &entity.SavedView{
+		ID:     view.Id,
+		UserID: view.UserId,
+		Name:   view.Name,
+		Filter: entity.SavedViewFilter{
+			Statuses:     view.GetFilter().GetStatuses(),
+			Priorities:   view.GetFilter().GetPriorities(),
+			Tags:         view.GetFilter().GetTags(),
+			Search:       view.GetFilter().GetSearch(),
+			DueFrom:      view.GetFilter().GetDueFrom(),
+			DueTo:        view.GetFilter().GetDueTo(),
+			Project:      view.GetFilter().GetProject(),
+			CustomFields: conditionsFromProto(view.GetFilter().GetCustomFields()),
+		},
+		Sort: entity.SavedViewSort{
+			Field: view.GetSort().GetField(),
+			Desc:  view.GetSort().GetDesc(),
+		},
+		CreatedAt: view.CreatedAt.AsTime(),
+		UpdatedAt: view.UpdatedAt.AsTime(),
+	}
+}
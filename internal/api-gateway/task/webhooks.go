@@ -0,0 +1,200 @@
+package task
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/api-gateway/apierror"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateWebhook создает подписку; секрет для проверки подписи возвращается только в этом ответе
+func (h *Handler) CreateWebhook(c *gin.Context) {
+	var req entity.WebhookSubscription
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Log.Error("Invalid CreateWebhook request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+			Error:   "VALIDATION_ERROR",
+			Message: "Invalid request data",
+		})
+		return
+	}
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	req.UserID = userID
+
+	resp, err := h.taskClient.CreateWebhook(webhookToProto(&req))
+	if err != nil {
+		h.Log.Error("Error caused after calling func CreateWebhook in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, webhookFromProto(resp))
+}
+
+func (h *Handler) ListWebhooks(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.ListWebhooks(userID)
+	if err != nil {
+		h.Log.Error("Error caused after calling func ListWebhooks in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	response := entity.WebhookListResponse{Webhooks: []*entity.WebhookSubscription{}, Total: resp.Total}
+	for _, hook := range resp.Webhooks {
+		response.Webhooks = append(response.Webhooks, webhookFromProto(hook))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateWebhook заменяет подписку; без поля active подписка остается активной, без secret - секрет прежний
+func (h *Handler) UpdateWebhook(c *gin.Context) {
+	req := entity.WebhookSubscription{Active: true}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.Log.Error("Invalid UpdateWebhook request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, entity.ErrorResponse{
+			Error:   "VALIDATION_ERROR",
+			Message: "Invalid request data",
+		})
+		return
+	}
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	req.ID = c.Param("id")
+	req.UserID = userID
+
+	resp, err := h.taskClient.UpdateWebhook(webhookToProto(&req))
+	if err != nil {
+		h.Log.Error("Error caused after calling func UpdateWebhook in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, webhookFromProto(resp))
+}
+
+func (h *Handler) DeleteWebhook(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.taskClient.DeleteWebhook(userID, c.Param("id")); err != nil {
+		h.Log.Error("Error caused after calling func DeleteWebhook in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// ListWebhookDeliveries возвращает журнал доставок подписки, ?limit= ограничивает число записей
+func (h *Handler) ListWebhookDeliveries(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	resp, err := h.taskClient.ListWebhookDeliveries(userID, c.Param("id"), int32(limit))
+	if err != nil {
+		h.Log.Error("Error caused after calling func ListWebhookDeliveries in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+
+	response := entity.WebhookDeliveryListResponse{Deliveries: []*entity.WebhookDelivery{}, Total: resp.Total}
+	for _, delivery := range resp.Deliveries {
+		response.Deliveries = append(response.Deliveries, webhookDeliveryFromProto(delivery))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// RedeliverWebhook ставит доставку в очередь на повторную отправку
+func (h *Handler) RedeliverWebhook(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.taskClient.RedeliverWebhook(userID, c.Param("id"), c.Param("delivery_id"))
+	if err != nil {
+		h.Log.Error("Error caused after calling func RedeliverWebhook in api-gateway task's handlers", zap.Error(err))
+		apierror.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusAccepted, webhookDeliveryFromProto(resp))
+}
+
+func webhookToProto(hook *entity.WebhookSubscription) *task.Webhook {
+	return &task.Webhook{
+		Id:         hook.ID,
+		UserId:     hook.UserID,
+		Project:    hook.Project,
+		Url:        hook.URL,
+		EventTypes: hook.EventTypes,
+		Secret:     hook.Secret,
+		Active:     hook.Active,
+	}
+}
+
+func webhookFromProto(hook *task.Webhook) *entity.WebhookSubscription {
+	eventTypes := hook.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	return &entity.WebhookSubscription{
+		ID:         hook.Id,
+		UserID:     hook.UserId,
+		Project:    hook.Project,
+		URL:        hook.Url,
+		EventTypes: eventTypes,
+		Secret:     hook.Secret,
+		Active:     hook.Active,
+		CreatedAt:  protoTime(hook.CreatedAt),
+		UpdatedAt:  protoTime(hook.UpdatedAt),
+	}
+}
+
+func webhookDeliveryFromProto(delivery *task.WebhookDelivery) *entity.WebhookDelivery {
+	return &entity.WebhookDelivery{
+		ID:             delivery.Id,
+		SubscriptionID: delivery.SubscriptionId,
+		EventID:        delivery.EventId,
+		EventType:      delivery.EventType,
+		Payload:        json.RawMessage(delivery.Payload),
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		ResponseBody:   delivery.ResponseBody,
+		LastError:      delivery.LastError,
+		DurationMs:     delivery.DurationMs,
+		NextAttemptAt:  optionalProtoTime(delivery.NextAttemptAt),
+		DeliveredAt:    optionalProtoTime(delivery.DeliveredAt),
+		CreatedAt:      protoTime(delivery.CreatedAt),
+		UpdatedAt:      protoTime(delivery.UpdatedAt),
+	}
+}
+
+func optionalProtoTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oogway93/taskmanager/internal/entity"
+)
+
+// errDuplicateEmail - аналог нарушения UNIQUE(email) в Postgres
+var errDuplicateEmail = errors.New("user with this email already exists")
+
+// memoryUser - строка users вместе с настройками, которые хранятся в отдельных колонках и таблицах.
+// Колонка timezone хранится в digest.Timezone
+type memoryUser struct {
+	user        entity.User
+	digest      entity.DigestSettings
+	preferences *entity.NotificationPreferences
+}
+
+// memoryUserRepository - потокобезопасная реализация UserRepository в памяти для тестов и локальной разработки.
+// Повторяет поведение Postgres, включая ErrUserNotFound для неактивных пользователей; события в outbox не пишутся
+type memoryUserRepository struct {
+	mu    sync.RWMutex
+	users map[string]*memoryUser
+}
+
+// NewMemoryUserRepository создает пустой репозиторий пользователей в памяти
+func NewMemoryUserRepository() UserRepository {
+	return &memoryUserRepository{users: make(map[string]*memoryUser)}
+}
+
+func (r *memoryUserRepository) Create(ctx context.Context, user *entity.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, stored := range r.users {
+		if stored.user.Email == user.Email {
+			return errDuplicateEmail
+		}
+	}
+
+	user.ID = uuid.New().String()
+	user.CreatedAt = time.Now()
+	user.UpdatedAt = time.Now()
+	if user.Timezone == "" {
+		user.Timezone = defaultTimezone
+	}
+
+	stored := *user
+	stored.CreatedAt = dbTime(stored.CreatedAt)
+	stored.UpdatedAt = dbTime(stored.UpdatedAt)
+	// значения по умолчанию колонок users из миграции 011_daily_digest
+	r.users[stored.ID] = &memoryUser{
+		user:   stored,
+		digest: entity.DigestSettings{Enabled: true, Timezone: stored.Timezone, Hour: 8},
+	}
+	return nil
+}
+
+func (r *memoryUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.byEmail(email)
+	return ok, nil
+}
+
+// GetByEmail, как и в Postgres, находит и неактивных пользователей
+func (r *memoryUserRepository) GetByEmail(ctx context.Context, email string) (*entity.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored, ok := r.byEmail(email)
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	user := stored.user
+	user.Timezone = stored.digest.Timezone
+	return &user, nil
+}
+
+func (r *memoryUserRepository) GetByID(ctx context.Context, userID uuid.UUID) (*entity.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored, ok := r.active(userID.String())
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	user := stored.user
+	user.Timezone = stored.digest.Timezone
+	return &user, nil
+}
+
+func (r *memoryUserRepository) GetDigestSettings(ctx context.Context, userID string) (*entity.DigestSettings, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored, ok := r.active(userID)
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	settings := stored.digest
+	return &settings, nil
+}
+
+func (r *memoryUserRepository) UpdateDigestSettings(ctx context.Context, userID string, settings *entity.DigestSettings) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.active(userID)
+	if !ok {
+		return ErrUserNotFound
+	}
+	timezone := stored.digest.Timezone
+	stored.digest = *settings
+	stored.digest.Timezone = timezone
+	stored.user.UpdatedAt = dbTime(time.Now())
+	return nil
+}
+
+func (r *memoryUserRepository) GetNotificationPreferences(ctx context.Context, userID string) (*entity.NotificationPreferences, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored, ok := r.active(userID)
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	preferences := entity.DefaultNotificationPreferences()
+	if stored.preferences != nil {
+		saved, err := clonePreferences(stored.preferences)
+		if err != nil {
+			return nil, err
+		}
+		preferences = *saved
+		preferences.Channels = entity.WithDefaultChannels(saved.Channels)
+	}
+	preferences.Timezone = stored.digest.Timezone
+	return &preferences, nil
+}
+
+func (r *memoryUserRepository) UpdateNotificationPreferences(ctx context.Context, userID string, preferences *entity.NotificationPreferences) error {
+	saved, err := clonePreferences(preferences)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.active(userID)
+	if !ok {
+		return ErrUserNotFound
+	}
+	stored.user.UpdatedAt = dbTime(time.Now())
+	stored.preferences = saved
+	return nil
+}
+
+func (r *memoryUserRepository) UpdateTimezone(ctx context.Context, userID, timezone string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.active(userID)
+	if !ok {
+		return ErrUserNotFound
+	}
+	stored.digest.Timezone = timezone
+	stored.user.UpdatedAt = dbTime(time.Now())
+	return nil
+}
+
+// byEmail и active вызываются под r.mu
+func (r *memoryUserRepository) byEmail(email string) (*memoryUser, bool) {
+	for _, stored := range r.users {
+		if stored.user.Email == email {
+			return stored, true
+		}
+	}
+	return nil, false
+}
+
+func (r *memoryUserRepository) active(userID string) (*memoryUser, bool) {
+	stored, ok := r.users[userID]
+	if !ok || !stored.user.Active {
+		return nil, false
+	}
+	return stored, true
+}
+
+// clonePreferences копирует настройки через JSON, как при записи каналов в jsonb
+func clonePreferences(preferences *entity.NotificationPreferences) (*entity.NotificationPreferences, error) {
+	channels, err := json.Marshal(preferences.Channels)
+	if err != nil {
+		return nil, err
+	}
+	saved := *preferences
+	saved.Channels = nil
+	if err := json.Unmarshal(channels, &saved.Channels); err != nil {
+		return nil, err
+	}
+	return &saved, nil
+}
+
+// dbTime округляет время до точности timestamptz
+func dbTime(t time.Time) time.Time {
+	return t.Round(time.Microsecond)
+}
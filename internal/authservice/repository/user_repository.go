@@ -3,11 +3,13 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/infrastructure/outbox"
 	"go.uber.org/zap"
 )
 
@@ -15,11 +17,20 @@ var (
 	ErrUserNotFound = errors.New("user not found")
 )
 
+// defaultTimezone - значение по умолчанию колонки users.timezone
+const defaultTimezone = "UTC"
+
 type UserRepository interface {
 	Create(ctx context.Context, user *entity.User) error
 	GetByID(ctx context.Context, userID uuid.UUID) (*entity.User, error)
 	GetByEmail(ctx context.Context, email string) (*entity.User, error)
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
+	GetDigestSettings(ctx context.Context, userID string) (*entity.DigestSettings, error)
+	// UpdateDigestSettings и UpdateNotificationPreferences не меняют часовой пояс: его пишет только UpdateTimezone
+	UpdateDigestSettings(ctx context.Context, userID string, settings *entity.DigestSettings) error
+	GetNotificationPreferences(ctx context.Context, userID string) (*entity.NotificationPreferences, error)
+	UpdateNotificationPreferences(ctx context.Context, userID string, preferences *entity.NotificationPreferences) error
+	UpdateTimezone(ctx context.Context, userID, timezone string) error
 }
 
 type userRepository struct {
@@ -35,17 +46,27 @@ func NewUserRepository(db *sql.DB, Log *zap.Logger) UserRepository {
 	}
 }
 
+// Create сохраняет пользователя и событие user.registered в одной транзакции
 func (r *userRepository) Create(ctx context.Context, user *entity.User) error {
 	query := `
-		INSERT INTO users (id, email, password_hash, username, role, active, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) 
+		INSERT INTO users (id, email, password_hash, username, role, active, created_at, updated_at, timezone)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) 
 	` //TODO: убрать raw sql, использовать gORM
 
 	user.ID = uuid.New().String()
 	user.CreatedAt = time.Now()
 	user.UpdatedAt = time.Now()
+	if user.Timezone == "" {
+		user.Timezone = defaultTimezone
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
 
-	_, err := r.db.ExecContext(ctx, query,
+	_, err = tx.ExecContext(ctx, query,
 		user.ID,
 		user.Email,
 		user.Password, // уже захэшированный пароль
@@ -54,9 +75,24 @@ func (r *userRepository) Create(ctx context.Context, user *entity.User) error {
 		user.Active,
 		user.CreatedAt,
 		user.UpdatedAt,
+		user.Timezone,
 	)
+	if err != nil {
+		return err
+	}
 
-	return err
+	event := entity.UserRegisteredEvent{
+		UserID:     user.ID,
+		Email:      user.Email,
+		Username:   user.Username,
+		OccurredAt: user.CreatedAt,
+	}
+	if err := outbox.Write(ctx, tx, entity.EventUserRegistered, user.ID, event); err != nil {
+		r.Log.Error("SQL error caused in repo's Create while writing outbox event", zap.Error(err))
+		return err
+	}
+
+	return tx.Commit()
 }
 
 // ExistsByEmail проверяет существование пользователя с email
@@ -69,7 +105,7 @@ func (r *userRepository) ExistsByEmail(ctx context.Context, email string) (bool,
 
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (*entity.User, error) {
 	query := `
-		SELECT id, email, password_hash, username, role, active, created_at, updated_at
+		SELECT id, email, password_hash, username, role, active, created_at, updated_at, timezone
 		FROM users 
 		WHERE email = $1
 	`
@@ -84,6 +120,7 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*entity.
 		&user.Active,
 		&user.CreatedAt,
 		&user.UpdatedAt,
+		&user.Timezone,
 	)
 
 	if err == sql.ErrNoRows {
@@ -100,7 +137,7 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*entity.
 
 func (r *userRepository) GetByID(ctx context.Context, userID uuid.UUID) (*entity.User, error) {
 	query := `
-		SELECT id, email, password_hash, username, role, active, created_at, updated_at
+		SELECT id, email, password_hash, username, role, active, created_at, updated_at, timezone
 		FROM users 
 		WHERE id = $1 AND active = true
 	`
@@ -115,6 +152,7 @@ func (r *userRepository) GetByID(ctx context.Context, userID uuid.UUID) (*entity
 		&user.Active,
 		&user.CreatedAt,
 		&user.UpdatedAt,
+		&user.Timezone,
 	)
 
 	if err == sql.ErrNoRows {
@@ -128,3 +166,150 @@ func (r *userRepository) GetByID(ctx context.Context, userID uuid.UUID) (*entity
 
 	return &user, nil
 }
+
+// GetDigestSettings возвращает настройки ежедневного дайджеста пользователя
+func (r *userRepository) GetDigestSettings(ctx context.Context, userID string) (*entity.DigestSettings, error) {
+	query := `SELECT digest_enabled, timezone, digest_hour FROM users WHERE id = $1 AND active = true`
+
+	var settings entity.DigestSettings
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(&settings.Enabled, &settings.Timezone, &settings.Hour)
+	if err == sql.ErrNoRows {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		r.Log.Error("Error caused in repo's GetDigestSettings", zap.Error(err))
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// UpdateDigestSettings сохраняет настройки дайджеста. Смена часа не сбрасывает digest_sent_on,
+// поэтому второе письмо за тот же день не уходит
+func (r *userRepository) UpdateDigestSettings(ctx context.Context, userID string, settings *entity.DigestSettings) error {
+	query := `
+		UPDATE users
+		SET digest_enabled = $2, digest_hour = $3, updated_at = $4
+		WHERE id = $1 AND active = true
+	`
+	result, err := r.db.ExecContext(ctx, query, userID, settings.Enabled, settings.Hour, time.Now())
+	if err != nil {
+		r.Log.Error("Error caused in repo's UpdateDigestSettings", zap.Error(err))
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+// GetNotificationPreferences возвращает настройки уведомлений; без сохраненных настроек - значения по умолчанию
+func (r *userRepository) GetNotificationPreferences(ctx context.Context, userID string) (*entity.NotificationPreferences, error) {
+	query := `
+		SELECT u.timezone, p.channels, p.quiet_hours_enabled, p.quiet_hours_start, p.quiet_hours_end, p.digest_batching
+		FROM users u
+		LEFT JOIN notification_preferences p ON p.user_id = u.id
+		WHERE u.id = $1 AND u.active = true
+	`
+	var (
+		preferences = entity.DefaultNotificationPreferences()
+		channels    []byte
+		quietOn     sql.NullBool
+		quietStart  sql.NullString
+		quietEnd    sql.NullString
+		batching    sql.NullBool
+	)
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(&preferences.Timezone, &channels, &quietOn, &quietStart, &quietEnd, &batching)
+	if err == sql.ErrNoRows {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		r.Log.Error("Error caused in repo's GetNotificationPreferences", zap.Error(err))
+		return nil, err
+	}
+
+	if channels != nil {
+		var stored map[string][]string
+		if err := json.Unmarshal(channels, &stored); err != nil {
+			return nil, err
+		}
+		preferences.Channels = entity.WithDefaultChannels(stored)
+	}
+	if quietStart.Valid {
+		preferences.QuietHours = entity.QuietHours{Enabled: quietOn.Bool, Start: quietStart.String, End: quietEnd.String}
+	}
+	preferences.DigestBatching = batching.Bool
+	return &preferences, nil
+}
+
+// UpdateNotificationPreferences сохраняет настройки уведомлений активного пользователя в одной транзакции
+func (r *userRepository) UpdateNotificationPreferences(ctx context.Context, userID string, preferences *entity.NotificationPreferences) error {
+	channels, err := json.Marshal(preferences.Channels)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx,
+		`UPDATE users SET updated_at = $2 WHERE id = $1 AND active = true`,
+		userID, now,
+	)
+	if err != nil {
+		r.Log.Error("Error caused in repo's UpdateNotificationPreferences", zap.Error(err))
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrUserNotFound
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO notification_preferences (user_id, channels, quiet_hours_enabled, quiet_hours_start, quiet_hours_end, digest_batching, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (user_id) DO UPDATE SET
+			channels = EXCLUDED.channels,
+			quiet_hours_enabled = EXCLUDED.quiet_hours_enabled,
+			quiet_hours_start = EXCLUDED.quiet_hours_start,
+			quiet_hours_end = EXCLUDED.quiet_hours_end,
+			digest_batching = EXCLUDED.digest_batching,
+			updated_at = EXCLUDED.updated_at
+	`, userID, channels, preferences.QuietHours.Enabled, preferences.QuietHours.Start, preferences.QuietHours.End,
+		preferences.DigestBatching, now)
+	if err != nil {
+		r.Log.Error("Error caused in repo's UpdateNotificationPreferences while saving preferences", zap.Error(err))
+		return err
+	}
+	return tx.Commit()
+}
+
+// UpdateTimezone меняет часовой пояс активного пользователя
+func (r *userRepository) UpdateTimezone(ctx context.Context, userID, timezone string) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE users SET timezone = $2, updated_at = $3 WHERE id = $1 AND active = true`,
+		userID, timezone, time.Now(),
+	)
+	if err != nil {
+		r.Log.Error("Error caused in repo's UpdateTimezone", zap.Error(err))
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
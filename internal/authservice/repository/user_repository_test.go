@@ -0,0 +1,322 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+// Один набор проверок запускается для реализации в памяти и для Postgres. Postgres проверяется,
+// только если TEST_POSTGRES_DSN указывает на базу с примененными миграциями
+
+func TestMemoryUserRepository(t *testing.T) {
+	testUserRepository(t, func(t *testing.T) UserRepository {
+		return NewMemoryUserRepository()
+	})
+}
+
+func TestPostgresUserRepository(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewUserRepository(db, zap.NewNop())
+	testUserRepository(t, func(t *testing.T) UserRepository {
+		t.Cleanup(func() {
+			if _, err := db.Exec(`DELETE FROM users WHERE email LIKE '%@conformance.test'`); err != nil {
+				t.Errorf("delete test users: %v", err)
+			}
+		})
+		return repo
+	})
+}
+
+// testUserRepository проверяет контракт UserRepository. Адреса пользователей уникальны для каждого запуска,
+// поэтому проверки не зависят от данных в базе
+func testUserRepository(t *testing.T, newRepo func(t *testing.T) UserRepository) {
+	ctx := context.Background()
+
+	t.Run("CreateAndGet", func(t *testing.T) {
+		repo := newRepo(t)
+		user := newTestUser()
+		if err := repo.Create(ctx, user); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		if user.ID == "" || user.CreatedAt.IsZero() || user.UpdatedAt.IsZero() {
+			t.Fatalf("Create did not fill id and timestamps: %+v", user)
+		}
+
+		byID, err := repo.GetByID(ctx, uuid.MustParse(user.ID))
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		byEmail, err := repo.GetByEmail(ctx, user.Email)
+		if err != nil {
+			t.Fatalf("GetByEmail: %v", err)
+		}
+		for _, got := range []*entity.User{byID, byEmail} {
+			if got.ID != user.ID || got.Email != user.Email || got.Password != user.Password ||
+				got.Username != user.Username || got.Role != user.Role || !got.Active {
+				t.Errorf("stored user = %+v, want %+v", got, user)
+			}
+			if got.CreatedAt.Sub(user.CreatedAt).Abs() > time.Millisecond {
+				t.Errorf("created at = %v, want %v", got.CreatedAt, user.CreatedAt)
+			}
+		}
+
+		exists, err := repo.ExistsByEmail(ctx, user.Email)
+		if err != nil || !exists {
+			t.Errorf("ExistsByEmail = %v, %v, want true", exists, err)
+		}
+	})
+
+	t.Run("DuplicateEmail", func(t *testing.T) {
+		repo := newRepo(t)
+		user := newTestUser()
+		if err := repo.Create(ctx, user); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		duplicate := newTestUser()
+		duplicate.Email = user.Email
+		if err := repo.Create(ctx, duplicate); err == nil {
+			t.Error("Create with a taken email succeeded")
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repo := newRepo(t)
+		missing := uuid.New()
+		if _, err := repo.GetByID(ctx, missing); !errors.Is(err, ErrUserNotFound) {
+			t.Errorf("GetByID error = %v, want ErrUserNotFound", err)
+		}
+		if _, err := repo.GetByEmail(ctx, "missing@conformance.test"); !errors.Is(err, ErrUserNotFound) {
+			t.Errorf("GetByEmail error = %v, want ErrUserNotFound", err)
+		}
+		if exists, err := repo.ExistsByEmail(ctx, "missing@conformance.test"); err != nil || exists {
+			t.Errorf("ExistsByEmail = %v, %v, want false", exists, err)
+		}
+		if _, err := repo.GetDigestSettings(ctx, missing.String()); !errors.Is(err, ErrUserNotFound) {
+			t.Errorf("GetDigestSettings error = %v, want ErrUserNotFound", err)
+		}
+		if err := repo.UpdateDigestSettings(ctx, missing.String(), &entity.DigestSettings{Timezone: "UTC"}); !errors.Is(err, ErrUserNotFound) {
+			t.Errorf("UpdateDigestSettings error = %v, want ErrUserNotFound", err)
+		}
+		if _, err := repo.GetNotificationPreferences(ctx, missing.String()); !errors.Is(err, ErrUserNotFound) {
+			t.Errorf("GetNotificationPreferences error = %v, want ErrUserNotFound", err)
+		}
+		preferences := entity.DefaultNotificationPreferences()
+		if err := repo.UpdateNotificationPreferences(ctx, missing.String(), &preferences); !errors.Is(err, ErrUserNotFound) {
+			t.Errorf("UpdateNotificationPreferences error = %v, want ErrUserNotFound", err)
+		}
+		if err := repo.UpdateTimezone(ctx, missing.String(), "UTC"); !errors.Is(err, ErrUserNotFound) {
+			t.Errorf("UpdateTimezone error = %v, want ErrUserNotFound", err)
+		}
+	})
+
+	t.Run("InactiveUser", func(t *testing.T) {
+		repo := newRepo(t)
+		user := newTestUser()
+		user.Active = false
+		if err := repo.Create(ctx, user); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+
+		// вход проверяет активность сам, поэтому поиск по email находит и неактивных
+		if got, err := repo.GetByEmail(ctx, user.Email); err != nil || got.Active {
+			t.Errorf("GetByEmail = %+v, %v, want inactive user", got, err)
+		}
+		if _, err := repo.GetByID(ctx, uuid.MustParse(user.ID)); !errors.Is(err, ErrUserNotFound) {
+			t.Errorf("GetByID error = %v, want ErrUserNotFound", err)
+		}
+		if _, err := repo.GetDigestSettings(ctx, user.ID); !errors.Is(err, ErrUserNotFound) {
+			t.Errorf("GetDigestSettings error = %v, want ErrUserNotFound", err)
+		}
+		if _, err := repo.GetNotificationPreferences(ctx, user.ID); !errors.Is(err, ErrUserNotFound) {
+			t.Errorf("GetNotificationPreferences error = %v, want ErrUserNotFound", err)
+		}
+	})
+
+	t.Run("DigestSettings", func(t *testing.T) {
+		repo := newRepo(t)
+		user := newTestUser()
+		if err := repo.Create(ctx, user); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+
+		settings, err := repo.GetDigestSettings(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("GetDigestSettings: %v", err)
+		}
+		if want := (entity.DigestSettings{Enabled: true, Timezone: "UTC", Hour: 8}); *settings != want {
+			t.Errorf("default digest settings = %+v, want %+v", *settings, want)
+		}
+
+		// часовой пояс меняется только через UpdateTimezone
+		if err := repo.UpdateDigestSettings(ctx, user.ID, &entity.DigestSettings{Enabled: false, Timezone: "Europe/Moscow", Hour: 6}); err != nil {
+			t.Fatalf("UpdateDigestSettings: %v", err)
+		}
+		want := entity.DigestSettings{Enabled: false, Timezone: "UTC", Hour: 6}
+		if settings, err = repo.GetDigestSettings(ctx, user.ID); err != nil || *settings != want {
+			t.Errorf("GetDigestSettings = %+v, %v, want %+v", settings, err, want)
+		}
+	})
+
+	t.Run("Timezone", func(t *testing.T) {
+		repo := newRepo(t)
+		user := newTestUser()
+		if err := repo.Create(ctx, user); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		if got, err := repo.GetByID(ctx, uuid.MustParse(user.ID)); err != nil || got.Timezone != "UTC" {
+			t.Errorf("default timezone = %+v, %v, want UTC", got, err)
+		}
+
+		if err := repo.UpdateTimezone(ctx, user.ID, "America/New_York"); err != nil {
+			t.Fatalf("UpdateTimezone: %v", err)
+		}
+		if got, err := repo.GetByEmail(ctx, user.Email); err != nil || got.Timezone != "America/New_York" {
+			t.Errorf("GetByEmail timezone = %+v, %v, want America/New_York", got, err)
+		}
+		// часовой пояс общий для профиля, дайджеста и уведомлений
+		if settings, err := repo.GetDigestSettings(ctx, user.ID); err != nil || settings.Timezone != "America/New_York" {
+			t.Errorf("digest timezone = %+v, %v, want America/New_York", settings, err)
+		}
+
+		withZone := newTestUser()
+		withZone.Timezone = "Asia/Tokyo"
+		if err := repo.Create(ctx, withZone); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		if got, err := repo.GetByID(ctx, uuid.MustParse(withZone.ID)); err != nil || got.Timezone != "Asia/Tokyo" {
+			t.Errorf("created timezone = %+v, %v, want Asia/Tokyo", got, err)
+		}
+	})
+
+	t.Run("NotificationPreferences", func(t *testing.T) {
+		repo := newRepo(t)
+		user := newTestUser()
+		if err := repo.Create(ctx, user); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+
+		preferences, err := repo.GetNotificationPreferences(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("GetNotificationPreferences: %v", err)
+		}
+		defaults := entity.DefaultNotificationPreferences()
+		if preferences.QuietHours != defaults.QuietHours || preferences.Timezone != "UTC" || preferences.DigestBatching {
+			t.Errorf("default preferences = %+v", preferences)
+		}
+		assertChannels(t, preferences.Channels, defaults.Channels)
+
+		update := entity.NotificationPreferences{
+			Channels:       map[string][]string{entity.NotificationComment: {entity.ChannelInApp}, entity.NotificationMention: {}},
+			QuietHours:     entity.QuietHours{Enabled: true, Start: "23:00", End: "06:30"},
+			Timezone:       "Asia/Tokyo",
+			DigestBatching: true,
+		}
+		if err := repo.UpdateNotificationPreferences(ctx, user.ID, &update); err != nil {
+			t.Fatalf("UpdateNotificationPreferences: %v", err)
+		}
+		update.Channels[entity.NotificationComment][0] = entity.ChannelEmail
+
+		preferences, err = repo.GetNotificationPreferences(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("GetNotificationPreferences: %v", err)
+		}
+		// часовой пояс меняется только через UpdateTimezone
+		if preferences.QuietHours != update.QuietHours || preferences.Timezone != "UTC" || !preferences.DigestBatching {
+			t.Errorf("saved preferences = %+v", preferences)
+		}
+		want := entity.WithDefaultChannels(map[string][]string{
+			entity.NotificationComment: {entity.ChannelInApp},
+			entity.NotificationMention: {},
+		})
+		assertChannels(t, preferences.Channels, want)
+
+		if err := repo.UpdateTimezone(ctx, user.ID, "Asia/Tokyo"); err != nil {
+			t.Fatalf("UpdateTimezone: %v", err)
+		}
+		if preferences, err := repo.GetNotificationPreferences(ctx, user.ID); err != nil || preferences.Timezone != "Asia/Tokyo" {
+			t.Errorf("preferences timezone = %+v, %v, want Asia/Tokyo", preferences, err)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		repo := newRepo(t)
+		const workers = 8
+
+		var wg sync.WaitGroup
+		errs := make(chan error, workers)
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				user := newTestUser()
+				if err := repo.Create(ctx, user); err != nil {
+					errs <- err
+					return
+				}
+				if err := repo.UpdateDigestSettings(ctx, user.ID, &entity.DigestSettings{Enabled: true, Timezone: "UTC", Hour: 9}); err != nil {
+					errs <- err
+					return
+				}
+				if _, err := repo.GetByEmail(ctx, user.Email); err != nil {
+					errs <- err
+				}
+			}()
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			t.Error(err)
+		}
+	})
+}
+
+func newTestUser() *entity.User {
+	return &entity.User{
+		Email:    uuid.NewString() + "@conformance.test",
+		Password: "hash",
+		Username: "conformance",
+		Role:     "user",
+		Active:   true,
+	}
+}
+
+func assertChannels(t *testing.T, got, want map[string][]string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("channels = %v, want %v", got, want)
+		return
+	}
+	for notificationType, channels := range want {
+		if !slices.Equal(got[notificationType], channels) {
+			t.Errorf("channels[%s] = %v, want %v", notificationType, got[notificationType], channels)
+		}
+	}
+}
+
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN is not set")
+	}
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("open postgres: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := db.Ping(); err != nil {
+		t.Fatalf("ping postgres: %v", err)
+	}
+	return db
+}
@@ -2,9 +2,11 @@ package server
 
 import (
 	"context"
+	"errors"
 
 	"github.com/oogway93/taskmanager/gen/auth"
 	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/authservice/repository"
 	"github.com/oogway93/taskmanager/internal/authservice/service"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -37,6 +39,7 @@ func (s *AuthServer) userToProto(user *entity.User) *auth.User {
 		// Password НЕ включается! ✅
 		CreatedAt: timestamppb.New(user.CreatedAt),
 		UpdatedAt: timestamppb.New(user.UpdatedAt),
+		Timezone:  user.Timezone,
 	}
 }
 
@@ -45,7 +48,10 @@ func (s *AuthServer) Register(ctx context.Context, req *auth.RegisterRequest) (*
 	user, err := s.authService.Register(ctx, req.Email, req.Password, req.Username)
 	if err != nil {
 		s.Log.Error("Error caused after calling func Register from auth service", zap.Error(err))
-		return nil, status.Error(codes.Internal, err.Error())
+		if errors.Is(err, service.ErrUserAlreadyExists) {
+			return nil, status.Error(codes.AlreadyExists, "user with this email already exists")
+		}
+		return nil, status.Error(codes.Internal, "registration failed")
 	}
 
 	// Генерируем токены
@@ -80,13 +86,13 @@ func (s *AuthServer) Login(ctx context.Context, req *auth.LoginRequest) (*auth.L
 	if err != nil {
 		switch err {
 		case service.ErrUserNotFound, service.ErrInvalidCredentials:
-			s.Log.Fatal("Login failed - invalid credentials", zap.String("email", req.Email))
+			s.Log.Info("Login failed - invalid credentials", zap.String("email", req.Email))
 			return nil, status.Error(codes.Unauthenticated, "invalid credentials")
 		case service.ErrUserInactive:
-			s.Log.Fatal("Login failed - inactive account", zap.String("email", req.Email))
+			s.Log.Info("Login failed - inactive account", zap.String("email", req.Email))
 			return nil, status.Error(codes.PermissionDenied, "account is deactivated")
 		default:
-			s.Log.Fatal("Login failed - internal error", zap.Error(err), zap.String("email", req.Email))
+			s.Log.Error("Login failed - internal error", zap.Error(err), zap.String("email", req.Email))
 			return nil, status.Error(codes.Internal, "login failed")
 		}
 	}
@@ -94,13 +100,13 @@ func (s *AuthServer) Login(ctx context.Context, req *auth.LoginRequest) (*auth.L
 	// Генерируем токены
 	accessToken, accessExp, err := s.tokenService.GenerateAccessToken(user)
 	if err != nil {
-		s.Log.Fatal("Failed to generate access token", zap.Error(err))
+		s.Log.Error("Failed to generate access token", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to generate tokens")
 	}
 
 	refreshToken, _, err := s.tokenService.GenerateRefreshToken(user)
 	if err != nil {
-		s.Log.Fatal("Failed to generate refresh token", zap.Error(err))
+		s.Log.Error("Failed to generate refresh token", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to generate tokens")
 	}
 
@@ -124,14 +130,14 @@ func (s *AuthServer) Login(ctx context.Context, req *auth.LoginRequest) (*auth.L
 func (s *AuthServer) ValidateToken(ctx context.Context, req *auth.ValidateTokenRequest) (*auth.ValidateTokenResponse, error) {
 	claims, err := s.authService.ValidateToken(req.Token)
 	if err != nil {
-		s.Log.Fatal("Error caused after trying func ValidateToken", zap.Error(err))
+		s.Log.Info("Error caused after trying func ValidateToken", zap.Error(err))
 		return &auth.ValidateTokenResponse{Valid: false}, nil
 	}
 
 	// Проверяем что пользователь все еще существует и активен
 	user, err := s.authService.GetUserByID(ctx, claims.UserID)
 	if err != nil || !user.Active {
-		s.Log.Fatal("Error caused after calling func GetUserByID or user is not active", zap.Error(err))
+		s.Log.Info("Error caused after calling func GetUserByID or user is not active", zap.Error(err))
 		return &auth.ValidateTokenResponse{Valid: false}, nil
 	}
 
@@ -147,10 +153,115 @@ func (s *AuthServer) GetUserProfile(ctx context.Context, req *auth.GetUserProfil
 	user, err := s.authService.GetUserByID(ctx, req.UserId)
 	if err != nil {
 		s.Log.Error("Error caused after calling GetUserByID", zap.Error(err))
-		return nil, status.Error(codes.NotFound, "user not found")
+		return nil, settingsError(err)
+	}
+
+	return &auth.GetUserProfileResponse{
+		User: s.userToProto(user),
+	}, nil
+}
+
+func (s *AuthServer) GetDigestSettings(ctx context.Context, req *auth.GetDigestSettingsRequest) (*auth.DigestSettings, error) {
+	settings, err := s.authService.GetDigestSettings(ctx, req.UserId)
+	if err != nil {
+		s.Log.Error("Error caused after calling GetDigestSettings", zap.Error(err))
+		return nil, settingsError(err)
+	}
+	return digestSettingsToProto(settings), nil
+}
+
+func (s *AuthServer) UpdateDigestSettings(ctx context.Context, req *auth.UpdateDigestSettingsRequest) (*auth.DigestSettings, error) {
+	settings, err := s.authService.UpdateDigestSettings(ctx, req.UserId, entity.UpdateDigestSettingsRequest{
+		Enabled: req.Enabled,
+		Hour:    req.Hour,
+	})
+	if err != nil {
+		s.Log.Error("Error caused after calling UpdateDigestSettings", zap.Error(err))
+		return nil, settingsError(err)
+	}
+	return digestSettingsToProto(settings), nil
+}
+
+func digestSettingsToProto(settings *entity.DigestSettings) *auth.DigestSettings {
+	return &auth.DigestSettings{
+		Enabled:  settings.Enabled,
+		Timezone: settings.Timezone,
+		Hour:     settings.Hour,
+	}
+}
+
+func (s *AuthServer) GetNotificationPreferences(ctx context.Context, req *auth.GetNotificationPreferencesRequest) (*auth.NotificationPreferences, error) {
+	preferences, err := s.authService.GetNotificationPreferences(ctx, req.UserId)
+	if err != nil {
+		s.Log.Error("Error caused after calling GetNotificationPreferences", zap.Error(err))
+		return nil, settingsError(err)
 	}
+	return notificationPreferencesToProto(preferences), nil
+}
+
+func (s *AuthServer) UpdateNotificationPreferences(ctx context.Context, req *auth.UpdateNotificationPreferencesRequest) (*auth.NotificationPreferences, error) {
+	update := entity.UpdateNotificationPreferencesRequest{
+		DigestBatching: req.DigestBatching,
+	}
+	if len(req.Channels) > 0 {
+		update.Channels = make(map[string][]string, len(req.Channels))
+		for notificationType, channels := range req.Channels {
+			update.Channels[notificationType] = channels.GetChannels()
+		}
+	}
+	if req.QuietHours != nil {
+		update.QuietHours = &entity.QuietHours{
+			Enabled: req.QuietHours.Enabled,
+			Start:   req.QuietHours.Start,
+			End:     req.QuietHours.End,
+		}
+	}
+
+	preferences, err := s.authService.UpdateNotificationPreferences(ctx, req.UserId, update)
+	if err != nil {
+		s.Log.Error("Error caused after calling UpdateNotificationPreferences", zap.Error(err))
+		return nil, settingsError(err)
+	}
+	return notificationPreferencesToProto(preferences), nil
+}
+
+func notificationPreferencesToProto(preferences *entity.NotificationPreferences) *auth.NotificationPreferences {
+	channels := make(map[string]*auth.NotificationChannels, len(preferences.Channels))
+	for notificationType, list := range preferences.Channels {
+		channels[notificationType] = &auth.NotificationChannels{Channels: list}
+	}
+	return &auth.NotificationPreferences{
+		Channels: channels,
+		QuietHours: &auth.QuietHours{
+			Enabled: preferences.QuietHours.Enabled,
+			Start:   preferences.QuietHours.Start,
+			End:     preferences.QuietHours.End,
+		},
+		Timezone:       preferences.Timezone,
+		DigestBatching: preferences.DigestBatching,
+	}
+}
 
+func (s *AuthServer) UpdateTimezone(ctx context.Context, req *auth.UpdateTimezoneRequest) (*auth.GetUserProfileResponse, error) {
+	user, err := s.authService.UpdateTimezone(ctx, req.UserId, req.Timezone)
+	if err != nil {
+		s.Log.Error("Error caused after calling UpdateTimezone", zap.Error(err))
+		return nil, settingsError(err)
+	}
 	return &auth.GetUserProfileResponse{
 		User: s.userToProto(user),
 	}, nil
 }
+
+// settingsError переводит ошибки настроек дайджеста и уведомлений в статусы gRPC
+func settingsError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrUserNotFound):
+		return status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, service.ErrInvalidTimezone), errors.Is(err, service.ErrInvalidDigestHour),
+		errors.Is(err, service.ErrInvalidPreferences):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, "failed to process settings")
+	}
+}
@@ -73,7 +73,7 @@ func (s *tokenService) GenerateAccessToken(user *entity.User) (string, time.Time
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	signedToken, err := token.SignedString([]byte(s.cfg.JWT.Secret))
 	if err != nil {
-		s.Log.Fatal("Error caused after calling func SignedString in tokenservice", zap.Error(err))
+		s.Log.Error("Error caused after calling func SignedString in tokenservice", zap.Error(err))
 		return "", time.Time{}, err
 	}
 
@@ -103,7 +103,7 @@ func (s *tokenService) GenerateRefreshToken(user *entity.User) (string, time.Tim
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	signedToken, err := token.SignedString([]byte(s.cfg.JWT.Secret))
 	if err != nil {
-		s.Log.Fatal("Error caused after calling func SignedString in tokenservice", zap.Error(err))
+		s.Log.Error("Error caused after calling func SignedString in tokenservice", zap.Error(err))
 		return "", time.Time{}, err
 	}
 
@@ -144,13 +144,13 @@ func (s *tokenService) ValidateToken(tokenString string) (*TokenClaims, error) {
 func (s *tokenService) GenerateTokenPair(user *entity.User) (accessToken, refreshToken string, accessExp, refreshExp time.Time, err error) {
 	accessToken, accessExp, err = s.GenerateAccessToken(user)
 	if err != nil {
-		s.Log.Fatal("Error caused after calling func GenerateTokenPair in tokenservice", zap.Error(err))
+		s.Log.Error("Error caused after calling func GenerateTokenPair in tokenservice", zap.Error(err))
 		return "", "", time.Time{}, time.Time{}, err
 	}
 
 	refreshToken, refreshExp, err = s.GenerateRefreshToken(user)
 	if err != nil {
-		s.Log.Fatal("Error caused after calling func GenerateRefreshPair in tokenservice", zap.Error(err))
+		s.Log.Error("Error caused after calling func GenerateRefreshPair in tokenservice", zap.Error(err))
 		return "", "", time.Time{}, time.Time{}, err
 	}
 
@@ -162,12 +162,11 @@ func (s *tokenService) ExtractUserIDFromToken(tokenString string) (string, error
 	// Парсим токен без проверки подписи (только для извлечения данных)
 	token, _, err := new(jwt.Parser).ParseUnverified(tokenString, &TokenClaims{})
 	if err != nil {
-		s.Log.Fatal("Error caused after calling func ParseUnverified in tokenservice", zap.Error(err))
+		s.Log.Error("Error caused after calling func ParseUnverified in tokenservice", zap.Error(err))
 		return "", ErrInvalidToken
 	}
 
 	if claims, ok := token.Claims.(*TokenClaims); ok {
-		s.Log.Fatal("Error caused after calling func Claims in tokenservice", zap.Error(err))
 		return claims.UserID, nil
 	}
 
@@ -185,19 +184,19 @@ func (s *tokenService) RefreshTokenPair(refreshToken string, user *entity.User)
 	// Валидируем refresh token
 	claims, err := s.ValidateToken(refreshToken)
 	if err != nil {
-		s.Log.Fatal("Error caused after calling func ValidateToken in tokenservice", zap.Error(err))
+		s.Log.Error("Error caused after calling func ValidateToken in tokenservice", zap.Error(err))
 		return "", "", time.Time{}, time.Time{}, err
 	}
 
 	// Проверяем что это действительно refresh token
 	if claims.TokenType != "refresh" {
-		s.Log.Fatal("Error caused in make check tokenType in tokenservice", zap.Error(err))
+		s.Log.Error("Error caused in make check tokenType in tokenservice", zap.Error(err))
 		return "", "", time.Time{}, time.Time{}, ErrInvalidToken
 	}
 
 	// Проверяем что токен принадлежит тому же пользователю
 	if claims.UserID != user.ID {
-		s.Log.Fatal("Error caused in make check UserID in tokenservice", zap.Error(err))
+		s.Log.Error("Error caused in make check UserID in tokenservice", zap.Error(err))
 		return "", "", time.Time{}, time.Time{}, ErrInvalidToken
 	}
 
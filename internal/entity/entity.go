@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -40,6 +41,8 @@ type User struct {
 	Active    bool
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// Timezone - часовой пояс IANA, по умолчанию UTC
+	Timezone string
 }
 
 type RegisterResponse struct {
@@ -56,9 +59,14 @@ type UserResponse struct {
 	Email     string    `json:"email"`
 	Username  string    `json:"username"`
 	Role      string    `json:"role"`
+	Timezone  string    `json:"timezone,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
+type UpdateTimezoneRequest struct {
+	Timezone string `json:"timezone" binding:"required"`
+}
+
 type ErrorResponse struct {
 	Error   string `json:"error"`
 	Code    string `json:"code,omitempty"`
@@ -76,6 +84,270 @@ type Task struct {
 	UpdatedAt   time.Time
 	DueDate     time.Time
 	User_id     string
+	ExternalUID string
+	CompletedAt time.Time
+	Estimate    float64
+	ParentID    string
+	Project     string
+	// CustomFields - значения пользовательских полей по ключу определения
+	CustomFields map[string]any
+	// SLA: сроки реакции и решения считаются от создания по политике приоритета
+	SLAResponseDueAt time.Time
+	SLADueAt         time.Time
+	RespondedAt      time.Time
+	SLAStatus        string
+	// StartAt - раньше задачу нельзя начать, SnoozedUntil - задача отложена; до этих моментов
+	// задача скрыта из списка
+	StartAt      time.Time
+	SnoozedUntil time.Time
+	// Checklist - прогресс чек-листа; не хранится в задаче и заполняется сервисом при чтении
+	Checklist ChecklistProgress
+}
+
+// Приоритеты задач, соответствуют enum TaskPriorities из proto/task.proto
+const (
+	PriorityLow      = "LOW"
+	PriorityNormal   = "NORMAL"
+	PriorityHigh     = "HIGH"
+	PriorityCritical = "CRITICAL"
+)
+
+// Статусы задач, соответствуют enum TaskStatus из proto/task.proto
+const (
+	StatusPending    = "PENDING"
+	StatusInProgress = "IN_PROGRESS"
+	StatusCompleted  = "COMPLETED"
+	StatusCancelled  = "CANCELLED"
+)
+
+// Статусы SLA задачи; пустой статус - SLA не отслеживается
+const (
+	SLAStatusOnTrack  = "ON_TRACK"
+	SLAStatusAtRisk   = "AT_RISK"
+	SLAStatusBreached = "BREACHED"
+	SLAStatusMet      = "MET"
+)
+
+// SLA цели: реакция (задача взята в работу) и решение (задача завершена)
+const (
+	SLATargetResponse   = "response"
+	SLATargetResolution = "resolution"
+)
+
+// SLAPolicy - цели SLA для приоритета задачи
+type SLAPolicy struct {
+	Priority          string    `json:"priority"`
+	ResponseMinutes   int32     `json:"response_minutes" binding:"required,gt=0"`
+	ResolutionMinutes int32     `json:"resolution_minutes" binding:"required,gt=0"`
+	AtRiskThreshold   float64   `json:"at_risk_threshold" binding:"omitempty,gt=0,lt=1"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+type SLAPolicyListResponse struct {
+	Policies []*SLAPolicy `json:"policies"`
+}
+
+// SLAEscalation - полезная нагрузка события task.sla_escalated об угрозе или нарушении SLA.
+// Project нужен вебхукам, подписанным на события одного проекта
+type SLAEscalation struct {
+	TaskID     string    `json:"task_id"`
+	UserID     string    `json:"user_id"`
+	Project    string    `json:"project,omitempty"`
+	Title      string    `json:"title"`
+	Priority   string    `json:"priority"`
+	SLAStatus  string    `json:"sla_status"`
+	Target     string    `json:"target"`
+	DueAt      time.Time `json:"due_at"`
+	DetectedAt time.Time `json:"detected_at"`
+}
+
+// Доменные события публикуются через outbox в topic exchange EventsExchange,
+// тип события служит routing key
+const (
+	EventsExchange = "taskmanager.events"
+
+	EventTaskCreated    = "task.created"
+	EventTaskUpdated    = "task.updated"
+	EventUserRegistered = "user.registered"
+	EventDailyDigest    = "digest.daily"
+	EventSLAEscalated   = "task.sla_escalated"
+	EventTaskShared     = "task.shared"
+)
+
+// TaskEvent - полезная нагрузка событий task.created и task.updated
+type TaskEvent struct {
+	TaskID         string     `json:"task_id"`
+	UserID         string     `json:"user_id"`
+	ParentID       string     `json:"parent_id,omitempty"`
+	Title          string     `json:"title"`
+	Status         string     `json:"status"`
+	PreviousStatus string     `json:"previous_status,omitempty"`
+	Priority       string     `json:"priority"`
+	Project        string     `json:"project,omitempty"`
+	Tags           []string   `json:"tags,omitempty"`
+	DueDate        *time.Time `json:"due_date,omitempty"`
+	OccurredAt     time.Time  `json:"occurred_at"`
+}
+
+// DigestSettings - настройки ежедневного дайджеста; Hour - локальный час отправки в часовом поясе Timezone
+type DigestSettings struct {
+	Enabled  bool   `json:"enabled"`
+	Timezone string `json:"timezone"`
+	Hour     int32  `json:"hour"`
+}
+
+// UpdateDigestSettingsRequest - частичное обновление настроек дайджеста, nil поля не меняются.
+// Часовой пояс задается в профиле (PUT /api/v1/auth/timezone)
+type UpdateDigestSettingsRequest struct {
+	Enabled *bool  `json:"enabled"`
+	Hour    *int32 `json:"hour" binding:"omitempty,min=0,max=23"`
+}
+
+// DigestRecipient - пользователь, которому пора отправить дайджест
+type DigestRecipient struct {
+	UserID   string
+	Email    string
+	Username string
+	Timezone string
+}
+
+// DigestTask - задача в письме дайджеста
+type DigestTask struct {
+	ID       string     `json:"id"`
+	Title    string     `json:"title"`
+	Priority string     `json:"priority"`
+	Status   string     `json:"status"`
+	Project  string     `json:"project,omitempty"`
+	DueDate  *time.Time `json:"due_date,omitempty"`
+}
+
+// DigestNotification - уведомление, письмо о котором накоплено до дайджеста
+type DigestNotification struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	Title     string    `json:"title"`
+	Body      string    `json:"body,omitempty"`
+	TaskID    string    `json:"task_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// DailyDigest - полезная нагрузка события digest.daily; Date - локальная дата пользователя.
+// Channels - каналы напоминания по настройкам пользователя, null - все каналы
+type DailyDigest struct {
+	UserID           string               `json:"user_id"`
+	Email            string               `json:"email"`
+	Username         string               `json:"username"`
+	Timezone         string               `json:"timezone"`
+	Date             string               `json:"date"`
+	DueToday         []DigestTask         `json:"due_today"`
+	Overdue          []DigestTask         `json:"overdue"`
+	RecentlyAssigned []DigestTask         `json:"recently_assigned"`
+	Notifications    []DigestNotification `json:"notifications"`
+	Channels         []string             `json:"channels"`
+	GeneratedAt      time.Time            `json:"generated_at"`
+}
+
+// Статусы доставки вебхука
+const (
+	WebhookDeliveryPending   = "PENDING"
+	WebhookDeliveryDelivered = "DELIVERED"
+	WebhookDeliveryFailed    = "FAILED"
+)
+
+// WebhookSubscription - подписка на события задач пользователя. Пустой Project - все проекты,
+// пустой EventTypes - все события задач. Secret возвращается только при создании
+type WebhookSubscription struct {
+	ID         string    `json:"id"`
+	UserID     string    `json:"user_id"`
+	Project    string    `json:"project"`
+	URL        string    `json:"url" binding:"required,url"`
+	EventTypes []string  `json:"event_types"`
+	Secret     string    `json:"secret,omitempty"`
+	Active     bool      `json:"active"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type WebhookListResponse struct {
+	Webhooks []*WebhookSubscription `json:"webhooks"`
+	Total    int32                  `json:"total"`
+}
+
+// WebhookDelivery - запись журнала доставок с результатом последней попытки.
+// URL и Secret заполняются только для отправки
+type WebhookDelivery struct {
+	ID             string          `json:"id"`
+	SubscriptionID string          `json:"subscription_id"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int32           `json:"attempts"`
+	ResponseStatus int32           `json:"response_status,omitempty"`
+	ResponseBody   string          `json:"response_body,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	DurationMs     int64           `json:"duration_ms"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+
+	URL    string `json:"-"`
+	Secret string `json:"-"`
+}
+
+type WebhookDeliveryListResponse struct {
+	Deliveries []*WebhookDelivery `json:"deliveries"`
+	Total      int32              `json:"total"`
+}
+
+// Типы уведомлений в приложении
+const (
+	NotificationAssignment = "assignment"
+	NotificationComment    = "comment"
+	NotificationMention    = "mention"
+	NotificationReminder   = "reminder"
+	NotificationSLABreach  = "sla_breach"
+)
+
+// Notification - уведомление пользователя в приложении; EventID - идентификатор исходного события из outbox
+type Notification struct {
+	ID        string     `json:"id"`
+	UserID    string     `json:"user_id"`
+	Type      string     `json:"type"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body,omitempty"`
+	TaskID    string     `json:"task_id,omitempty"`
+	EventID   string     `json:"-"`
+	Read      bool       `json:"read"`
+	ReadAt    *time.Time `json:"read_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+
+	// InApp - уведомление видно в приложении; EmailPending - письмо ждет дайджеста;
+	// Email - письмо, которое публикуется вместе с сохранением уведомления
+	InApp        bool               `json:"-"`
+	EmailPending bool               `json:"-"`
+	Email        *NotificationEmail `json:"-"`
+}
+
+type NotificationListResponse struct {
+	Notifications []*Notification `json:"notifications"`
+	Total         int32           `json:"total"`
+	UnreadCount   int32           `json:"unread_count"`
+}
+
+// MarkNotificationsReadResponse - число отмеченных уведомлений и оставшихся непрочитанных
+type MarkNotificationsReadResponse struct {
+	Updated     int32 `json:"updated"`
+	UnreadCount int32 `json:"unread_count"`
+}
+
+// UserRegisteredEvent - полезная нагрузка события user.registered
+type UserRegisteredEvent struct {
+	UserID     string    `json:"user_id"`
+	Email      string    `json:"email"`
+	Username   string    `json:"username"`
+	OccurredAt time.Time `json:"occurred_at"`
 }
 
 // type TaskCreate struct {
@@ -93,21 +365,36 @@ type TaskRequest struct {
 	Priority    string   `json:"priority"` //TODO:сделать enum, чтобы проверялось правильность введения
 	Status      string   `json:"status"`
 	Tags        []string `json:"tags"`
-	User_id     string
+	Estimate    float64  `json:"estimate"`
+	Project     string   `json:"project"`
+	// CustomFields - значения пользовательских полей: {"client_id": "C-42", "story_points": 5}
+	CustomFields map[string]any `json:"custom_fields"`
+	StartAt      *time.Time     `json:"start_at"`
+	User_id      string
 }
 
 type TaskResponse struct {
 	Task *Task `json:"task"`
 }
 
+// TaskListData - задача в списке; UserID отличает чужие задачи, доступные по шарингу, от своих
 type TaskListData struct {
-	Title       string   `json:"title"`
-	Description string   `json:"description"`
-	Priority    string   `json:"priority"` //TODO:сделать enum, чтобы проверялось правильность введения
-	Status      string   `json:"status"`
-	Tags        []string `json:"tags"`
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID           string   `json:"id"`
+	UserID       string   `json:"user_id"`
+	Title        string   `json:"title"`
+	Description  string   `json:"description"`
+	Priority     string   `json:"priority"` //TODO:сделать enum, чтобы проверялось правильность введения
+	Status       string   `json:"status"`
+	Tags         []string `json:"tags"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	Project      string             `json:"project,omitempty"`
+	CustomFields map[string]any     `json:"custom_fields,omitempty"`
+	SLAStatus    string             `json:"sla_status,omitempty"`
+	SLADueAt     *time.Time         `json:"sla_due_at,omitempty"`
+	StartAt      *time.Time         `json:"start_at,omitempty"`
+	SnoozedUntil *time.Time         `json:"snoozed_until,omitempty"`
+	Checklist    *ChecklistProgress `json:"checklist,omitempty"`
 }
 
 type TaskListResponse struct {
@@ -118,10 +405,533 @@ type TaskListResponse struct {
 
 type GetTaskRequest struct {
 	TaskId string
+	UserId string
+}
+
+type ImportResult struct {
+	Created int32   `json:"created"`
+	Updated int32   `json:"updated"`
+	Skipped int32   `json:"skipped"`
+	Tasks   []*Task `json:"tasks"`
+}
+
+type QuickAddRequest struct {
+	Text     string `json:"text" binding:"required"`
+	Timezone string `json:"timezone"`
+	Preview  bool   `json:"preview"`
+}
+
+type QuickAddResponse struct {
+	Task  *Task `json:"task"`
+	Saved bool  `json:"saved"`
 }
 
-type EmailMessage struct {
-	EmailTo string `json:"email"`
+type UpdateTaskRequest struct {
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Status      string     `json:"status"`
+	Tags        []string   `json:"tags"`
+	DueDate     *time.Time `json:"due_date"`
+	Estimate    *float64   `json:"estimate"`
+	Project     *string    `json:"project"`
+	// CustomFields сливается с текущими значениями; null удаляет значение поля
+	CustomFields map[string]any `json:"custom_fields"`
+	// StartAt - дата, раньше которой задачу нельзя начать; нулевое время снимает дату
+	StartAt *time.Time `json:"start_at"`
 }
 
+// SnoozeTaskRequest - Until в RFC3339 или относительное выражение ("now+2h", "tomorrow+9h")
+// в часовом поясе Timezone
+type SnoozeTaskRequest struct {
+	Until    string `json:"until" binding:"required"`
+	Timezone string `json:"timezone"`
+}
+
+// StatusChange - запись истории статусов задачи
+type StatusChange struct {
+	TaskID    string
+	Status    string
+	ChangedAt time.Time
+}
+
+type StatsFilter struct {
+	UserID   string
+	Tags     []string
+	From     time.Time
+	To       time.Time
+	Location *time.Location
+	// IncludeClosed - учитывать задачи, завершенные до начала периода (нужно для накопленного потока)
+	IncludeClosed bool
+}
+
+type StatsBucket struct {
+	Period    string `json:"period"`
+	Created   int32  `json:"created"`
+	Completed int32  `json:"completed"`
+}
+
+type StatusDuration struct {
+	Status     string  `json:"status"`
+	TotalHours float64 `json:"total_hours"`
+	AvgHours   float64 `json:"avg_hours"`
+	Tasks      int32   `json:"tasks"`
+}
+
+type TaskStats struct {
+	From              time.Time        `json:"from"`
+	To                time.Time        `json:"to"`
+	Created           int32            `json:"created"`
+	Completed         int32            `json:"completed"`
+	Daily             []StatsBucket    `json:"daily"`
+	Weekly            []StatsBucket    `json:"weekly"`
+	AvgCycleTimeHours float64          `json:"avg_cycle_time_hours"`
+	P90CycleTimeHours float64          `json:"p90_cycle_time_hours"`
+	TimeInStatus      []StatusDuration `json:"time_in_status"`
+	OverdueRate       float64          `json:"overdue_rate"`
+}
+
+// BurndownPoint - остаток открытых задач и оценок на конец дня
+type BurndownPoint struct {
+	Date              string  `json:"date"`
+	OpenTasks         int32   `json:"open_tasks"`
+	RemainingEstimate float64 `json:"remaining_estimate"`
+	IdealEstimate     float64 `json:"ideal_estimate"`
+}
+
+// CumulativeFlowPoint - количество задач в каждом статусе на конец дня
+type CumulativeFlowPoint struct {
+	Date   string           `json:"date"`
+	Counts map[string]int32 `json:"counts"`
+}
+
+type BurndownResponse struct {
+	Points []BurndownPoint `json:"points"`
+}
+
+type CumulativeFlowResponse struct {
+	Statuses []string              `json:"statuses"`
+	Points   []CumulativeFlowPoint `json:"points"`
+}
+
+// TaskFilter - условия выборки списка задач
+type TaskFilter struct {
+	UserID     string
+	Statuses   []string
+	Priorities []string
+	Tags       []string
+	Search     string
+	DueFrom    time.Time
+	DueTo      time.Time
+	Project    string
+	// CustomFields - условия на пользовательские поля, значения уже приведены к типу поля
+	CustomFields []CustomFieldCondition
+	SortBy       string
+	SortDesc     bool
+	// AvailableAt, если задано, скрывает задачи с start_at или snoozed_until позже этого момента
+	AvailableAt time.Time
+	// Shared - вместо задач UserID выбрать задачи, к которым ему выдан доступ
+	Shared bool
+}
+
+// TaskListQuery - параметры запроса списка задач. Условия CustomFields приходят строками
+// и приводятся к типу поля в сервисе; Project и SortBy перекрывают значения представления
+type TaskListQuery struct {
+	UserID       string
+	ViewID       string
+	Timezone     string
+	Project      string
+	CustomFields []CustomFieldCondition
+	SortBy       string
+	SortDesc     bool
+	// IncludeDeferred - показать задачи, которые еще нельзя начать или которые отложены
+	IncludeDeferred bool
+	// SharedWithMe - вместо своих задач вернуть задачи других пользователей, к которым выдан доступ
+	SharedWithMe bool
+}
+
+// Поля, по которым можно сортировать список задач
+const (
+	SortByCreatedAt = "created_at"
+	SortByUpdatedAt = "updated_at"
+	SortByDueDate   = "due_date"
+	SortByPriority  = "priority"
+	SortByTitle     = "title"
+	// SortByCustomFieldPrefix + ключ поля - сортировка по пользовательскому полю, например "cf:story_points"
+	SortByCustomFieldPrefix = "cf:"
+)
+
+// SavedViewFilter хранит фильтр представления; DueFrom/DueTo - относительные выражения
+// ("today", "end_of_week", "now+3d"), которые вычисляются в момент запроса
+type SavedViewFilter struct {
+	Statuses   []string `json:"statuses,omitempty"`
+	Priorities []string `json:"priorities,omitempty"`
+	Tags       []string `json:"tags,omitempty"`
+	Search     string   `json:"search,omitempty"`
+	DueFrom    string   `json:"due_from,omitempty"`
+	DueTo      string   `json:"due_to,omitempty"`
+	Project    string   `json:"project,omitempty"`
+	// CustomFields хранит условия с исходными строковыми значениями
+	CustomFields []CustomFieldCondition `json:"custom_fields,omitempty"`
+}
 
+type SavedViewSort struct {
+	Field string `json:"field,omitempty"`
+	Desc  bool   `json:"desc,omitempty"`
+}
+
+type SavedView struct {
+	ID        string          `json:"id"`
+	UserID    string          `json:"user_id"`
+	Name      string          `json:"name" binding:"required,max=100"`
+	Filter    SavedViewFilter `json:"filter"`
+	Sort      SavedViewSort   `json:"sort"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+type SavedViewListResponse struct {
+	Views []*SavedView `json:"views"`
+	Total int32        `json:"total"`
+}
+
+// Типы пользовательских полей
+const (
+	CustomFieldText         = "text"
+	CustomFieldNumber       = "number"
+	CustomFieldDate         = "date"
+	CustomFieldSingleSelect = "single_select"
+	CustomFieldMultiSelect  = "multi_select"
+)
+
+// Операторы сравнения в условиях на пользовательские поля
+const (
+	CustomFieldOpEq  = "eq"
+	CustomFieldOpGt  = "gt"
+	CustomFieldOpGte = "gte"
+	CustomFieldOpLt  = "lt"
+	CustomFieldOpLte = "lte"
+)
+
+// CustomFieldDefinition описывает пользовательское поле задач.
+// Пустой Project - поле действует во всех проектах пользователя; поле проекта перекрывает общее с тем же ключом
+type CustomFieldDefinition struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	Project   string    `json:"project"`
+	Key       string    `json:"key" binding:"required,max=64"`
+	Name      string    `json:"name" binding:"required,max=100"`
+	Type      string    `json:"type" binding:"required"`
+	Options   []string  `json:"options"`
+	Required  bool      `json:"required"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type CustomFieldListResponse struct {
+	Fields []*CustomFieldDefinition `json:"fields"`
+	Total  int32                    `json:"total"`
+}
+
+// CustomFieldCondition - условие выборки задач по пользовательскому полю.
+// Для multi_select оператор eq означает "содержит значение"
+type CustomFieldCondition struct {
+	Key   string `json:"key"`
+	Op    string `json:"op"`
+	Value any    `json:"value"`
+}
+
+// SubtaskBlueprint - заготовка подзадачи, создаваемой вместе с задачей из шаблона
+type SubtaskBlueprint struct {
+	Title       string `json:"title" binding:"required"`
+	Description string `json:"description,omitempty"`
+	Priority    string `json:"priority,omitempty"`
+	DueOffset   string `json:"due_offset,omitempty"`
+}
+
+// TaskTemplate - шаблон задачи. Title и Description могут содержать плейсхолдеры {{name}},
+// DueOffset - относительное выражение срока ("today+2d", "now+4h")
+type TaskTemplate struct {
+	ID          string             `json:"id"`
+	UserID      string             `json:"user_id"`
+	Name        string             `json:"name" binding:"required,max=100"`
+	Title       string             `json:"title" binding:"required"`
+	Description string             `json:"description"`
+	Priority    string             `json:"priority"`
+	Tags        []string           `json:"tags"`
+	DueOffset   string             `json:"due_offset"`
+	Checklist   []string           `json:"checklist"`
+	Subtasks    []SubtaskBlueprint `json:"subtasks"`
+	CreatedAt   time.Time          `json:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+}
+
+type TaskTemplateListResponse struct {
+	Templates []*TaskTemplate `json:"templates"`
+	Total     int32           `json:"total"`
+}
+
+type InstantiateTemplateRequest struct {
+	Variables map[string]string `json:"variables"`
+	Timezone  string            `json:"timezone"`
+}
+
+type InstantiateTemplateResponse struct {
+	Task     *Task   `json:"task"`
+	Subtasks []*Task `json:"subtasks"`
+}
+
+// Факторы оценки "что делать дальше"
+const (
+	ScoreFactorPriority = "priority"
+	ScoreFactorDueDate  = "due_date"
+	ScoreFactorSLA      = "sla"
+	ScoreFactorBlocking = "blocking"
+	ScoreFactorAge      = "age"
+)
+
+// NextTasksQuery - параметры подбора следующих задач. Weights - веса факторов по имени,
+// пропущенные факторы получают вес по умолчанию, нулевой вес отключает фактор
+type NextTasksQuery struct {
+	UserID  string
+	Project string
+	Limit   int
+	Weights map[string]float64
+}
+
+// ScoreFactor - вклад одного фактора: Value от -1 до 1, Contribution = Weight * Value
+type ScoreFactor struct {
+	Factor       string  `json:"factor"`
+	Value        float64 `json:"value"`
+	Weight       float64 `json:"weight"`
+	Contribution float64 `json:"contribution"`
+	Reason       string  `json:"reason"`
+}
+
+type ScoredTask struct {
+	Task    *Task         `json:"task"`
+	Score   float64       `json:"score"`
+	Factors []ScoreFactor `json:"factors"`
+}
+
+type NextTasksResponse struct {
+	Tasks   []ScoredTask       `json:"tasks"`
+	Weights map[string]float64 `json:"weights"`
+}
+
+// Квадранты матрицы Эйзенхауэра
+const (
+	QuadrantDoFirst   = "DO_FIRST"  // срочно и важно
+	QuadrantSchedule  = "SCHEDULE"  // важно, но не срочно
+	QuadrantDelegate  = "DELEGATE"  // срочно, но не важно
+	QuadrantEliminate = "ELIMINATE" // не срочно и не важно
+)
+
+// MatrixThresholds - пороги классификации: задача важна с приоритета ImportantPriority,
+// срочна за UrgentWithinHours часов до срока или со статуса SLA UrgentSLAStatus
+type MatrixThresholds struct {
+	ImportantPriority string `json:"important_priority"`
+	UrgentWithinHours int    `json:"urgent_within_hours"`
+	UrgentSLAStatus   string `json:"urgent_sla_status"`
+}
+
+type EisenhowerQuery struct {
+	UserID     string
+	Project    string
+	Thresholds MatrixThresholds
+}
+
+// MatrixTask - задача в квадранте. Important и Urgent соответствуют итоговому квадранту,
+// Overridden - квадрант закреплен вручную
+type MatrixTask struct {
+	Task       *Task  `json:"task"`
+	Quadrant   string `json:"quadrant"`
+	Important  bool   `json:"important"`
+	Urgent     bool   `json:"urgent"`
+	Overridden bool   `json:"overridden"`
+	Reason     string `json:"reason"`
+}
+
+type EisenhowerMatrix struct {
+	DoFirst    []MatrixTask     `json:"do_first"`
+	Schedule   []MatrixTask     `json:"schedule"`
+	Delegate   []MatrixTask     `json:"delegate"`
+	Eliminate  []MatrixTask     `json:"eliminate"`
+	Thresholds MatrixThresholds `json:"thresholds"`
+}
+
+// QuadrantOverrideRequest - пустой Quadrant снимает закрепление задачи
+type QuadrantOverrideRequest struct {
+	Quadrant string `json:"quadrant"`
+}
+
+type QuadrantOverrideResponse struct {
+	TaskID   string `json:"task_id"`
+	Quadrant string `json:"quadrant"`
+}
+
+// Виды записей календаря
+const (
+	CalendarEntryDue   = "due"
+	CalendarEntryStart = "start"
+)
+
+// CalendarFilter - задачи со сроком или началом в полуинтервале [From, To)
+type CalendarFilter struct {
+	UserID  string
+	Project string
+	From    time.Time
+	To      time.Time
+}
+
+// CalendarQuery - диапазон локальных дат YYYY-MM-DD включительно; пустой Timezone означает UTC
+type CalendarQuery struct {
+	UserID   string
+	From     string
+	To       string
+	Timezone string
+	Project  string
+}
+
+// CalendarEntry - событие задачи в календаре: срок (due) или начало (start)
+type CalendarEntry struct {
+	Kind string    `json:"kind"`
+	At   time.Time `json:"at"`
+	Task *Task     `json:"task"`
+}
+
+// CalendarDay - локальный день; дни без задач тоже возвращаются, с пустым Entries
+type CalendarDay struct {
+	Date    string          `json:"date"`
+	Entries []CalendarEntry `json:"entries"`
+}
+
+type Calendar struct {
+	From     string        `json:"from"`
+	To       string        `json:"to"`
+	Timezone string        `json:"timezone"`
+	Days     []CalendarDay `json:"days"`
+}
+
+// ChecklistItem - пункт чек-листа задачи; пункты упорядочены по Position
+type ChecklistItem struct {
+	ID        string    `json:"id"`
+	TaskID    string    `json:"task_id"`
+	Text      string    `json:"text"`
+	Done      bool      `json:"done"`
+	Position  int       `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ChecklistProgress - выполненные и все пункты чек-листа; Total = 0 означает, что чек-листа нет
+type ChecklistProgress struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
+
+type Checklist struct {
+	Items    []ChecklistItem   `json:"items"`
+	Progress ChecklistProgress `json:"progress"`
+}
+
+type AddChecklistItemRequest struct {
+	Text string `json:"text" binding:"required"`
+}
+
+// ReorderChecklistRequest - новый порядок всех пунктов чек-листа
+type ReorderChecklistRequest struct {
+	ItemIDs []string `json:"item_ids" binding:"required"`
+}
+
+// CloneTaskOptions - параметры копирования задачи. ShiftDueBy - сдвиг сроков и дат начала
+// вида "+7d", "-2h", "+1w", отсчитываемый в часовом поясе Timezone
+type CloneTaskOptions struct {
+	IncludeSubtasks bool   `json:"include_subtasks"`
+	ResetStatus     bool   `json:"reset_status"`
+	ShiftDueBy      string `json:"shift_due_by"`
+	Timezone        string `json:"timezone"`
+}
+
+// TaskCopy - задача дерева копирования. Parent - индекс родителя в том же срезе (-1 у корня),
+// родитель всегда стоит раньше потомков; Checklist копируется вместе с задачей
+type TaskCopy struct {
+	Task      *Task
+	Parent    int
+	Checklist []ChecklistItem
+}
+
+// Права доступа к чужой задаче
+const (
+	SharePermissionRead = "READ"
+	SharePermissionEdit = "EDIT"
+)
+
+// TaskShare - доступ пользователя GranteeID к задаче, выданный владельцем GrantedBy
+type TaskShare struct {
+	TaskID     string    `json:"task_id"`
+	GranteeID  string    `json:"grantee_id"`
+	Permission string    `json:"permission"`
+	GrantedBy  string    `json:"granted_by"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// TaskSharedEvent - полезная нагрузка события task.shared: владелец UserID выдал пользователю
+// GranteeID доступ к задаче или изменил его уровень
+type TaskSharedEvent struct {
+	TaskID     string    `json:"task_id"`
+	UserID     string    `json:"user_id"`
+	GranteeID  string    `json:"grantee_id"`
+	Title      string    `json:"title"`
+	Permission string    `json:"permission"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// ShareTaskRequest - пустой Permission означает READ
+type ShareTaskRequest struct {
+	UserID     string `json:"user_id" binding:"required"`
+	Permission string `json:"permission"`
+}
+
+type TaskSharesResponse struct {
+	Shares []TaskShare `json:"shares"`
+}
+
+// PublicLink - публичная ссылка на задачу только для чтения. Token заполнен только в ответе
+// на создание: в базе хранится его хеш
+type PublicLink struct {
+	ID           string     `json:"id"`
+	TaskID       string     `json:"task_id"`
+	UserID       string     `json:"-"`
+	Token        string     `json:"token,omitempty"`
+	URL          string     `json:"url,omitempty"`
+	ExpiresAt    time.Time  `json:"expires_at"`
+	RevokedAt    *time.Time `json:"revoked_at,omitempty"`
+	ViewCount    int64      `json:"view_count"`
+	LastViewedAt *time.Time `json:"last_viewed_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// CreatePublicLinkRequest - ExpiresAt в RFC3339 или относительное выражение ("now+7d"),
+// по умолчанию ссылка действует неделю
+type CreatePublicLinkRequest struct {
+	ExpiresAt string `json:"expires_at"`
+	Timezone  string `json:"timezone"`
+}
+
+type PublicLinksResponse struct {
+	Links []PublicLink `json:"links"`
+}
+
+// PublicTaskView - поля задачи, которые видны по публичной ссылке
+type PublicTaskView struct {
+	Title         string             `json:"title"`
+	Description   string             `json:"description"`
+	Status        string             `json:"status"`
+	Priority      string             `json:"priority"`
+	DueDate       *time.Time         `json:"due_date,omitempty"`
+	CompletedAt   *time.Time         `json:"completed_at,omitempty"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+	Checklist     *ChecklistProgress `json:"checklist,omitempty"`
+	LinkExpiresAt time.Time          `json:"link_expires_at"`
+}
@@ -0,0 +1,166 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// Каналы доставки уведомлений
+const (
+	ChannelEmail   = "email"
+	ChannelInApp   = "in_app"
+	ChannelWebhook = "webhook"
+	// ChannelOff в запросе отключает все каналы типа уведомления
+	ChannelOff = "off"
+)
+
+// EventNotificationEmail - письмо об отдельном уведомлении, отправляется cmd/rabbitmq
+const EventNotificationEmail = "notification.email"
+
+// NotificationTypes - типы уведомлений, для которых настраиваются каналы
+var NotificationTypes = []string{
+	NotificationAssignment,
+	NotificationComment,
+	NotificationMention,
+	NotificationReminder,
+	NotificationSLABreach,
+}
+
+// QuietHours - локальное время (HH:MM) в часовом поясе пользователя, когда письма откладываются.
+// Start позже End - интервал через полночь
+type QuietHours struct {
+	Enabled bool   `json:"enabled"`
+	Start   string `json:"start"`
+	End     string `json:"end"`
+}
+
+// NotificationPreferences - настройки уведомлений пользователя. Channels - каналы по типу уведомления,
+// пустой список - тип отключен. DigestBatching - письма по отдельным уведомлениям копятся до дайджеста
+type NotificationPreferences struct {
+	Channels       map[string][]string `json:"channels"`
+	QuietHours     QuietHours          `json:"quiet_hours"`
+	Timezone       string              `json:"timezone"`
+	DigestBatching bool                `json:"digest_batching"`
+}
+
+// UpdateNotificationPreferencesRequest - частичное обновление: в Channels меняются только переданные типы,
+// nil поля не меняются. Часовой пояс тихих часов задается в профиле (PUT /api/v1/auth/timezone)
+type UpdateNotificationPreferencesRequest struct {
+	Channels       map[string][]string `json:"channels"`
+	QuietHours     *QuietHours         `json:"quiet_hours"`
+	DigestBatching *bool               `json:"digest_batching"`
+}
+
+// NotificationRecipient - адресат уведомлений с настройками, которые проверяет производитель перед публикацией
+type NotificationRecipient struct {
+	UserID        string
+	Email         string
+	Username      string
+	DigestEnabled bool
+	Preferences   NotificationPreferences
+}
+
+// NotificationEmail - полезная нагрузка события notification.email.
+// SendAfter - конец тихих часов, до него событие не публикуется
+type NotificationEmail struct {
+	UserID    string    `json:"user_id"`
+	Email     string    `json:"email"`
+	Username  string    `json:"username"`
+	Type      string    `json:"type"`
+	Title     string    `json:"title"`
+	Body      string    `json:"body"`
+	TaskID    string    `json:"task_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+
+	SendAfter time.Time `json:"-"`
+}
+
+// DefaultNotificationChannels возвращает каналы по умолчанию: письма о назначениях выключены,
+// вебхуки получают все события, кроме напоминаний
+func DefaultNotificationChannels() map[string][]string {
+	return map[string][]string{
+		NotificationAssignment: {ChannelInApp, ChannelWebhook},
+		NotificationComment:    {ChannelInApp, ChannelEmail, ChannelWebhook},
+		NotificationMention:    {ChannelInApp, ChannelEmail, ChannelWebhook},
+		NotificationReminder:   {ChannelInApp, ChannelEmail},
+		NotificationSLABreach:  {ChannelInApp, ChannelEmail, ChannelWebhook},
+	}
+}
+
+// DefaultNotificationPreferences возвращает настройки пользователя, который их не менял
+func DefaultNotificationPreferences() NotificationPreferences {
+	return NotificationPreferences{
+		Channels:   DefaultNotificationChannels(),
+		QuietHours: QuietHours{Start: "22:00", End: "07:00"},
+		Timezone:   "UTC",
+	}
+}
+
+// WithDefaultChannels дополняет сохраненные каналы значениями по умолчанию для отсутствующих типов
+func WithDefaultChannels(channels map[string][]string) map[string][]string {
+	result := DefaultNotificationChannels()
+	for notificationType, list := range channels {
+		if _, ok := result[notificationType]; ok {
+			result[notificationType] = list
+		}
+	}
+	return result
+}
+
+// Allows сообщает, включен ли канал channel для уведомлений типа notificationType
+func (p NotificationPreferences) Allows(notificationType, channel string) bool {
+	channels, ok := p.Channels[notificationType]
+	if !ok {
+		channels = DefaultNotificationChannels()[notificationType]
+	}
+	for _, enabled := range channels {
+		if enabled == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// QuietUntil возвращает конец тихих часов, если now в них попадает
+func (p NotificationPreferences) QuietUntil(now time.Time) (time.Time, bool) {
+	if !p.QuietHours.Enabled {
+		return time.Time{}, false
+	}
+	start, err := ParseClock(p.QuietHours.Start)
+	if err != nil {
+		return time.Time{}, false
+	}
+	end, err := ParseClock(p.QuietHours.End)
+	if err != nil || start == end {
+		return time.Time{}, false
+	}
+
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	local := now.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	endOfQuiet := func(days int) time.Time {
+		return time.Date(local.Year(), local.Month(), local.Day()+days, end/60, end%60, 0, 0, loc)
+	}
+
+	switch {
+	case start < end && minute >= start && minute < end:
+		return endOfQuiet(0), true
+	case start > end && minute >= start:
+		return endOfQuiet(1), true
+	case start > end && minute < end:
+		return endOfQuiet(0), true
+	}
+	return time.Time{}, false
+}
+
+// ParseClock разбирает время HH:MM и возвращает число минут от полуночи
+func ParseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
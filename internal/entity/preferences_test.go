@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQuietUntil(t *testing.T) {
+	utc := func(day, hour, minute int) time.Time {
+		return time.Date(2030, time.January, day, hour, minute, 0, 0, time.UTC)
+	}
+	quiet := func(start, end, timezone string) NotificationPreferences {
+		return NotificationPreferences{QuietHours: QuietHours{Enabled: true, Start: start, End: end}, Timezone: timezone}
+	}
+
+	tests := []struct {
+		name        string
+		preferences NotificationPreferences
+		now         time.Time
+		want        time.Time
+	}{
+		{name: "disabled", preferences: NotificationPreferences{QuietHours: QuietHours{Start: "22:00", End: "07:00"}}, now: utc(2, 23, 0)},
+		{name: "same day inside", preferences: quiet("13:00", "14:00", "UTC"), now: utc(2, 13, 30), want: utc(2, 14, 0)},
+		{name: "same day start", preferences: quiet("13:00", "14:00", "UTC"), now: utc(2, 13, 0), want: utc(2, 14, 0)},
+		{name: "same day end", preferences: quiet("13:00", "14:00", "UTC"), now: utc(2, 14, 0)},
+		{name: "same day before", preferences: quiet("13:00", "14:00", "UTC"), now: utc(2, 12, 59)},
+		{name: "overnight evening", preferences: quiet("22:00", "07:00", "UTC"), now: utc(2, 23, 0), want: utc(3, 7, 0)},
+		{name: "overnight start", preferences: quiet("22:00", "07:00", "UTC"), now: utc(2, 22, 0), want: utc(3, 7, 0)},
+		{name: "overnight morning", preferences: quiet("22:00", "07:00", "UTC"), now: utc(2, 6, 59), want: utc(2, 7, 0)},
+		{name: "overnight end", preferences: quiet("22:00", "07:00", "UTC"), now: utc(2, 7, 0)},
+		{name: "overnight daytime", preferences: quiet("22:00", "07:00", "UTC"), now: utc(2, 15, 0)},
+		{name: "equal start and end", preferences: quiet("22:00", "22:00", "UTC"), now: utc(2, 22, 0)},
+		{name: "invalid clock", preferences: quiet("25:00", "07:00", "UTC"), now: utc(2, 23, 0)},
+		// 20:00 UTC - 23:00 в Москве, тихие часы до 07:00 по Москве
+		{name: "user time zone", preferences: quiet("22:00", "07:00", "Europe/Moscow"), now: utc(2, 20, 0), want: utc(3, 4, 0)},
+		{name: "unknown time zone falls back to UTC", preferences: quiet("22:00", "07:00", "Mars/Olympus"), now: utc(2, 23, 0), want: utc(3, 7, 0)},
+		{name: "empty time zone is UTC", preferences: quiet("22:00", "07:00", ""), now: utc(2, 20, 0)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := tc.preferences.QuietUntil(tc.now)
+			if ok != !tc.want.IsZero() || !got.Equal(tc.want) {
+				t.Errorf("QuietUntil(%v) = %v, %v; want %v", tc.now, got, ok, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// maxBackoff ограничивает паузу между повторными попытками публикации события
+	maxBackoff = 5 * time.Minute
+	// lastErrorLimit - сколько символов ошибки брокера сохраняется в last_error
+	lastErrorLimit = 1000
+)
+
+// Execer - транзакция, в которой пишется событие. Запись должна идти в той же транзакции,
+// что и изменение данных, иначе событие может потеряться или опубликоваться без изменения
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// Publisher отправляет событие в exchange брокера и возвращает nil только после подтверждения
+type Publisher interface {
+	PublishEvent(ctx context.Context, exchange, routingKey, messageID string, body []byte) error
+}
+
+// Event - запись outbox, ожидающая публикации
+type Event struct {
+	ID          int64
+	Type        string
+	AggregateID string
+	Payload     []byte
+	Attempts    int
+}
+
+// Write сохраняет событие eventType с полезной нагрузкой payload в outbox внутри транзакции tx
+func Write(ctx context.Context, tx Execer, eventType, aggregateID string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO outbox (event_type, aggregate_id, payload) VALUES ($1, $2, $3)`,
+		eventType, aggregateID, body,
+	)
+	return err
+}
+
+// WriteAt сохраняет событие, которое relay опубликует не раньше notBefore (например, после тихих часов)
+func WriteAt(ctx context.Context, tx Execer, eventType, aggregateID string, payload any, notBefore time.Time) error {
+	if notBefore.IsZero() {
+		return Write(ctx, tx, eventType, aggregateID, payload)
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO outbox (event_type, aggregate_id, payload, next_attempt_at) VALUES ($1, $2, $3, $4)`,
+		eventType, aggregateID, body, notBefore,
+	)
+	return err
+}
+
+// Relay периодически выбирает неотправленные события outbox, публикует их в exchange
+// (тип события - routing key, id записи - message id) и отмечает отправленными.
+// Неудачная публикация откладывает событие с экспоненциальной паузой; после maxAttempts
+// попыток событие остается в таблице с last_error для ручного разбора.
+// Доставка at-least-once: потребители должны быть идемпотентны по message id
+type Relay struct {
+	db          *sql.DB
+	publisher   Publisher
+	exchange    string
+	interval    time.Duration
+	batchSize   int
+	maxAttempts int
+	Log         *zap.Logger
+
+	now func() time.Time
+}
+
+// NewRelay создает relay. Несколько экземпляров могут работать одновременно:
+// строки блокируются через FOR UPDATE SKIP LOCKED
+func NewRelay(db *sql.DB, publisher Publisher, exchange string, interval time.Duration, batchSize, maxAttempts int, Log *zap.Logger) *Relay {
+	return &Relay{
+		db:          db,
+		publisher:   publisher,
+		exchange:    exchange,
+		interval:    interval,
+		batchSize:   batchSize,
+		maxAttempts: maxAttempts,
+		Log:         Log,
+		now:         time.Now,
+	}
+}
+
+// Run публикует события до отмены ctx. Полная пачка означает, что в очереди остались события,
+// поэтому следующая выборка идет сразу, без ожидания интервала
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		dispatched, err := r.Dispatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			r.Log.Error("Error caused after calling outbox relay's Dispatch", zap.Error(err))
+		}
+		if err == nil && dispatched == r.batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch публикует одну пачку событий и возвращает число отправленных.
+// На первой ошибке брокера пачка прерывается, чтобы не нарушать порядок остальных событий
+func (r *Relay) Dispatch(ctx context.Context) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	events, err := r.pending(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	dispatched := 0
+	for _, event := range events {
+		messageID := strconv.FormatInt(event.ID, 10)
+		if err := r.publisher.PublishEvent(ctx, r.exchange, event.Type, messageID, event.Payload); err != nil {
+			r.Log.Warn("Failed to publish outbox event, will retry",
+				zap.Int64("id", event.ID), zap.String("type", event.Type), zap.Int("attempts", event.Attempts+1), zap.Error(err))
+			if markErr := r.markFailed(ctx, tx, event, err); markErr != nil {
+				return dispatched, markErr
+			}
+			break
+		}
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE outbox SET dispatched_at = $2, attempts = attempts + 1, last_error = NULL WHERE id = $1`,
+			event.ID, r.now(),
+		); err != nil {
+			return dispatched, err
+		}
+		dispatched++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return dispatched, nil
+}
+
+func (r *Relay) pending(ctx context.Context, tx *sql.Tx) ([]Event, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id, event_type, aggregate_id, payload, attempts
+		FROM outbox
+		WHERE dispatched_at IS NULL AND attempts < $1 AND next_attempt_at <= $2
+		ORDER BY id
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED
+	`, r.maxAttempts, r.now(), r.batchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []Event
+	for rows.Next() {
+		var event Event
+		if err := rows.Scan(&event.ID, &event.Type, &event.AggregateID, &event.Payload, &event.Attempts); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+func (r *Relay) markFailed(ctx context.Context, tx *sql.Tx, event Event, cause error) error {
+	message := cause.Error()
+	if len(message) > lastErrorLimit {
+		message = message[:lastErrorLimit]
+	}
+	_, err := tx.ExecContext(ctx,
+		`UPDATE outbox SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3 WHERE id = $1`,
+		event.ID, message, r.now().Add(Backoff(event.Attempts+1)),
+	)
+	return err
+}
+
+// Backoff возвращает паузу перед следующей попыткой после attempts неудачных: 1s, 2s, 4s ... до maxBackoff
+func Backoff(attempts int) time.Duration {
+	if attempts < 1 {
+		return 0
+	}
+	if attempts > 20 {
+		return maxBackoff
+	}
+	delay := time.Second << (attempts - 1)
+	if delay > maxBackoff {
+		return maxBackoff
+	}
+	return delay
+}
@@ -0,0 +1,127 @@
+package rabbitmq
+
+import (
+	"context"
+	"time"
+
+	"github.com/streadway/amqp"
+	"go.uber.org/zap"
+)
+
+// reconnectDelay - пауза перед повторным подключением потребителя к брокеру
+const reconnectDelay = 5 * time.Second
+
+// Handler обрабатывает одно сообщение. Ошибка возвращает сообщение в очередь один раз,
+// при повторной ошибке сообщение отбрасывается, чтобы не блокировать очередь
+type Handler func(ctx context.Context, delivery amqp.Delivery) error
+
+// Consumer читает устойчивую очередь queue, привязанную к topic exchange по routingKeys.
+// Сообщения подтверждаются после успешной обработки, подключение восстанавливается после обрыва
+type Consumer struct {
+	url         string
+	exchange    string
+	queue       string
+	routingKeys []string
+	autoDelete  bool
+	Log         *zap.Logger
+}
+
+// NewConsumer создает потребителя; подключение устанавливается в Run
+func NewConsumer(url, exchange, queue string, routingKeys []string, Log *zap.Logger) *Consumer {
+	return &Consumer{
+		url:         url,
+		exchange:    exchange,
+		queue:       queue,
+		routingKeys: routingKeys,
+		Log:         Log,
+	}
+}
+
+// AutoDelete делает очередь временной: RabbitMQ удаляет ее вместе с сообщениями после отключения
+// потребителя. Нужна, когда каждый экземпляр сервиса должен получить все сообщения
+func (c *Consumer) AutoDelete() *Consumer {
+	c.autoDelete = true
+	return c
+}
+
+// Run обрабатывает сообщения handler до отмены ctx
+func (c *Consumer) Run(ctx context.Context, handler Handler) {
+	for {
+		if err := c.consume(ctx, handler); err != nil && ctx.Err() == nil {
+			c.Log.Error("RabbitMQ consumer stopped, reconnecting", zap.String("queue", c.queue), zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
+
+func (c *Consumer) consume(ctx context.Context, handler Handler) error {
+	conn, err := amqp.Dial(c.url)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return err
+	}
+	defer ch.Close()
+
+	if err := ch.ExchangeDeclare(c.exchange, amqp.ExchangeTopic, true, false, false, false, nil); err != nil {
+		return err
+	}
+	q, err := ch.QueueDeclare(c.queue, !c.autoDelete, c.autoDelete, false, false, nil)
+	if err != nil {
+		return err
+	}
+	for _, key := range c.routingKeys {
+		if err := ch.QueueBind(q.Name, key, c.exchange, false, nil); err != nil {
+			return err
+		}
+	}
+	if err := ch.Qos(10, 0, false); err != nil {
+		return err
+	}
+
+	deliveries, err := ch.Consume(q.Name, "", false, false, false, false, nil)
+	if err != nil {
+		return err
+	}
+	closed := conn.NotifyClose(make(chan *amqp.Error, 1))
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case amqpErr := <-closed:
+			if amqpErr != nil {
+				return amqpErr
+			}
+			return amqp.ErrClosed
+		case delivery, ok := <-deliveries:
+			if !ok {
+				return amqp.ErrClosed
+			}
+			c.handle(ctx, handler, delivery)
+		}
+	}
+}
+
+func (c *Consumer) handle(ctx context.Context, handler Handler, delivery amqp.Delivery) {
+	if err := handler(ctx, delivery); err != nil {
+		c.Log.Error("Error caused after handling RabbitMQ message",
+			zap.String("queue", c.queue),
+			zap.String("routing_key", delivery.RoutingKey),
+			zap.String("message_id", delivery.MessageId),
+			zap.Bool("redelivered", delivery.Redelivered),
+			zap.Error(err))
+		delivery.Nack(false, !delivery.Redelivered)
+		return
+	}
+	delivery.Ack(false)
+}
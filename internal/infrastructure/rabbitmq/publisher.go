@@ -0,0 +1,176 @@
+package rabbitmq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/streadway/amqp"
+	"go.uber.org/zap"
+)
+
+// confirmTimeout - сколько ждать подтверждения публикации от брокера
+const confirmTimeout = 5 * time.Second
+
+var ErrPublishNotConfirmed = errors.New("rabbitmq: publish was not confirmed by broker")
+
+// Publisher публикует JSON сообщения в очереди и exchange RabbitMQ.
+// Подключение устанавливается при первой публикации и пересоздается после ошибки,
+// поэтому сервис может стартовать раньше брокера. Канал работает в режиме publisher confirms:
+// Publish возвращает nil только после подтверждения брокера
+type Publisher struct {
+	url string
+	Log *zap.Logger
+
+	mu       sync.Mutex
+	conn     *amqp.Connection
+	ch       *amqp.Channel
+	confirms chan amqp.Confirmation
+	declared map[string]bool
+}
+
+// NewPublisher создает издателя; подключение к брокеру откладывается до первой публикации
+func NewPublisher(url string, Log *zap.Logger) *Publisher {
+	return &Publisher{url: url, Log: Log, declared: make(map[string]bool)}
+}
+
+// Publish объявляет устойчивую очередь queue (один раз) и отправляет в нее message в формате JSON
+func (p *Publisher) Publish(ctx context.Context, queue string, message any) error {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.connect(); err != nil {
+		return err
+	}
+	if !p.declared[queue] {
+		if _, err := p.ch.QueueDeclare(queue, true, false, false, false, nil); err != nil {
+			p.reset()
+			return err
+		}
+		p.declared[queue] = true
+	}
+
+	return p.publish(ctx, "", queue, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		Timestamp:    time.Now(),
+		Body:         body,
+	})
+}
+
+// PublishEvent объявляет устойчивый topic exchange (один раз) и отправляет в него готовое JSON тело
+// с routing key. messageID позволяет потребителям отбрасывать повторные доставки
+func (p *Publisher) PublishEvent(ctx context.Context, exchange, routingKey, messageID string, body []byte) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.connect(); err != nil {
+		return err
+	}
+	if key := "exchange:" + exchange; !p.declared[key] {
+		if err := p.ch.ExchangeDeclare(exchange, amqp.ExchangeTopic, true, false, false, false, nil); err != nil {
+			p.reset()
+			return err
+		}
+		p.declared[key] = true
+	}
+
+	return p.publish(ctx, exchange, routingKey, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		MessageId:    messageID,
+		Type:         routingKey,
+		Timestamp:    time.Now(),
+		Body:         body,
+	})
+}
+
+// publish отправляет сообщение и ждет подтверждения брокера. Вызывается под p.mu
+func (p *Publisher) publish(ctx context.Context, exchange, routingKey string, msg amqp.Publishing) error {
+	if err := p.ch.Publish(exchange, routingKey, false, false, msg); err != nil {
+		p.Log.Error("Failed to publish message to RabbitMQ",
+			zap.String("exchange", exchange), zap.String("routing_key", routingKey), zap.Error(err))
+		p.reset()
+		return err
+	}
+
+	timer := time.NewTimer(confirmTimeout)
+	defer timer.Stop()
+	select {
+	case confirm, ok := <-p.confirms:
+		if ok && confirm.Ack {
+			return nil
+		}
+	case <-ctx.Done():
+		// подтверждение может прийти позже и спутать следующую публикацию, поэтому канал пересоздается
+		p.reset()
+		return ctx.Err()
+	case <-timer.C:
+	}
+	p.Log.Error("RabbitMQ did not confirm published message",
+		zap.String("exchange", exchange), zap.String("routing_key", routingKey))
+	p.reset()
+	return ErrPublishNotConfirmed
+}
+
+func (p *Publisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conn == nil {
+		return nil
+	}
+	err := p.conn.Close()
+	p.conn, p.ch, p.confirms = nil, nil, nil
+	return err
+}
+
+func (p *Publisher) connect() error {
+	if p.conn != nil && !p.conn.IsClosed() && p.ch != nil {
+		return nil
+	}
+	p.reset()
+
+	conn, err := amqp.Dial(p.url)
+	if err != nil {
+		p.Log.Error("Failed connection to RabbitMQ", zap.Error(err))
+		return err
+	}
+	ch, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+		p.Log.Error("Failed to open RabbitMQ channel", zap.Error(err))
+		return err
+	}
+	if err := ch.Confirm(false); err != nil {
+		conn.Close()
+		p.Log.Error("Failed to enable RabbitMQ publisher confirms", zap.Error(err))
+		return err
+	}
+	p.conn, p.ch = conn, ch
+	p.confirms = ch.NotifyPublish(make(chan amqp.Confirmation, 1))
+	return nil
+}
+
+// reset закрывает текущее подключение; следующая публикация подключится заново
+func (p *Publisher) reset() {
+	if p.conn != nil {
+		p.conn.Close()
+	}
+	p.conn, p.ch, p.confirms = nil, nil, nil
+	p.declared = make(map[string]bool)
+}
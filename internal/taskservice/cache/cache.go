@@ -0,0 +1,250 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/taskservice/repository"
+	"github.com/streadway/amqp"
+	"go.uber.org/zap"
+)
+
+// Queue - очередь RabbitMQ с событиями, по которым сбрасывается кэш
+const Queue = "task_cache_invalidation"
+
+// EventTypes - события, после которых закэшированные задачи и списки устаревают
+var EventTypes = []string{entity.EventTaskCreated, entity.EventTaskUpdated, entity.EventSLAEscalated}
+
+const keyPrefix = "taskmanager:tasks:"
+
+// Операции для метрики task_cache_requests_total
+const (
+	operationGetTask   = "get_task"
+	operationListTasks = "list_tasks"
+)
+
+// Backend - хранилище кэша: LRU в памяти процесса или Redis
+type Backend interface {
+	// Get возвращает значение и false без ошибки, если ключа нет или срок его жизни истек
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// Repository - кэширующий декоратор TaskRepository для GetTaskByID и ListTasks. Остальные методы
+// обращаются к базе напрямую.
+//
+// Ключи записей содержат версию области: задачи для GetTaskByID и пользователя для ListTasks.
+// Сброс удаляет версию, и следующее чтение создает новую, поэтому старые записи становятся
+// недостижимыми и истекают по TTL. Версия читается до запроса в базу, так что результат,
+// прочитанный параллельно с записью, сохраняется под уже сброшенной версией и не попадет в ответы
+type Repository struct {
+	repository.TaskRepository
+	backend Backend
+	taskTTL time.Duration
+	listTTL time.Duration
+	Log     *zap.Logger
+}
+
+// NewRepository оборачивает repo кэшем; taskTTL - срок жизни задачи, listTTL - списка задач
+func NewRepository(repo repository.TaskRepository, backend Backend, taskTTL, listTTL time.Duration, Log *zap.Logger) *Repository {
+	return &Repository{
+		TaskRepository: repo,
+		backend:        backend,
+		taskTTL:        taskTTL,
+		listTTL:        listTTL,
+		Log:            Log,
+	}
+}
+
+func (r *Repository) GetTaskByID(ctx context.Context, taskId uuid.UUID) (entity.Task, error) {
+	var task entity.Task
+	key, hit := r.lookup(ctx, operationGetTask, taskScope(taskId.String()), r.taskTTL, "task", &task)
+	if hit {
+		return task, nil
+	}
+
+	task, err := r.TaskRepository.GetTaskByID(ctx, taskId)
+	if err != nil {
+		return task, err
+	}
+	r.store(ctx, key, task, r.taskTTL)
+	return task, nil
+}
+
+func (r *Repository) ListTasks(ctx context.Context, filter entity.TaskFilter) ([]entity.Task, error) {
+	var tasks []entity.Task
+	suffix, err := filterHash(filter)
+	if err != nil {
+		return nil, err
+	}
+	key, hit := r.lookup(ctx, operationListTasks, userScope(filter.UserID), r.listTTL, "list:"+suffix, &tasks)
+	if hit {
+		return tasks, nil
+	}
+
+	tasks, err = r.TaskRepository.ListTasks(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	r.store(ctx, key, tasks, r.listTTL)
+	return tasks, nil
+}
+
+func (r *Repository) CreateTask(ctx context.Context, task *entity.Task) error {
+	if err := r.TaskRepository.CreateTask(ctx, task); err != nil {
+		return err
+	}
+	r.invalidate(ctx, sourceWrite, userScope(task.User_id))
+	return nil
+}
+
+func (r *Repository) CreateTaskTree(ctx context.Context, parent *entity.Task, subtasks []*entity.Task, checklist []entity.ChecklistItem) error {
+	if err := r.TaskRepository.CreateTaskTree(ctx, parent, subtasks, checklist); err != nil {
+		return err
+	}
+	r.invalidate(ctx, sourceWrite, userScope(parent.User_id))
+	return nil
+}
+
+func (r *Repository) CloneTaskTree(ctx context.Context, copies []entity.TaskCopy) error {
+	if err := r.TaskRepository.CloneTaskTree(ctx, copies); err != nil {
+		return err
+	}
+	if len(copies) > 0 {
+		r.invalidate(ctx, sourceWrite, userScope(copies[0].Task.User_id))
+	}
+	return nil
+}
+
+func (r *Repository) UpdateTask(ctx context.Context, task *entity.Task) error {
+	if err := r.TaskRepository.UpdateTask(ctx, task); err != nil {
+		return err
+	}
+	r.invalidate(ctx, sourceWrite, taskScope(task.ID), userScope(task.User_id))
+	return nil
+}
+
+// Handle - обработчик для rabbitmq.Consumer. Сбрасывает кэш после изменений, сделанных другими
+// экземплярами сервиса или в обход TaskRepository, например проверкой SLA
+func (r *Repository) Handle(ctx context.Context, delivery amqp.Delivery) error {
+	var event struct {
+		TaskID string `json:"task_id"`
+		UserID string `json:"user_id"`
+	}
+	if err := json.Unmarshal(delivery.Body, &event); err != nil {
+		r.Log.Error("Failed to decode event for cache invalidation", zap.String("type", delivery.RoutingKey), zap.Error(err))
+		return nil
+	}
+
+	var scopes []string
+	if event.TaskID != "" {
+		scopes = append(scopes, taskScope(event.TaskID))
+	}
+	if event.UserID != "" {
+		scopes = append(scopes, userScope(event.UserID))
+	}
+	if len(scopes) == 0 {
+		return nil
+	}
+	CacheInvalidations.WithLabelValues(sourceEvent).Inc()
+	return r.backend.Delete(ctx, versionKeys(scopes)...)
+}
+
+// lookup возвращает ключ записи для текущей версии scope и декодирует найденное значение в dest.
+// Ошибки хранилища не прерывают запрос: пустой key означает, что результат не нужно сохранять
+func (r *Repository) lookup(ctx context.Context, operation, scope string, ttl time.Duration, name string, dest any) (string, bool) {
+	version, err := r.version(ctx, scope, ttl)
+	if err != nil {
+		r.Log.Warn("Error caused after reading task cache version", zap.String("scope", scope), zap.Error(err))
+		CacheRequests.WithLabelValues(operation, resultError).Inc()
+		return "", false
+	}
+	key := keyPrefix + scope + ":" + version + ":" + name
+
+	value, ok, err := r.backend.Get(ctx, key)
+	if err != nil {
+		r.Log.Warn("Error caused after reading task cache", zap.String("key", key), zap.Error(err))
+		CacheRequests.WithLabelValues(operation, resultError).Inc()
+		return "", false
+	}
+	if ok {
+		if err := json.Unmarshal(value, dest); err == nil {
+			CacheRequests.WithLabelValues(operation, resultHit).Inc()
+			return key, true
+		}
+		r.Log.Warn("Failed to decode cached value", zap.String("key", key), zap.Error(err))
+	}
+	CacheRequests.WithLabelValues(operation, resultMiss).Inc()
+	return key, false
+}
+
+func (r *Repository) store(ctx context.Context, key string, value any, ttl time.Duration) {
+	if key == "" {
+		return
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		r.Log.Warn("Failed to encode value for task cache", zap.String("key", key), zap.Error(err))
+		return
+	}
+	if err := r.backend.Set(ctx, key, data, ttl); err != nil {
+		r.Log.Warn("Error caused after writing task cache", zap.String("key", key), zap.Error(err))
+	}
+}
+
+// version возвращает текущую версию области и создает новую, если ее нет
+func (r *Repository) version(ctx context.Context, scope string, ttl time.Duration) (string, error) {
+	key := keyPrefix + "version:" + scope
+	value, ok, err := r.backend.Get(ctx, key)
+	if err != nil || ok {
+		return string(value), err
+	}
+
+	randomUUID, err := uuid.NewV4()
+	if err != nil {
+		return "", err
+	}
+	version := randomUUID.String()
+	return version, r.backend.Set(ctx, key, []byte(version), ttl)
+}
+
+// invalidate сбрасывает области после записи. Ошибка только логируется: запись в базу уже
+// выполнена, а устаревшие данные истекут по TTL
+func (r *Repository) invalidate(ctx context.Context, source string, scopes ...string) {
+	CacheInvalidations.WithLabelValues(source).Inc()
+	if err := r.backend.Delete(ctx, versionKeys(scopes)...); err != nil {
+		r.Log.Error("Error caused after invalidating task cache", zap.Strings("scopes", scopes), zap.Error(err))
+	}
+}
+
+func taskScope(taskId string) string {
+	return "task:" + taskId
+}
+
+func userScope(userId string) string {
+	return "user:" + userId
+}
+
+func versionKeys(scopes []string) []string {
+	keys := make([]string, len(scopes))
+	for i, scope := range scopes {
+		keys[i] = keyPrefix + "version:" + scope
+	}
+	return keys
+}
+
+// filterHash - короткий отпечаток фильтра для ключа списка
+func filterHash(filter entity.TaskFilter) (string, error) {
+	data, err := json.Marshal(filter)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:16]), nil
+}
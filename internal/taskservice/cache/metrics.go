@@ -0,0 +1,42 @@
+package cache
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// Результаты обращения к кэшу для метрики task_cache_requests_total
+const (
+	resultHit   = "hit"
+	resultMiss  = "miss"
+	resultError = "error"
+)
+
+// Источники сброса для метрики task_cache_invalidations_total
+const (
+	sourceWrite = "write"
+	sourceEvent = "event"
+)
+
+var (
+	CacheRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "task_cache_requests_total",
+			Help: "Task cache lookups by operation and result (hit, miss, error)",
+		},
+		[]string{"operation", "result"},
+	)
+
+	CacheInvalidations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "task_cache_invalidations_total",
+			Help: "Task cache invalidations by source (write, event)",
+		},
+		[]string{"source"},
+	)
+)
+
+// PrometheusInit регистрирует метрики кэша в реестре по умолчанию
+func PrometheusInit() {
+	prometheus.MustRegister(
+		CacheRequests,
+		CacheInvalidations,
+	)
+}
@@ -0,0 +1,223 @@
+package digest
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+const (
+	// batchSize - сколько получателей обрабатывается за один проход
+	batchSize = 100
+	// maxSectionTasks ограничивает число задач в каждом разделе письма
+	maxSectionTasks = 20
+	// recentWindow - задачи, созданные за это время, попадают в раздел "недавно назначенные"
+	recentWindow = 24 * time.Hour
+	// maxBatchedNotifications ограничивает число накопленных уведомлений в одном письме,
+	// остальные уходят со следующим дайджестом
+	maxBatchedNotifications = 50
+)
+
+// Repository - данные, которые нужны планировщику дайджестов
+type Repository interface {
+	ListDigestRecipients(ctx context.Context, now time.Time, limit int) ([]entity.DigestRecipient, error)
+	ListDigestTasks(ctx context.Context, userId string, dueBefore, createdSince time.Time) ([]entity.Task, error)
+	ListPendingEmailNotifications(ctx context.Context, userId string, limit int) ([]entity.DigestNotification, error)
+	MarkDigestSent(ctx context.Context, userId, date string, digest *entity.DailyDigest, sendAfter time.Time) (bool, error)
+}
+
+// Preferences - настройки уведомлений, которые проверяются перед публикацией дайджеста
+type Preferences interface {
+	GetNotificationRecipient(ctx context.Context, userId string) (*entity.NotificationRecipient, error)
+}
+
+// Scheduler периодически собирает дайджесты для пользователей, у которых в их часовом поясе
+// наступил час отправки, и публикует их через outbox. Пустой дайджест или дайджест с отключенными
+// напоминаниями не отправляется, но день отмечается, чтобы не собирать его повторно.
+// В тихие часы событие откладывается до их конца
+type Scheduler struct {
+	repo     Repository
+	prefs    Preferences
+	interval time.Duration
+	Log      *zap.Logger
+
+	now func() time.Time
+}
+
+func NewScheduler(repo Repository, prefs Preferences, interval time.Duration, Log *zap.Logger) *Scheduler {
+	return &Scheduler{repo: repo, prefs: prefs, interval: interval, Log: Log, now: time.Now}
+}
+
+// Run проверяет получателей каждые interval до отмены ctx
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		processed, err := s.RunOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			s.Log.Error("Error caused after calling digest scheduler's RunOnce", zap.Error(err))
+		}
+		if err == nil && processed == batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce обрабатывает одну пачку получателей и возвращает ее размер. Ошибка по одному
+// пользователю не останавливает остальных, возвращается последняя из них
+func (s *Scheduler) RunOnce(ctx context.Context) (int, error) {
+	now := s.now()
+	recipients, err := s.repo.ListDigestRecipients(ctx, now, batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var failed error
+	for _, recipient := range recipients {
+		if err := s.send(ctx, recipient, now); err != nil {
+			s.Log.Error("Error caused after building daily digest", zap.String("user_id", recipient.UserID), zap.Error(err))
+			failed = err
+		}
+	}
+	return len(recipients), failed
+}
+
+func (s *Scheduler) send(ctx context.Context, recipient entity.DigestRecipient, now time.Time) error {
+	loc, err := time.LoadLocation(recipient.Timezone)
+	if err != nil {
+		s.Log.Warn("Unknown user timezone, using UTC for digest", zap.String("user_id", recipient.UserID), zap.Error(err))
+		loc = time.UTC
+	}
+	_, endOfDay := dayBounds(now, loc)
+
+	tasks, err := s.repo.ListDigestTasks(ctx, recipient.UserID, endOfDay, now.Add(-recentWindow))
+	if err != nil {
+		return err
+	}
+
+	settings, err := s.prefs.GetNotificationRecipient(ctx, recipient.UserID)
+	if err != nil || settings == nil {
+		return err
+	}
+	batched, err := s.repo.ListPendingEmailNotifications(ctx, recipient.UserID, maxBatchedNotifications)
+	if err != nil {
+		return err
+	}
+
+	digest := Build(recipient, tasks, now, loc)
+	digest.Notifications = append(digest.Notifications, batched...)
+	digest.Channels = Channels(settings.Preferences, len(batched) > 0)
+
+	var event *entity.DailyDigest
+	if !IsEmpty(digest) && len(digest.Channels) > 0 {
+		event = digest
+	}
+	sendAfter, _ := settings.Preferences.QuietUntil(now)
+	sent, err := s.repo.MarkDigestSent(ctx, recipient.UserID, digest.Date, event, sendAfter)
+	if err != nil {
+		return err
+	}
+	if sent && event != nil {
+		s.Log.Info("Daily digest queued", zap.String("user_id", recipient.UserID), zap.String("date", digest.Date))
+	}
+	return nil
+}
+
+// Build раскладывает открытые задачи по разделам дайджеста на локальную дату пользователя в now:
+// просроченные, со сроком сегодня и созданные за последние сутки (без задач из первых двух разделов)
+func Build(recipient entity.DigestRecipient, tasks []entity.Task, now time.Time, loc *time.Location) *entity.DailyDigest {
+	startOfDay, endOfDay := dayBounds(now, loc)
+	digest := &entity.DailyDigest{
+		UserID:           recipient.UserID,
+		Email:            recipient.Email,
+		Username:         recipient.Username,
+		Timezone:         loc.String(),
+		Date:             startOfDay.Format(time.DateOnly),
+		DueToday:         []entity.DigestTask{},
+		Overdue:          []entity.DigestTask{},
+		RecentlyAssigned: []entity.DigestTask{},
+		Notifications:    []entity.DigestNotification{},
+		GeneratedAt:      now,
+	}
+
+	var overdue, dueToday, recent []entity.Task
+	for _, task := range tasks {
+		if task.Status == entity.StatusCompleted || task.Status == entity.StatusCancelled {
+			continue
+		}
+		switch {
+		case !task.DueDate.IsZero() && task.DueDate.Before(startOfDay):
+			overdue = append(overdue, task)
+		case !task.DueDate.IsZero() && task.DueDate.Before(endOfDay):
+			dueToday = append(dueToday, task)
+		case !task.CreatedAt.Before(now.Add(-recentWindow)):
+			recent = append(recent, task)
+		}
+	}
+
+	sort.SliceStable(overdue, func(i, j int) bool { return overdue[i].DueDate.Before(overdue[j].DueDate) })
+	sort.SliceStable(dueToday, func(i, j int) bool { return dueToday[i].DueDate.Before(dueToday[j].DueDate) })
+	sort.SliceStable(recent, func(i, j int) bool { return recent[i].CreatedAt.After(recent[j].CreatedAt) })
+
+	digest.Overdue = digestTasks(overdue, loc)
+	digest.DueToday = digestTasks(dueToday, loc)
+	digest.RecentlyAssigned = digestTasks(recent, loc)
+	return digest
+}
+
+// IsEmpty сообщает, что в дайджесте нет ни одной задачи и накопленного уведомления
+func IsEmpty(digest *entity.DailyDigest) bool {
+	return len(digest.DueToday) == 0 && len(digest.Overdue) == 0 && len(digest.RecentlyAssigned) == 0 &&
+		len(digest.Notifications) == 0
+}
+
+// Channels возвращает каналы дайджеста по настройкам напоминаний; накопленные письма
+// отправляются письмом, даже если письма-напоминания отключены
+func Channels(preferences entity.NotificationPreferences, hasBatched bool) []string {
+	channels := []string{}
+	for _, channel := range []string{entity.ChannelEmail, entity.ChannelInApp} {
+		if preferences.Allows(entity.NotificationReminder, channel) || (channel == entity.ChannelEmail && hasBatched) {
+			channels = append(channels, channel)
+		}
+	}
+	return channels
+}
+
+// dayBounds возвращает начало и конец локального дня loc, в который попадает now
+func dayBounds(now time.Time, loc *time.Location) (time.Time, time.Time) {
+	local := now.In(loc)
+	start := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	return start, start.AddDate(0, 0, 1)
+}
+
+func digestTasks(tasks []entity.Task, loc *time.Location) []entity.DigestTask {
+	if len(tasks) > maxSectionTasks {
+		tasks = tasks[:maxSectionTasks]
+	}
+	result := make([]entity.DigestTask, 0, len(tasks))
+	for _, task := range tasks {
+		item := entity.DigestTask{
+			ID:       task.ID,
+			Title:    task.Title,
+			Priority: task.Priority,
+			Status:   task.Status,
+			Project:  task.Project,
+		}
+		if !task.DueDate.IsZero() {
+			dueDate := task.DueDate.In(loc)
+			item.DueDate = &dueDate
+		}
+		result = append(result, item)
+	}
+	return result
+}
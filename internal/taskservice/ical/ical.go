@@ -0,0 +1,262 @@
+package ical
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidCalendar = errors.New("invalid iCalendar data")
+)
+
+const (
+	ComponentTodo  = "VTODO"
+	ComponentEvent = "VEVENT"
+)
+
+// Item - задача или событие из календаря (VTODO / VEVENT)
+type Item struct {
+	Component   string
+	UID         string
+	Summary     string
+	Description string
+	Categories  []string
+	// Priority по RFC 5545: 0 - не задан, 1 - наивысший, 9 - наинизший
+	Priority int
+	Due      time.Time
+	Start    time.Time
+}
+
+// Deadline возвращает срок выполнения: DUE для VTODO, DTSTART для VEVENT
+func (i Item) Deadline() time.Time {
+	if !i.Due.IsZero() {
+		return i.Due
+	}
+	return i.Start
+}
+
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Parse разбирает .ics файл и возвращает все компоненты VTODO и VEVENT
+func Parse(r io.Reader) ([]Item, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		items    []Item
+		current  *Item
+		depth    int
+		calendar bool
+	)
+	for _, line := range lines {
+		prop, err := parseLine(line)
+		if err != nil {
+			return nil, err
+		}
+
+		switch prop.name {
+		case "BEGIN":
+			value := strings.ToUpper(prop.value)
+			if value == "VCALENDAR" {
+				calendar = true
+				continue
+			}
+			if current != nil {
+				// вложенные компоненты (например VALARM) пропускаем
+				depth++
+				continue
+			}
+			if value == ComponentTodo || value == ComponentEvent {
+				current = &Item{Component: value}
+			}
+			continue
+		case "END":
+			if current == nil {
+				continue
+			}
+			if depth > 0 {
+				depth--
+				continue
+			}
+			if strings.ToUpper(prop.value) == current.Component {
+				items = append(items, *current)
+				current = nil
+			}
+			continue
+		}
+
+		if current == nil || depth > 0 {
+			continue
+		}
+		if err := current.apply(prop); err != nil {
+			return nil, err
+		}
+	}
+
+	if !calendar {
+		return nil, fmt.Errorf("%w: missing BEGIN:VCALENDAR", ErrInvalidCalendar)
+	}
+	if current != nil {
+		return nil, fmt.Errorf("%w: unterminated %s", ErrInvalidCalendar, current.Component)
+	}
+
+	return items, nil
+}
+
+func (i *Item) apply(prop property) error {
+	switch prop.name {
+	case "UID":
+		i.UID = prop.value
+	case "SUMMARY":
+		i.Summary = unescape(prop.value)
+	case "DESCRIPTION":
+		i.Description = unescape(prop.value)
+	case "CATEGORIES":
+		for _, category := range splitEscaped(prop.value) {
+			if category = strings.TrimSpace(unescape(category)); category != "" {
+				i.Categories = append(i.Categories, category)
+			}
+		}
+	case "PRIORITY":
+		priority, err := strconv.Atoi(strings.TrimSpace(prop.value))
+		if err != nil || priority < 0 || priority > 9 {
+			return fmt.Errorf("%w: bad PRIORITY %q", ErrInvalidCalendar, prop.value)
+		}
+		i.Priority = priority
+	case "DUE":
+		due, err := parseDateTime(prop)
+		if err != nil {
+			return err
+		}
+		i.Due = due
+	case "DTSTART":
+		start, err := parseDateTime(prop)
+		if err != nil {
+			return err
+		}
+		i.Start = start
+	}
+	return nil
+}
+
+// unfold склеивает строки, перенесенные по RFC 5545 (продолжение начинается с пробела или табуляции)
+func unfold(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+// parseLine разбирает строку вида NAME;PARAM=VALUE:VALUE
+func parseLine(line string) (property, error) {
+	colon := -1
+	quoted := false
+	for idx, r := range line {
+		if r == '"' {
+			quoted = !quoted
+		}
+		if r == ':' && !quoted {
+			colon = idx
+			break
+		}
+	}
+	if colon <= 0 {
+		return property{}, fmt.Errorf("%w: malformed line %q", ErrInvalidCalendar, line)
+	}
+
+	parts := strings.Split(line[:colon], ";")
+	prop := property{
+		name:   strings.ToUpper(parts[0]),
+		params: make(map[string]string, len(parts)-1),
+		value:  line[colon+1:],
+	}
+	for _, param := range parts[1:] {
+		key, value, ok := strings.Cut(param, "=")
+		if !ok {
+			continue
+		}
+		prop.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+	return prop, nil
+}
+
+// parseDateTime поддерживает форматы DATE, DATE-TIME в UTC, с TZID и "плавающее" время
+func parseDateTime(prop property) (time.Time, error) {
+	value := strings.TrimSpace(prop.value)
+
+	loc := time.UTC
+	if tzid, ok := prop.params["TZID"]; ok {
+		if tz, err := time.LoadLocation(tzid); err == nil {
+			loc = tz
+		}
+	}
+
+	layouts := []string{"20060102T150405Z", "20060102T150405", "20060102"}
+	for _, layout := range layouts {
+		if strings.HasSuffix(layout, "Z") {
+			if t, err := time.Parse(layout, value); err == nil {
+				return t, nil
+			}
+			continue
+		}
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: bad %s value %q", ErrInvalidCalendar, prop.name, value)
+}
+
+// splitEscaped делит значение по запятым, не учитывая экранированные "\,"
+func splitEscaped(value string) []string {
+	var (
+		parts []string
+		buf   bytes.Buffer
+	)
+	for idx := 0; idx < len(value); idx++ {
+		if value[idx] == '\\' && idx+1 < len(value) {
+			buf.WriteByte(value[idx])
+			buf.WriteByte(value[idx+1])
+			idx++
+			continue
+		}
+		if value[idx] == ',' {
+			parts = append(parts, buf.String())
+			buf.Reset()
+			continue
+		}
+		buf.WriteByte(value[idx])
+	}
+	return append(parts, buf.String())
+}
+
+func unescape(value string) string {
+	replacer := strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`)
+	return replacer.Replace(value)
+}
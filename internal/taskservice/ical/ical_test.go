@@ -0,0 +1,138 @@
+package ical
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testdata/tasks.ics - экспорт задач Thunderbird с переводами строк CRLF,
+// testdata/events.ics - экспорт Google Calendar с переводами строк LF
+
+func parseFile(t *testing.T, name string) []Item {
+	t.Helper()
+	file, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	items, err := Parse(file)
+	if err != nil {
+		t.Fatalf("Parse(%s): %v", name, err)
+	}
+	return items
+}
+
+func TestParseTasks(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("no time zone data: %v", err)
+	}
+
+	want := []Item{
+		{
+			Component: ComponentTodo,
+			UID:       "7f1c2a3e-1b2d-4c5e-9f00-6a7b8c9d0e1f",
+			Summary:   "Prepare quarterly report, draft 2",
+			// перенесенная строка склеена, SUMMARY и DESCRIPTION из VALARM пропущены
+			Description: "Collect numbers from:\n- sales\n- support\nSee the shared folder \"Q1; drafts\".",
+			Categories:  []string{"Finance", "Q1, 2030", "Reports"},
+			Priority:    1,
+			Due:         time.Date(2030, 1, 15, 17, 0, 0, 0, berlin),
+		},
+		{
+			Component: ComponentTodo,
+			UID:       "call-bank@example.com",
+			Summary:   "Call the bank",
+			Due:       time.Date(2030, 1, 20, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	items := parseFile(t, "testdata/tasks.ics")
+	if len(items) != len(want) {
+		t.Fatalf("got %d items, want %d: %+v", len(items), len(want), items)
+	}
+	for i := range want {
+		if !itemEqual(items[i], want[i]) {
+			t.Errorf("item %d =\n%+v\nwant\n%+v", i, items[i], want[i])
+		}
+	}
+	if due := items[0].Deadline().UTC(); !due.Equal(time.Date(2030, 1, 15, 16, 0, 0, 0, time.UTC)) {
+		t.Errorf("TZID deadline in UTC = %v", due)
+	}
+}
+
+func TestParseEvents(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("no time zone data: %v", err)
+	}
+
+	want := []Item{
+		{
+			Component:   ComponentEvent,
+			UID:         "4q2kv0b0m3l7hv0c2n1@google.com",
+			Summary:     "Sprint planning",
+			Description: `Agenda: backlog review\, estimates`,
+			Start:       time.Date(2030, 2, 4, 8, 30, 0, 0, time.UTC),
+		},
+		{
+			Component: ComponentEvent,
+			UID:       "offsite-20300214@google.com",
+			Summary:   "Team offsite",
+			Start:     time.Date(2030, 2, 14, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Component: ComponentEvent,
+			UID:       "standup-ny@google.com",
+			Summary:   "Standup; New York",
+			Start:     time.Date(2030, 3, 1, 9, 30, 0, 0, newYork),
+		},
+	}
+
+	items := parseFile(t, "testdata/events.ics")
+	if len(items) != len(want) {
+		t.Fatalf("got %d items, want %d: %+v", len(items), len(want), items)
+	}
+	for i := range want {
+		if !itemEqual(items[i], want[i]) {
+			t.Errorf("item %d =\n%+v\nwant\n%+v", i, items[i], want[i])
+		}
+		if !items[i].Deadline().Equal(want[i].Start) {
+			t.Errorf("item %d deadline = %v, want DTSTART %v", i, items[i].Deadline(), want[i].Start)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"no calendar", "BEGIN:VTODO\nSUMMARY:Lost\nEND:VTODO\n"},
+		{"unterminated todo", "BEGIN:VCALENDAR\nBEGIN:VTODO\nSUMMARY:Open\nEND:VCALENDAR\n"},
+		{"bad priority", "BEGIN:VCALENDAR\nBEGIN:VTODO\nPRIORITY:high\nEND:VTODO\nEND:VCALENDAR\n"},
+		{"bad due", "BEGIN:VCALENDAR\nBEGIN:VTODO\nDUE:next week\nEND:VTODO\nEND:VCALENDAR\n"},
+		{"malformed line", "BEGIN:VCALENDAR\nSUMMARY\nEND:VCALENDAR\n"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := Parse(strings.NewReader(tc.data)); !errors.Is(err, ErrInvalidCalendar) {
+				t.Errorf("Parse error = %v, want ErrInvalidCalendar", err)
+			}
+		})
+	}
+}
+
+// itemEqual сравнивает время через Equal: у значений с TZID своя *time.Location
+func itemEqual(got, want Item) bool {
+	if !got.Due.Equal(want.Due) || !got.Start.Equal(want.Start) {
+		return false
+	}
+	got.Due, got.Start, want.Due, want.Start = time.Time{}, time.Time{}, time.Time{}, time.Time{}
+	return reflect.DeepEqual(got, want)
+}
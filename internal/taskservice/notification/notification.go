@@ -0,0 +1,176 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/streadway/amqp"
+	"go.uber.org/zap"
+)
+
+// Queue - очередь RabbitMQ, из которой доменные события превращаются в уведомления
+const Queue = "task_notifications"
+
+// EventTypes - события, из которых создаются уведомления
+var EventTypes = []string{entity.EventTaskShared, entity.EventSLAEscalated, entity.EventDailyDigest}
+
+// Repository - хранилище, в которое Feed записывает уведомления
+type Repository interface {
+	CreateNotifications(ctx context.Context, notifications []entity.Notification) error
+}
+
+// Preferences - настройки уведомлений адресата; nil без ошибки - пользователь удален или неактивен
+type Preferences interface {
+	GetNotificationRecipient(ctx context.Context, userId string) (*entity.NotificationRecipient, error)
+}
+
+// TypeOf возвращает тип уведомления, к которому относится событие, или пустую строку. По нему
+// настройки каналов решают и судьбу вебхуков: task.created отключается вместе с вебхуками назначений
+func TypeOf(eventType string) string {
+	switch eventType {
+	case entity.EventTaskCreated, entity.EventTaskShared:
+		return entity.NotificationAssignment
+	case entity.EventSLAEscalated:
+		return entity.NotificationSLABreach
+	case entity.EventDailyDigest:
+		return entity.NotificationReminder
+	}
+	return ""
+}
+
+// Build превращает доменное событие в уведомления: выданный доступ к задаче - assignment получателю,
+// нарушение SLA - sla_breach, ежедневный дайджест с просроченными или сегодняшними задачами - reminder.
+// Собственная новая задача не уведомляет автора: assignment получает только другой пользователь.
+// Для остальных событий и угрозы SLA уведомления не создаются
+func Build(eventType, eventID string, body []byte) ([]entity.Notification, error) {
+	switch eventType {
+	case entity.EventTaskShared:
+		var event entity.TaskSharedEvent
+		if err := json.Unmarshal(body, &event); err != nil {
+			return nil, err
+		}
+		if event.GranteeID == "" || event.GranteeID == event.UserID {
+			return nil, nil
+		}
+		access := "view"
+		if event.Permission == entity.SharePermissionEdit {
+			access = "view and edit"
+		}
+		return []entity.Notification{{
+			UserID:    event.GranteeID,
+			Type:      entity.NotificationAssignment,
+			Title:     "Task shared with you: " + event.Title,
+			Body:      "You can " + access + " this task",
+			TaskID:    event.TaskID,
+			EventID:   eventID,
+			CreatedAt: event.OccurredAt,
+		}}, nil
+
+	case entity.EventSLAEscalated:
+		var escalation entity.SLAEscalation
+		if err := json.Unmarshal(body, &escalation); err != nil {
+			return nil, err
+		}
+		if escalation.SLAStatus != entity.SLAStatusBreached {
+			return nil, nil
+		}
+		return []entity.Notification{{
+			UserID:    escalation.UserID,
+			Type:      entity.NotificationSLABreach,
+			Title:     "SLA breached: " + escalation.Title,
+			Body:      fmt.Sprintf("The %s target was due at %s", escalation.Target, escalation.DueAt.UTC().Format("2006-01-02 15:04 MST")),
+			TaskID:    escalation.TaskID,
+			EventID:   eventID,
+			CreatedAt: escalation.DetectedAt,
+		}}, nil
+
+	case entity.EventDailyDigest:
+		var digest entity.DailyDigest
+		if err := json.Unmarshal(body, &digest); err != nil {
+			return nil, err
+		}
+		if len(digest.Overdue) == 0 && len(digest.DueToday) == 0 {
+			return nil, nil
+		}
+		return []entity.Notification{{
+			UserID:    digest.UserID,
+			Type:      entity.NotificationReminder,
+			Title:     "Tasks for " + digest.Date,
+			Body:      fmt.Sprintf("%d overdue, %d due today", len(digest.Overdue), len(digest.DueToday)),
+			EventID:   eventID,
+			CreatedAt: digest.GeneratedAt,
+		}}, nil
+	}
+	return nil, nil
+}
+
+// Route применяет настройки адресата к уведомлению: видимость в приложении, письмо сразу
+// (после тихих часов) или в ближайшем дайджесте. Письмом-напоминанием служит сам дайджест.
+// false - все каналы типа отключены и уведомление не сохраняется
+func Route(notification *entity.Notification, recipient *entity.NotificationRecipient, now time.Time) bool {
+	preferences := recipient.Preferences
+	notification.InApp = preferences.Allows(notification.Type, entity.ChannelInApp)
+	email := notification.Type != entity.NotificationReminder && preferences.Allows(notification.Type, entity.ChannelEmail)
+
+	switch {
+	case email && preferences.DigestBatching && recipient.DigestEnabled:
+		notification.EmailPending = true
+	case email:
+		sendAfter, _ := preferences.QuietUntil(now)
+		notification.Email = &entity.NotificationEmail{
+			UserID:    recipient.UserID,
+			Email:     recipient.Email,
+			Username:  recipient.Username,
+			Type:      notification.Type,
+			Title:     notification.Title,
+			Body:      notification.Body,
+			TaskID:    notification.TaskID,
+			CreatedAt: notification.CreatedAt,
+			SendAfter: sendAfter,
+		}
+	}
+	return notification.InApp || email
+}
+
+// Feed записывает уведомления из событий RabbitMQ с учетом настроек адресатов
+type Feed struct {
+	repo  Repository
+	prefs Preferences
+	Log   *zap.Logger
+
+	now func() time.Time
+}
+
+func NewFeed(repo Repository, prefs Preferences, Log *zap.Logger) *Feed {
+	return &Feed{repo: repo, prefs: prefs, Log: Log, now: time.Now}
+}
+
+// Handle - обработчик для rabbitmq.Consumer. Идентификатор события - message id из outbox,
+// поэтому повторное получение того же сообщения не создает дубликатов
+func (f *Feed) Handle(ctx context.Context, delivery amqp.Delivery) error {
+	if delivery.MessageId == "" {
+		f.Log.Warn("Skipping event without message id", zap.String("type", delivery.RoutingKey))
+		return nil
+	}
+
+	notifications, err := Build(delivery.RoutingKey, delivery.MessageId, delivery.Body)
+	if err != nil {
+		f.Log.Error("Failed to decode event for notifications", zap.String("type", delivery.RoutingKey), zap.Error(err))
+		return nil
+	}
+
+	routed := make([]entity.Notification, 0, len(notifications))
+	for _, notification := range notifications {
+		recipient, err := f.prefs.GetNotificationRecipient(ctx, notification.UserID)
+		if err != nil {
+			return err
+		}
+		if recipient != nil && Route(&notification, recipient, f.now()) {
+			routed = append(routed, notification)
+		}
+	}
+	return f.repo.CreateNotifications(ctx, routed)
+}
@@ -0,0 +1,84 @@
+package notification
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+)
+
+func TestRoute(t *testing.T) {
+	// 23:00 UTC - внутри тихих часов 22:00-07:00
+	now := time.Date(2030, 1, 2, 23, 0, 0, 0, time.UTC)
+	morning := time.Date(2030, 1, 3, 7, 0, 0, 0, time.UTC)
+
+	recipient := func(change func(*entity.NotificationRecipient)) *entity.NotificationRecipient {
+		r := &entity.NotificationRecipient{
+			UserID:      "user-1",
+			Email:       "user@example.com",
+			Username:    "user",
+			Preferences: entity.DefaultNotificationPreferences(),
+		}
+		if change != nil {
+			change(r)
+		}
+		return r
+	}
+	channels := func(notificationType string, list ...string) func(*entity.NotificationRecipient) {
+		return func(r *entity.NotificationRecipient) { r.Preferences.Channels[notificationType] = list }
+	}
+
+	tests := []struct {
+		name      string
+		kind      string
+		recipient *entity.NotificationRecipient
+		wantSaved bool
+		wantInApp bool
+		// wantEmail - письмо публикуется сразу, wantPending - ждет дайджеста
+		wantEmail   bool
+		wantPending bool
+		wantAfter   time.Time
+	}{
+		{name: "defaults", kind: entity.NotificationComment, recipient: recipient(nil),
+			wantSaved: true, wantInApp: true, wantEmail: true},
+		{name: "email off by default", kind: entity.NotificationAssignment, recipient: recipient(nil),
+			wantSaved: true, wantInApp: true},
+		{name: "reminder is mailed by the digest", kind: entity.NotificationReminder, recipient: recipient(nil),
+			wantSaved: true, wantInApp: true},
+		{name: "email only", kind: entity.NotificationComment, recipient: recipient(channels(entity.NotificationComment, entity.ChannelEmail)),
+			wantSaved: true, wantEmail: true},
+		{name: "all channels off", kind: entity.NotificationComment, recipient: recipient(channels(entity.NotificationComment)),
+			wantSaved: false},
+		{name: "reminder by email only is dropped", kind: entity.NotificationReminder, recipient: recipient(channels(entity.NotificationReminder, entity.ChannelEmail)),
+			wantSaved: false},
+		{name: "digest batching", kind: entity.NotificationComment, recipient: recipient(func(r *entity.NotificationRecipient) {
+			r.DigestEnabled = true
+			r.Preferences.DigestBatching = true
+		}), wantSaved: true, wantInApp: true, wantPending: true},
+		{name: "digest batching without digest", kind: entity.NotificationComment, recipient: recipient(func(r *entity.NotificationRecipient) {
+			r.Preferences.DigestBatching = true
+		}), wantSaved: true, wantInApp: true, wantEmail: true},
+		{name: "quiet hours", kind: entity.NotificationMention, recipient: recipient(func(r *entity.NotificationRecipient) {
+			r.Preferences.QuietHours.Enabled = true
+		}), wantSaved: true, wantInApp: true, wantEmail: true, wantAfter: morning},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			notification := entity.Notification{UserID: "user-1", Type: tc.kind, Title: "Task", TaskID: "task-1", CreatedAt: now}
+			saved := Route(&notification, tc.recipient, now)
+
+			if saved != tc.wantSaved || notification.InApp != tc.wantInApp || notification.EmailPending != tc.wantPending {
+				t.Errorf("Route = %v, in app %v, pending %v; want %v, %v, %v",
+					saved, notification.InApp, notification.EmailPending, tc.wantSaved, tc.wantInApp, tc.wantPending)
+			}
+			if (notification.Email != nil) != tc.wantEmail {
+				t.Fatalf("email = %+v, want email %v", notification.Email, tc.wantEmail)
+			}
+			if email := notification.Email; email != nil {
+				if email.Email != "user@example.com" || email.Type != tc.kind || email.TaskID != "task-1" || !email.SendAfter.Equal(tc.wantAfter) {
+					t.Errorf("email = %+v, want send after %v", email, tc.wantAfter)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,334 @@
+package quickadd
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+)
+
+var (
+	ErrEmptyTitle = errors.New("quick add text has no title")
+)
+
+// endOfDay - время по умолчанию, если указана дата без времени
+const (
+	endOfDayHour   = 23
+	endOfDayMinute = 59
+)
+
+// Result - распознанная из строки задача
+type Result struct {
+	Title    string
+	DueDate  time.Time
+	Tags     []string
+	Priority string
+}
+
+var (
+	timeRe     = regexp.MustCompile(`^(\d{1,2}):(\d{2})$`)
+	ampmRe     = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(am|pm)$`)
+	isoDateRe  = regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})$`)
+	dotDateRe  = regexp.MustCompile(`^(\d{1,2})\.(\d{1,2})(?:\.(\d{4}))?$`)
+	priorities = map[string]string{
+		"low":      entity.PriorityLow,
+		"normal":   entity.PriorityNormal,
+		"medium":   entity.PriorityNormal,
+		"high":     entity.PriorityHigh,
+		"critical": entity.PriorityCritical,
+		"urgent":   entity.PriorityCritical,
+	}
+	weekdays = map[string]time.Weekday{
+		"sunday": time.Sunday, "sun": time.Sunday,
+		"monday": time.Monday, "mon": time.Monday,
+		"tuesday": time.Tuesday, "tue": time.Tuesday,
+		"wednesday": time.Wednesday, "wed": time.Wednesday,
+		"thursday": time.Thursday, "thu": time.Thursday,
+		"friday": time.Friday, "fri": time.Friday,
+		"saturday": time.Saturday, "sat": time.Saturday,
+	}
+	connectors = map[string]bool{"at": true, "on": true, "by": true, "due": true}
+	// markers - предлоги, после которых слово вроде "sat" или "today" считается датой и внутри заголовка
+	markers = map[string]bool{"on": true, "by": true, "due": true}
+)
+
+// Parse разбирает строку вида "Call client tomorrow 17:00 #support !high".
+// Относительные даты считаются от now в часовом поясе loc
+func Parse(text string, now time.Time, loc *time.Location) (Result, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	now = now.In(loc)
+
+	var (
+		result  = Result{Priority: entity.PriorityNormal}
+		title   []string
+		date    time.Time
+		hasDate bool
+		hour    = -1
+		minute  int
+		exact   time.Time
+	)
+
+	tokens := strings.Fields(text)
+	tail := trailingMetaStart(tokens)
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		lower := strings.ToLower(strings.TrimRight(token, ",."))
+
+		if strings.HasPrefix(token, "#") && len(token) > 1 {
+			result.Tags = append(result.Tags, strings.ToLower(token[1:]))
+			continue
+		}
+		if strings.HasPrefix(lower, "!") {
+			if priority, ok := priorities[lower[1:]]; ok {
+				result.Priority = priority
+				continue
+			}
+		}
+
+		// предлог перед датой/временем не попадает в заголовок
+		if connectors[lower] && i+1 < len(tokens) {
+			next := strings.ToLower(strings.TrimRight(tokens[i+1], ",."))
+			if _, _, ok := parseTime(next); ok {
+				continue
+			}
+			if isDateWord(next) && (!isAmbiguousDateWord(next) || acceptsDateWord(tokens, i+1, tail)) {
+				continue
+			}
+		}
+
+		if h, m, ok := parseTime(lower); ok {
+			hour, minute = h, m
+			continue
+		}
+
+		// "today", "tonight" и дни недели встречаются в обычных заголовках ("Sun cream", "Today show"),
+		// поэтому датой они считаются только в конце строки или после предлога
+		if isAmbiguousDateWord(lower) && !acceptsDateWord(tokens, i, tail) {
+			title = append(title, token)
+			continue
+		}
+
+		switch lower {
+		case "today":
+			date, hasDate = now, true
+			continue
+		case "tonight":
+			date, hasDate = now, true
+			if hour < 0 {
+				hour, minute = 20, 0
+			}
+			continue
+		case "tomorrow":
+			date, hasDate = now.AddDate(0, 0, 1), true
+			continue
+		case "next":
+			if i+1 < len(tokens) {
+				next := strings.ToLower(tokens[i+1])
+				if weekday, ok := weekdays[next]; ok {
+					date, hasDate = nextWeekday(now, weekday, true), true
+					i++
+					continue
+				}
+				if next == "week" {
+					date, hasDate = nextWeekday(now, time.Monday, true), true
+					i++
+					continue
+				}
+				if next == "month" {
+					date, hasDate = time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, loc), true
+					i++
+					continue
+				}
+			}
+		case "in":
+			// "in 3 days", "in 2 hours"
+			if i+2 < len(tokens) {
+				if n, err := strconv.Atoi(tokens[i+1]); err == nil && n > 0 {
+					unit := strings.TrimSuffix(strings.ToLower(strings.TrimRight(tokens[i+2], ",.")), "s")
+					if offset, withTime, ok := addOffset(now, n, unit); ok {
+						if withTime {
+							exact = offset
+						} else {
+							date, hasDate = offset, true
+						}
+						i += 2
+						continue
+					}
+				}
+			}
+		}
+
+		if weekday, ok := weekdays[lower]; ok {
+			date, hasDate = nextWeekday(now, weekday, false), true
+			continue
+		}
+		if d, ok := parseDate(lower, now, loc); ok {
+			date, hasDate = d, true
+			continue
+		}
+
+		title = append(title, token)
+	}
+
+	result.Title = strings.Join(title, " ")
+	if result.Title == "" {
+		return result, ErrEmptyTitle
+	}
+
+	switch {
+	case !exact.IsZero():
+		result.DueDate = exact
+	case hasDate && hour >= 0:
+		result.DueDate = time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, loc)
+	case hasDate:
+		result.DueDate = time.Date(date.Year(), date.Month(), date.Day(), endOfDayHour, endOfDayMinute, 0, 0, loc)
+	case hour >= 0:
+		// только время: сегодня, а если уже прошло - завтра
+		due := time.Date(now.Year(), now.Month(), now.Day(), hour, minute, 0, 0, loc)
+		if !due.After(now) {
+			due = due.AddDate(0, 0, 1)
+		}
+		result.DueDate = due
+	}
+
+	return result, nil
+}
+
+func parseTime(token string) (int, int, bool) {
+	if m := timeRe.FindStringSubmatch(token); m != nil {
+		hour, _ := strconv.Atoi(m[1])
+		minute, _ := strconv.Atoi(m[2])
+		if hour > 23 || minute > 59 {
+			return 0, 0, false
+		}
+		return hour, minute, true
+	}
+	if m := ampmRe.FindStringSubmatch(token); m != nil {
+		hour, _ := strconv.Atoi(m[1])
+		minute := 0
+		if m[2] != "" {
+			minute, _ = strconv.Atoi(m[2])
+		}
+		if hour < 1 || hour > 12 || minute > 59 {
+			return 0, 0, false
+		}
+		if m[3] == "pm" && hour != 12 {
+			hour += 12
+		}
+		if m[3] == "am" && hour == 12 {
+			hour = 0
+		}
+		return hour, minute, true
+	}
+	return 0, 0, false
+}
+
+func parseDate(token string, now time.Time, loc *time.Location) (time.Time, bool) {
+	if m := isoDateRe.FindStringSubmatch(token); m != nil {
+		t, err := time.ParseInLocation("2006-01-02", token, loc)
+		return t, err == nil
+	}
+	if m := dotDateRe.FindStringSubmatch(token); m != nil {
+		day, _ := strconv.Atoi(m[1])
+		month, _ := strconv.Atoi(m[2])
+		year := now.Year()
+		if m[3] != "" {
+			year, _ = strconv.Atoi(m[3])
+		}
+		if month < 1 || month > 12 || day < 1 || day > 31 {
+			return time.Time{}, false
+		}
+		t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+		if t.Day() != day {
+			return time.Time{}, false
+		}
+		// "15.01" без года - ближайшая будущая дата
+		if m[3] == "" && t.Before(startOfDay(now)) {
+			t = t.AddDate(1, 0, 0)
+		}
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+func isDateWord(token string) bool {
+	switch token {
+	case "today", "tonight", "tomorrow", "next", "in":
+		return true
+	}
+	if _, ok := weekdays[token]; ok {
+		return true
+	}
+	return isoDateRe.MatchString(token) || dotDateRe.MatchString(token)
+}
+
+func isAmbiguousDateWord(token string) bool {
+	if token == "today" || token == "tonight" {
+		return true
+	}
+	_, ok := weekdays[token]
+	return ok
+}
+
+// acceptsDateWord сообщает, считать ли датой неоднозначное слово tokens[i]
+func acceptsDateWord(tokens []string, i, tail int) bool {
+	if i >= tail {
+		return true
+	}
+	return i > 0 && markers[strings.ToLower(tokens[i-1])]
+}
+
+// trailingMetaStart возвращает начало хвоста строки, где остались только дата, время, теги и приоритет
+func trailingMetaStart(tokens []string) int {
+	tail := len(tokens)
+	for tail > 0 {
+		token := tokens[tail-1]
+		lower := strings.ToLower(strings.TrimRight(token, ",."))
+		_, _, isTime := parseTime(lower)
+		_, isPriority := priorities[strings.TrimPrefix(lower, "!")]
+		meta := isTime || isDateWord(lower) || connectors[lower] ||
+			lower == "week" || lower == "month" ||
+			(strings.HasPrefix(token, "#") && len(token) > 1) ||
+			(strings.HasPrefix(lower, "!") && isPriority)
+		if !meta {
+			break
+		}
+		tail--
+	}
+	return tail
+}
+
+// nextWeekday возвращает ближайший день недели; strict - не считая сегодняшний
+func nextWeekday(now time.Time, weekday time.Weekday, strict bool) time.Time {
+	days := (int(weekday) - int(now.Weekday()) + 7) % 7
+	if days == 0 && strict {
+		days = 7
+	}
+	return now.AddDate(0, 0, days)
+}
+
+// addOffset сдвигает now на n единиц; withTime - сдвиг задает точное время, а не только дату
+func addOffset(now time.Time, n int, unit string) (t time.Time, withTime bool, ok bool) {
+	switch unit {
+	case "minute", "min":
+		return now.Add(time.Duration(n) * time.Minute), true, true
+	case "hour", "hr":
+		return now.Add(time.Duration(n) * time.Hour), true, true
+	case "day":
+		return now.AddDate(0, 0, n), false, true
+	case "week":
+		return now.AddDate(0, 0, 7*n), false, true
+	case "month":
+		return now.AddDate(0, n, 0), false, true
+	}
+	return time.Time{}, false, false
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,95 @@
+package quickadd
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+)
+
+func TestParse(t *testing.T) {
+	// среда, 2 января 2030
+	now := time.Date(2030, 1, 2, 10, 0, 0, 0, time.UTC)
+	at := func(day time.Month, date, hour, minute int) time.Time {
+		return time.Date(2030, day, date, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		text     string
+		title    string
+		due      time.Time
+		tags     []string
+		priority string
+	}{
+		{text: "Call client tomorrow 17:00 #support !high", title: "Call client", due: at(1, 3, 17, 0), tags: []string{"support"}, priority: entity.PriorityHigh},
+		{text: "Write report", title: "Write report"},
+		{text: "Write report today", title: "Write report", due: at(1, 2, 23, 59)},
+		{text: "Party tonight", title: "Party", due: at(1, 2, 20, 0)},
+		{text: "Party tonight at 21:30", title: "Party", due: at(1, 2, 21, 30)},
+		{text: "Review PR fri 15:00 #dev", title: "Review PR", due: at(1, 4, 15, 0), tags: []string{"dev"}},
+		{text: "Review PR wed", title: "Review PR", due: at(1, 2, 23, 59)},
+		{text: "Plan sprint next wed", title: "Plan sprint", due: at(1, 9, 23, 59)},
+		{text: "Plan sprint next week", title: "Plan sprint", due: at(1, 7, 23, 59)},
+		{text: "Pay rent next month", title: "Pay rent", due: at(2, 1, 23, 59)},
+		{text: "Check logs in 2 hours", title: "Check logs", due: now.Add(2 * time.Hour)},
+		{text: "Renew domain in 3 days", title: "Renew domain", due: at(1, 5, 23, 59)},
+		{text: "Standup 9am", title: "Standup", due: at(1, 3, 9, 0)},
+		{text: "Lunch 12:30pm", title: "Lunch", due: at(1, 2, 12, 30)},
+		{text: "Lunch at 1pm", title: "Lunch", due: at(1, 2, 13, 0)},
+		{text: "Send invoice 2030-03-15", title: "Send invoice", due: at(3, 15, 23, 59)},
+		{text: "Send invoice 01.01", title: "Send invoice", due: time.Date(2031, 1, 1, 23, 59, 0, 0, time.UTC)},
+		{text: "Send invoice by 20.01.2030 !urgent", title: "Send invoice", due: at(1, 20, 23, 59), priority: entity.PriorityCritical},
+
+		// дни недели и today/tonight внутри заголовка остаются словами заголовка
+		{text: "Buy sun cream", title: "Buy sun cream"},
+		{text: "Sun cream tomorrow", title: "Sun cream", due: at(1, 3, 23, 59)},
+		{text: "Watch the Today show", title: "Watch the Today show"},
+		{text: "Tonight show tickets", title: "Tonight show tickets"},
+		{text: "Sat exam prep", title: "Sat exam prep"},
+		{text: "Mon Cheri chocolate fri", title: "Mon Cheri chocolate", due: at(1, 4, 23, 59)},
+		{text: "Meet at sat office", title: "Meet at sat office"},
+		{text: "Meeting on thu about budget", title: "Meeting about budget", due: at(1, 3, 23, 59)},
+		{text: "Finish draft by today, then relax", title: "Finish draft then relax", due: at(1, 2, 23, 59)},
+		{text: "Ship release due sat #release", title: "Ship release", due: at(1, 5, 23, 59), tags: []string{"release"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.text, func(t *testing.T) {
+			got, err := Parse(tc.text, now, time.UTC)
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			priority := tc.priority
+			if priority == "" {
+				priority = entity.PriorityNormal
+			}
+			if got.Title != tc.title || !got.DueDate.Equal(tc.due) || got.Priority != priority || !reflect.DeepEqual(got.Tags, tc.tags) {
+				t.Errorf("Parse(%q) = %q due %v tags %v priority %s; want %q due %v tags %v priority %s",
+					tc.text, got.Title, got.DueDate, got.Tags, got.Priority, tc.title, tc.due, tc.tags, priority)
+			}
+		})
+	}
+}
+
+func TestParseLocation(t *testing.T) {
+	loc := time.FixedZone("UTC+3", 3*60*60)
+	// в UTC еще 1 января, в UTC+3 уже 2 января
+	now := time.Date(2030, 1, 1, 22, 0, 0, 0, time.UTC)
+
+	got, err := Parse("Call bank tomorrow 10:00", now, loc)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if want := time.Date(2030, 1, 3, 10, 0, 0, 0, loc); !got.DueDate.Equal(want) {
+		t.Errorf("due = %v, want %v", got.DueDate, want)
+	}
+}
+
+func TestParseEmptyTitle(t *testing.T) {
+	for _, text := range []string{"", "tomorrow 10:00", "#work !high", "on fri"} {
+		if _, err := Parse(text, time.Now(), time.UTC); !errors.Is(err, ErrEmptyTitle) {
+			t.Errorf("Parse(%q) error = %v, want ErrEmptyTitle", text, err)
+		}
+	}
+}
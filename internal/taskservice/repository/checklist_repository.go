@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/lib/pq"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrChecklistItemNotFound = errors.New("checklist item not found")
+)
+
+type ChecklistRepository interface {
+	// ListItems возвращает пункты чек-листа задачи по порядку
+	ListItems(ctx context.Context, taskId string) ([]entity.ChecklistItem, error)
+	GetItem(ctx context.Context, taskId, itemId string) (*entity.ChecklistItem, error)
+	// GetProgress возвращает прогресс чек-листов по id задачи; задач без пунктов в ответе нет
+	GetProgress(ctx context.Context, taskIds []string) (map[string]entity.ChecklistProgress, error)
+	// AddItem добавляет пункт в конец чек-листа
+	AddItem(ctx context.Context, item *entity.ChecklistItem) error
+	ToggleItem(ctx context.Context, taskId, itemId string) error
+	// ReorderItems расставляет пункты в порядке itemIds, который должен содержать все пункты задачи
+	ReorderItems(ctx context.Context, taskId string, itemIds []string) error
+	DeleteItem(ctx context.Context, taskId, itemId string) error
+}
+
+type checklistRepository struct {
+	db  *sql.DB
+	Log *zap.Logger
+}
+
+// NewChecklistRepository создает репозиторий чек-листов задач
+func NewChecklistRepository(db *sql.DB, Log *zap.Logger) ChecklistRepository {
+	return &checklistRepository{db: db, Log: Log}
+}
+
+func (r *checklistRepository) ListItems(ctx context.Context, taskId string) ([]entity.ChecklistItem, error) {
+	query := `
+		SELECT id, task_id, text, done, position, created_at, updated_at
+		FROM task_checklist_items
+		WHERE task_id = $1
+		ORDER BY position, created_at, id
+	`
+	rows, err := r.db.QueryContext(ctx, query, taskId)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's ListItems checklist", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []entity.ChecklistItem
+	for rows.Next() {
+		item, err := scanChecklistItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, *item)
+	}
+	return items, rows.Err()
+}
+
+func (r *checklistRepository) GetItem(ctx context.Context, taskId, itemId string) (*entity.ChecklistItem, error) {
+	query := `
+		SELECT id, task_id, text, done, position, created_at, updated_at
+		FROM task_checklist_items
+		WHERE id = $1 AND task_id = $2
+	`
+	item, err := scanChecklistItem(r.db.QueryRowContext(ctx, query, itemId, taskId))
+	if err == sql.ErrNoRows {
+		return nil, ErrChecklistItemNotFound
+	}
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's GetItem checklist", zap.Error(err))
+		return nil, err
+	}
+	return item, nil
+}
+
+func (r *checklistRepository) GetProgress(ctx context.Context, taskIds []string) (map[string]entity.ChecklistProgress, error) {
+	progress := make(map[string]entity.ChecklistProgress)
+	if len(taskIds) == 0 {
+		return progress, nil
+	}
+
+	query := `
+		SELECT task_id, COUNT(*) FILTER (WHERE done), COUNT(*)
+		FROM task_checklist_items
+		WHERE task_id = ANY($1::uuid[])
+		GROUP BY task_id
+	`
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(taskIds))
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's GetProgress checklist", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			taskId string
+			p      entity.ChecklistProgress
+		)
+		if err := rows.Scan(&taskId, &p.Done, &p.Total); err != nil {
+			return nil, err
+		}
+		progress[taskId] = p
+	}
+	return progress, rows.Err()
+}
+
+func (r *checklistRepository) AddItem(ctx context.Context, item *entity.ChecklistItem) error {
+	query := `
+		INSERT INTO task_checklist_items (id, task_id, text, done, position, created_at, updated_at)
+		VALUES ($1, $2, $3, $4,
+			(SELECT COALESCE(MAX(position) + 1, 0) FROM task_checklist_items WHERE task_id = $2),
+			$5, $5)
+		RETURNING position
+	`
+	randomUUID, err := uuid.NewV4()
+	if err != nil {
+		r.Log.Error("Failed generate random UUID", zap.Error(err))
+		return err
+	}
+	item.ID = randomUUID.String()
+	item.CreatedAt = time.Now()
+	item.UpdatedAt = item.CreatedAt
+
+	err = r.db.QueryRowContext(ctx, query, item.ID, item.TaskID, item.Text, item.Done, item.CreatedAt).Scan(&item.Position)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's AddItem checklist", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *checklistRepository) ToggleItem(ctx context.Context, taskId, itemId string) error {
+	query := `
+		UPDATE task_checklist_items
+		SET done = NOT done, updated_at = NOW()
+		WHERE id = $1 AND task_id = $2
+	`
+	result, err := r.db.ExecContext(ctx, query, itemId, taskId)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's ToggleItem checklist", zap.Error(err))
+		return err
+	}
+	return expectAffected(result, ErrChecklistItemNotFound)
+}
+
+func (r *checklistRepository) ReorderItems(ctx context.Context, taskId string, itemIds []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE task_checklist_items SET position = $1, updated_at = NOW() WHERE id = $2 AND task_id = $3`
+	for position, itemId := range itemIds {
+		result, err := tx.ExecContext(ctx, query, position, itemId, taskId)
+		if err != nil {
+			r.Log.Error("SQL error caused in repo's ReorderItems checklist", zap.Error(err))
+			return err
+		}
+		if err := expectAffected(result, ErrChecklistItemNotFound); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *checklistRepository) DeleteItem(ctx context.Context, taskId, itemId string) error {
+	query := `DELETE FROM task_checklist_items WHERE id = $1 AND task_id = $2`
+	result, err := r.db.ExecContext(ctx, query, itemId, taskId)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's DeleteItem checklist", zap.Error(err))
+		return err
+	}
+	return expectAffected(result, ErrChecklistItemNotFound)
+}
+
+// insertChecklistItem вставляет пункт с заданной позицией внутри транзакции копирования задачи
+func insertChecklistItem(ctx context.Context, tx *sql.Tx, item *entity.ChecklistItem) error {
+	query := `
+		INSERT INTO task_checklist_items (id, task_id, text, done, position, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $6)
+	`
+	randomUUID, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	item.ID = randomUUID.String()
+	item.CreatedAt = time.Now()
+	item.UpdatedAt = item.CreatedAt
+
+	_, err = tx.ExecContext(ctx, query, item.ID, item.TaskID, item.Text, item.Done, item.Position, item.CreatedAt)
+	return err
+}
+
+func scanChecklistItem(row rowScanner) (*entity.ChecklistItem, error) {
+	var item entity.ChecklistItem
+	err := row.Scan(&item.ID, &item.TaskID, &item.Text, &item.Done, &item.Position, &item.CreatedAt, &item.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
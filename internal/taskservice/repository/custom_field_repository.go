@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/lib/pq"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrCustomFieldNotFound = errors.New("custom field not found")
+	ErrCustomFieldExists   = errors.New("custom field with this key already exists")
+)
+
+type CustomFieldRepository interface {
+	Create(ctx context.Context, field *entity.CustomFieldDefinition) error
+	GetByID(ctx context.Context, fieldId, userId string) (*entity.CustomFieldDefinition, error)
+	// ListByUser возвращает определения пользователя; с непустым project - только общие и этого проекта
+	ListByUser(ctx context.Context, userId, project string) ([]entity.CustomFieldDefinition, error)
+	Update(ctx context.Context, field *entity.CustomFieldDefinition) error
+	Delete(ctx context.Context, fieldId, userId string) error
+}
+
+const customFieldColumns = `id, user_id, project, key, name, type, options, required, created_at, updated_at`
+
+type customFieldRepository struct {
+	db  *sql.DB
+	Log *zap.Logger
+}
+
+// NewCustomFieldRepository создает репозиторий определений пользовательских полей
+func NewCustomFieldRepository(db *sql.DB, Log *zap.Logger) CustomFieldRepository {
+	return &customFieldRepository{db: db, Log: Log}
+}
+
+func (r *customFieldRepository) Create(ctx context.Context, field *entity.CustomFieldDefinition) error {
+	query := `
+		INSERT INTO custom_field_definitions (` + customFieldColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+	randomUUID, err := uuid.NewV4()
+	if err != nil {
+		r.Log.Error("Failed generate random UUID", zap.Error(err))
+		return err
+	}
+	field.ID = randomUUID.String()
+	field.CreatedAt = time.Now()
+	field.UpdatedAt = field.CreatedAt
+
+	_, err = r.db.ExecContext(ctx, query,
+		field.ID,
+		field.UserID,
+		field.Project,
+		field.Key,
+		field.Name,
+		field.Type,
+		pq.Array(field.Options),
+		field.Required,
+		field.CreatedAt,
+		field.UpdatedAt,
+	)
+	if isUniqueViolation(err) {
+		return ErrCustomFieldExists
+	}
+	return err
+}
+
+func (r *customFieldRepository) GetByID(ctx context.Context, fieldId, userId string) (*entity.CustomFieldDefinition, error) {
+	query := `SELECT ` + customFieldColumns + ` FROM custom_field_definitions WHERE id = $1 AND user_id = $2`
+	field, err := scanCustomField(r.db.QueryRowContext(ctx, query, fieldId, userId))
+	if err == sql.ErrNoRows {
+		return nil, ErrCustomFieldNotFound
+	}
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's GetByID custom field", zap.Error(err))
+		return nil, err
+	}
+	return field, nil
+}
+
+func (r *customFieldRepository) ListByUser(ctx context.Context, userId, project string) ([]entity.CustomFieldDefinition, error) {
+	query := `
+		SELECT ` + customFieldColumns + `
+		FROM custom_field_definitions
+		WHERE user_id = $1 AND ($2 = '' OR project IN ('', $2))
+		ORDER BY project, key
+	`
+	rows, err := r.db.QueryContext(ctx, query, userId, project)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's ListByUser custom fields", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var fields []entity.CustomFieldDefinition
+	for rows.Next() {
+		field, err := scanCustomField(rows)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, *field)
+	}
+	return fields, rows.Err()
+}
+
+// Update меняет название, варианты и обязательность поля; ключ, тип и проект не меняются,
+// чтобы не ломать уже сохраненные значения
+func (r *customFieldRepository) Update(ctx context.Context, field *entity.CustomFieldDefinition) error {
+	query := `
+		UPDATE custom_field_definitions
+		SET name = $3, options = $4, required = $5, updated_at = $6
+		WHERE id = $1 AND user_id = $2
+	`
+	field.UpdatedAt = time.Now()
+	result, err := r.db.ExecContext(ctx, query, field.ID, field.UserID, field.Name, pq.Array(field.Options), field.Required, field.UpdatedAt)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's Update custom field", zap.Error(err))
+		return err
+	}
+	return expectAffected(result, ErrCustomFieldNotFound)
+}
+
+func (r *customFieldRepository) Delete(ctx context.Context, fieldId, userId string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM custom_field_definitions WHERE id = $1 AND user_id = $2`, fieldId, userId)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's Delete custom field", zap.Error(err))
+		return err
+	}
+	return expectAffected(result, ErrCustomFieldNotFound)
+}
+
+func scanCustomField(row rowScanner) (*entity.CustomFieldDefinition, error) {
+	var field entity.CustomFieldDefinition
+	err := row.Scan(
+		&field.ID,
+		&field.UserID,
+		&field.Project,
+		&field.Key,
+		&field.Name,
+		&field.Type,
+		pq.Array(&field.Options),
+		&field.Required,
+		&field.CreatedAt,
+		&field.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &field, nil
+}
@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/infrastructure/outbox"
+	"go.uber.org/zap"
+)
+
+type DigestRepository interface {
+	// ListDigestRecipients возвращает пользователей, у которых в их часовом поясе наступил час дайджеста,
+	// а дайджест за текущую локальную дату еще не отправлен
+	ListDigestRecipients(ctx context.Context, now time.Time, limit int) ([]entity.DigestRecipient, error)
+	// ListDigestTasks возвращает открытые задачи со сроком до dueBefore или созданные после createdSince
+	ListDigestTasks(ctx context.Context, userId string, dueBefore, createdSince time.Time) ([]entity.Task, error)
+	// ListPendingEmailNotifications возвращает уведомления, письма о которых ждут дайджеста, старые первыми
+	ListPendingEmailNotifications(ctx context.Context, userId string, limit int) ([]entity.DigestNotification, error)
+	// MarkDigestSent отмечает дайджест за локальную дату date отправленным и, если digest не nil,
+	// пишет событие digest.daily в outbox той же транзакцией (не раньше sendAfter) и снимает ожидание
+	// с вошедших в него уведомлений. false - дайджест за эту дату уже отмечен
+	MarkDigestSent(ctx context.Context, userId, date string, digest *entity.DailyDigest, sendAfter time.Time) (bool, error)
+}
+
+type digestRepository struct {
+	db  *sql.DB
+	Log *zap.Logger
+}
+
+// NewDigestRepository создает репозиторий ежедневных дайджестов
+func NewDigestRepository(db *sql.DB, Log *zap.Logger) DigestRepository {
+	return &digestRepository{db: db, Log: Log}
+}
+
+func (r *digestRepository) ListDigestRecipients(ctx context.Context, now time.Time, limit int) ([]entity.DigestRecipient, error) {
+	query := `
+		SELECT id, email, username, timezone
+		FROM users
+		WHERE active AND digest_enabled
+		  AND EXTRACT(HOUR FROM $1::timestamptz AT TIME ZONE timezone) >= digest_hour
+		  AND (digest_sent_on IS NULL OR digest_sent_on < ($1::timestamptz AT TIME ZONE timezone)::date)
+		ORDER BY id
+		LIMIT $2
+	`
+	rows, err := r.db.QueryContext(ctx, query, now, limit)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's ListDigestRecipients", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var recipients []entity.DigestRecipient
+	for rows.Next() {
+		var recipient entity.DigestRecipient
+		if err := rows.Scan(&recipient.UserID, &recipient.Email, &recipient.Username, &recipient.Timezone); err != nil {
+			return nil, err
+		}
+		recipients = append(recipients, recipient)
+	}
+	return recipients, rows.Err()
+}
+
+func (r *digestRepository) ListDigestTasks(ctx context.Context, userId string, dueBefore, createdSince time.Time) ([]entity.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE user_id = $1
+		  AND status NOT IN ($4, $5)
+		  AND ((due_date IS NOT NULL AND due_date < $2) OR created_at >= $3)
+		ORDER BY due_date NULLS LAST, created_at DESC
+	`
+	rows, err := r.db.QueryContext(ctx, query, userId, dueBefore, createdSince, entity.StatusCompleted, entity.StatusCancelled)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's ListDigestTasks", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tasks []entity.Task
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, rows.Err()
+}
+
+func (r *digestRepository) ListPendingEmailNotifications(ctx context.Context, userId string, limit int) ([]entity.DigestNotification, error) {
+	query := `
+		SELECT id, type, title, body, task_id, created_at
+		FROM notifications
+		WHERE user_id = $1 AND email_pending
+		ORDER BY created_at, id
+		LIMIT $2
+	`
+	rows, err := r.db.QueryContext(ctx, query, userId, limit)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's ListPendingEmailNotifications", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []entity.DigestNotification
+	for rows.Next() {
+		var (
+			notification entity.DigestNotification
+			taskID       sql.NullString
+		)
+		err := rows.Scan(&notification.ID, &notification.Type, &notification.Title, &notification.Body, &taskID, &notification.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		notification.TaskID = taskID.String
+		notifications = append(notifications, notification)
+	}
+	return notifications, rows.Err()
+}
+
+func (r *digestRepository) MarkDigestSent(ctx context.Context, userId, date string, digest *entity.DailyDigest, sendAfter time.Time) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE users SET digest_sent_on = $2::date
+		WHERE id = $1 AND (digest_sent_on IS NULL OR digest_sent_on < $2::date)
+	`, userId, date)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's MarkDigestSent", zap.Error(err))
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if affected == 0 {
+		return false, nil
+	}
+
+	if digest != nil {
+		if err := outbox.WriteAt(ctx, tx, entity.EventDailyDigest, userId, digest, sendAfter); err != nil {
+			r.Log.Error("SQL error caused in repo's MarkDigestSent while writing outbox event", zap.Error(err))
+			return false, err
+		}
+
+		ids := make([]string, 0, len(digest.Notifications))
+		for _, notification := range digest.Notifications {
+			ids = append(ids, notification.ID)
+		}
+		if len(ids) > 0 {
+			_, err := tx.ExecContext(ctx,
+				`UPDATE notifications SET email_pending = FALSE WHERE user_id = $1 AND id = ANY($2::uuid[])`,
+				userId, pq.Array(ids),
+			)
+			if err != nil {
+				r.Log.Error("SQL error caused in repo's MarkDigestSent while clearing pending emails", zap.Error(err))
+				return false, err
+			}
+		}
+	}
+	return true, tx.Commit()
+}
@@ -0,0 +1,620 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/taskservice/sla"
+)
+
+// memoryTaskRepository - потокобезопасная реализация TaskRepository в памяти для тестов и локальной разработки.
+// Повторяет поведение Postgres: те же ошибки, фильтры и порядок сортировки, время хранится с точностью
+// до микросекунды. Доменные события в outbox не пишутся; чек-листы хранятся только те, что создаются
+// вместе с задачами, и читаются через ListItems
+type memoryTaskRepository struct {
+	mu         sync.RWMutex
+	tasks      map[string]entity.Task
+	history    []entity.StatusChange
+	checklists map[string][]entity.ChecklistItem
+}
+
+// NewMemoryTaskRepository создает пустой репозиторий задач в памяти
+func NewMemoryTaskRepository() TaskRepository {
+	return &memoryTaskRepository{tasks: make(map[string]entity.Task), checklists: make(map[string][]entity.ChecklistItem)}
+}
+
+func (r *memoryTaskRepository) CreateTask(ctx context.Context, task *entity.Task) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.insertTask(task)
+}
+
+func (r *memoryTaskRepository) CreateTaskTree(ctx context.Context, parent *entity.Task, subtasks []*entity.Task, checklist []entity.ChecklistItem) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.insertTask(parent); err != nil {
+		return err
+	}
+	if err := r.insertChecklist(parent.ID, checklist); err != nil {
+		return err
+	}
+	for _, subtask := range subtasks {
+		subtask.ParentID = parent.ID
+		if err := r.insertTask(subtask); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *memoryTaskRepository) CloneTaskTree(ctx context.Context, copies []entity.TaskCopy) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, taskCopy := range copies {
+		if taskCopy.Parent >= 0 {
+			taskCopy.Task.ParentID = copies[taskCopy.Parent].Task.ID
+		}
+		if err := r.insertTask(taskCopy.Task); err != nil {
+			return err
+		}
+		if err := r.insertChecklist(taskCopy.Task.ID, taskCopy.Checklist); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertChecklist заполняет служебные поля пунктов так же, как insertChecklistItem; вызывается под r.mu
+func (r *memoryTaskRepository) insertChecklist(taskId string, checklist []entity.ChecklistItem) error {
+	for i := range checklist {
+		randomUUID, err := uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		item := &checklist[i]
+		item.ID = randomUUID.String()
+		item.TaskID = taskId
+		item.CreatedAt = time.Now()
+		item.UpdatedAt = item.CreatedAt
+
+		stored := *item
+		stored.CreatedAt = dbTime(item.CreatedAt)
+		stored.UpdatedAt = stored.CreatedAt
+		r.checklists[taskId] = append(r.checklists[taskId], stored)
+	}
+	return nil
+}
+
+// ListItems возвращает пункты чек-листа задачи в порядке ChecklistRepository.ListItems
+func (r *memoryTaskRepository) ListItems(ctx context.Context, taskId string) ([]entity.ChecklistItem, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	items := slices.Clone(r.checklists[taskId])
+	slices.SortFunc(items, func(a, b entity.ChecklistItem) int {
+		return cmp.Or(cmp.Compare(a.Position, b.Position), a.CreatedAt.Compare(b.CreatedAt), strings.Compare(a.ID, b.ID))
+	})
+	return items, nil
+}
+
+// insertTask заполняет служебные поля задачи так же, как taskRepository.insertTask; вызывается под r.mu
+func (r *memoryTaskRepository) insertTask(task *entity.Task) error {
+	randomUUID, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	stored, err := storedTask(*task)
+	if err != nil {
+		return err
+	}
+	task.ID = randomUUID.String()
+	task.CreatedAt = time.Now()
+	task.UpdatedAt = time.Now()
+	if task.Status == entity.StatusCompleted {
+		task.CompletedAt = task.CreatedAt
+	}
+
+	stored.ID = task.ID
+	stored.CreatedAt = dbTime(task.CreatedAt)
+	stored.UpdatedAt = dbTime(task.UpdatedAt)
+	stored.CompletedAt = dbTime(task.CompletedAt)
+	r.tasks[stored.ID] = stored
+	r.history = append(r.history, entity.StatusChange{TaskID: stored.ID, Status: stored.Status, ChangedAt: stored.CreatedAt})
+	return nil
+}
+
+func (r *memoryTaskRepository) ListTasks(ctx context.Context, filter entity.TaskFilter) ([]entity.Task, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	match, err := filterMatcher(filter)
+	if err != nil {
+		return nil, err
+	}
+	var tasks []entity.Task
+	for _, task := range r.tasks {
+		if match(task) {
+			tasks = append(tasks, task)
+		}
+	}
+
+	compare := taskComparator(filter.SortBy)
+	slices.SortFunc(tasks, func(a, b entity.Task) int {
+		// NULLS LAST в обоих направлениях, при равенстве - по id
+		if order, ok := compare(a, b); ok {
+			if filter.SortDesc {
+				order = -order
+			}
+			if order != 0 {
+				return order
+			}
+		} else if order != 0 {
+			return order
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return cloneTasks(tasks)
+}
+
+func (r *memoryTaskRepository) GetTaskByID(ctx context.Context, taskId uuid.UUID) (entity.Task, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	task, ok := r.tasks[taskId.String()]
+	if !ok {
+		return entity.Task{}, ErrTaskNotFound
+	}
+	return cloneTask(task)
+}
+
+func (r *memoryTaskRepository) GetTaskByExternalUID(ctx context.Context, userId, externalUID string) (entity.Task, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, task := range r.tasks {
+		// пустой external_uid хранится как NULL и не совпадает ни с чем
+		if task.User_id == userId && task.ExternalUID != "" && task.ExternalUID == externalUID {
+			return cloneTask(task)
+		}
+	}
+	return entity.Task{}, ErrTaskNotFound
+}
+
+// UpdateTask меняет те же поля, что и UPDATE в taskRepository; владелец, родитель, внешний идентификатор
+// и сроки SLA остаются прежними, статус SLA пересчитывается только при смене статуса задачи
+func (r *memoryTaskRepository) UpdateTask(ctx context.Context, task *entity.Task) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, ok := r.tasks[task.ID]
+	if !ok {
+		return ErrTaskNotFound
+	}
+	changes, err := storedTask(*task)
+	if err != nil {
+		return err
+	}
+
+	task.UpdatedAt = time.Now()
+	task.CompletedAt = current.CompletedAt
+	task.SLAStatus = current.SLAStatus
+	statusChanged := current.Status != task.Status
+	if statusChanged {
+		task.CompletedAt = time.Time{}
+		if task.Status == entity.StatusCompleted {
+			task.CompletedAt = task.UpdatedAt
+		}
+		task.SLAStatus = sla.Transition(current.SLAStatus, current.SLADueAt, current.Status, task.Status, task.UpdatedAt)
+	}
+
+	current.Title = changes.Title
+	current.Description = changes.Description
+	current.Priority = changes.Priority
+	current.Status = changes.Status
+	current.Tags = changes.Tags
+	current.DueDate = changes.DueDate
+	current.UpdatedAt = dbTime(task.UpdatedAt)
+	current.CompletedAt = dbTime(task.CompletedAt)
+	current.Estimate = changes.Estimate
+	current.Project = changes.Project
+	current.CustomFields = changes.CustomFields
+	current.RespondedAt = changes.RespondedAt
+	current.SLAStatus = task.SLAStatus
+	current.StartAt = changes.StartAt
+	current.SnoozedUntil = changes.SnoozedUntil
+	r.tasks[current.ID] = current
+
+	if statusChanged {
+		r.history = append(r.history, entity.StatusChange{TaskID: current.ID, Status: current.Status, ChangedAt: current.UpdatedAt})
+	}
+	return nil
+}
+
+func (r *memoryTaskRepository) ListTasksForStats(ctx context.Context, filter entity.StatsFilter) ([]entity.Task, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var tasks []entity.Task
+	for _, task := range r.tasks {
+		if task.User_id != filter.UserID || task.CreatedAt.After(filter.To) || !containsAll(task.Tags, filter.Tags) {
+			continue
+		}
+		if filter.IncludeClosed || task.CompletedAt.IsZero() || !task.CompletedAt.Before(filter.From) ||
+			(!task.DueDate.IsZero() && !task.DueDate.Before(filter.From)) {
+			tasks = append(tasks, task)
+		}
+	}
+	slices.SortFunc(tasks, func(a, b entity.Task) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), strings.Compare(a.ID, b.ID))
+	})
+	return cloneTasks(tasks)
+}
+
+func (r *memoryTaskRepository) ListCalendarTasks(ctx context.Context, filter entity.CalendarFilter) ([]entity.Task, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	inRange := func(t time.Time) bool {
+		return !t.IsZero() && !t.Before(filter.From) && t.Before(filter.To)
+	}
+	var tasks []entity.Task
+	for _, task := range r.tasks {
+		if task.User_id != filter.UserID || (filter.Project != "" && task.Project != filter.Project) {
+			continue
+		}
+		if inRange(task.DueDate) || inRange(task.StartAt) {
+			tasks = append(tasks, task)
+		}
+	}
+	slices.SortFunc(tasks, func(a, b entity.Task) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), strings.Compare(a.ID, b.ID))
+	})
+	return cloneTasks(tasks)
+}
+
+func (r *memoryTaskRepository) ListStatusHistory(ctx context.Context, taskIds []string) ([]entity.StatusChange, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var history []entity.StatusChange
+	for _, change := range r.history {
+		if slices.Contains(taskIds, change.TaskID) {
+			history = append(history, change)
+		}
+	}
+	// стабильная сортировка сохраняет порядок записи при одинаковом времени, как ORDER BY ..., id
+	slices.SortStableFunc(history, func(a, b entity.StatusChange) int {
+		return cmp.Or(strings.Compare(a.TaskID, b.TaskID), a.ChangedAt.Compare(b.ChangedAt))
+	})
+	return history, nil
+}
+
+// filterMatcher переводит условия buildListQuery в проверку задачи
+func filterMatcher(filter entity.TaskFilter) (func(entity.Task) bool, error) {
+	var search *regexp.Regexp
+	if filter.Search != "" {
+		pattern, err := likePattern("%" + filter.Search + "%")
+		if err != nil {
+			return nil, err
+		}
+		search = pattern
+	}
+	conditions := make([]entity.CustomFieldCondition, len(filter.CustomFields))
+	for i, condition := range filter.CustomFields {
+		value, err := normalizeJSON(condition.Value)
+		if err != nil {
+			return nil, err
+		}
+		conditions[i] = entity.CustomFieldCondition{Key: condition.Key, Op: condition.Op, Value: value}
+	}
+
+	return func(task entity.Task) bool {
+		switch {
+		case task.User_id != filter.UserID:
+			return false
+		case len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, task.Status):
+			return false
+		case len(filter.Priorities) > 0 && !slices.Contains(filter.Priorities, task.Priority):
+			return false
+		case len(filter.Tags) > 0 && !containsAll(task.Tags, filter.Tags):
+			return false
+		case search != nil && !search.MatchString(task.Title) && !search.MatchString(task.Description):
+			return false
+		case !filter.DueFrom.IsZero() && (task.DueDate.IsZero() || task.DueDate.Before(filter.DueFrom)):
+			return false
+		case !filter.DueTo.IsZero() && (task.DueDate.IsZero() || task.DueDate.After(filter.DueTo)):
+			return false
+		case filter.Project != "" && task.Project != filter.Project:
+			return false
+		case !filter.AvailableAt.IsZero() && (task.StartAt.After(filter.AvailableAt) || task.SnoozedUntil.After(filter.AvailableAt)):
+			return false
+		}
+		for _, condition := range conditions {
+			if !customFieldMatches(task.CustomFields, condition) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+// customFieldMatches повторяет customFieldCondition: равенство - вхождение jsonb (@>),
+// сравнение чисел - как numeric, остальных значений - как текста
+func customFieldMatches(fields map[string]any, condition entity.CustomFieldCondition) bool {
+	stored, exists := fields[condition.Key]
+	if _, isOp := customFieldOps[condition.Op]; !isOp {
+		return exists && jsonContains(stored, condition.Value)
+	}
+
+	text, ok := jsonText(stored)
+	if !exists || !ok {
+		return false
+	}
+	if number, isNumber := condition.Value.(float64); isNumber {
+		value, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+		if err != nil {
+			return false
+		}
+		return compareOp(cmp.Compare(value, number), condition.Op)
+	}
+	value, _ := jsonText(condition.Value)
+	return compareOp(strings.Compare(text, value), condition.Op)
+}
+
+func compareOp(order int, op string) bool {
+	switch op {
+	case entity.CustomFieldOpGt:
+		return order > 0
+	case entity.CustomFieldOpGte:
+		return order >= 0
+	case entity.CustomFieldOpLt:
+		return order < 0
+	case entity.CustomFieldOpLte:
+		return order <= 0
+	}
+	return false
+}
+
+// taskComparator возвращает сравнение по полю сортировки. ok = false - у одной из задач значение NULL,
+// такой порядок не зависит от направления сортировки
+func taskComparator(sortBy string) func(a, b entity.Task) (int, bool) {
+	byTime := func(value func(entity.Task) time.Time) func(a, b entity.Task) (int, bool) {
+		return func(a, b entity.Task) (int, bool) {
+			return nullsLast(value(a).IsZero(), value(b).IsZero(), func() int { return value(a).Compare(value(b)) })
+		}
+	}
+
+	if key, isCustom := strings.CutPrefix(sortBy, entity.SortByCustomFieldPrefix); isCustom {
+		return func(a, b entity.Task) (int, bool) {
+			left, leftOk := a.CustomFields[key]
+			right, rightOk := b.CustomFields[key]
+			return nullsLast(!leftOk, !rightOk, func() int { return compareJSON(left, right) })
+		}
+	}
+	switch sortBy {
+	case entity.SortByUpdatedAt:
+		return byTime(func(task entity.Task) time.Time { return task.UpdatedAt })
+	case entity.SortByDueDate:
+		return byTime(func(task entity.Task) time.Time { return task.DueDate })
+	case entity.SortByTitle:
+		return func(a, b entity.Task) (int, bool) { return strings.Compare(a.Title, b.Title), true }
+	case entity.SortByPriority:
+		return func(a, b entity.Task) (int, bool) {
+			return cmp.Compare(priorityRank(a.Priority), priorityRank(b.Priority)), true
+		}
+	}
+	return byTime(func(task entity.Task) time.Time { return task.CreatedAt })
+}
+
+func nullsLast(leftNull, rightNull bool, compare func() int) (int, bool) {
+	switch {
+	case leftNull && rightNull:
+		return 0, false
+	case leftNull:
+		return 1, false
+	case rightNull:
+		return -1, false
+	}
+	return compare(), true
+}
+
+// priorityRank - значение CASE из sortColumns
+func priorityRank(priority string) int {
+	switch priority {
+	case entity.PriorityCritical:
+		return 3
+	case entity.PriorityHigh:
+		return 2
+	case entity.PriorityLow:
+		return 0
+	}
+	return 1
+}
+
+// compareJSON сравнивает значения jsonb: null < строки < числа < булевы < массивы < объекты
+func compareJSON(a, b any) int {
+	if order := cmp.Compare(jsonRank(a), jsonRank(b)); order != 0 {
+		return order
+	}
+	switch left := a.(type) {
+	case string:
+		return strings.Compare(left, b.(string))
+	case float64:
+		return cmp.Compare(left, b.(float64))
+	case bool:
+		right := b.(bool)
+		if left == right {
+			return 0
+		}
+		if right {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
+func jsonRank(value any) int {
+	switch value.(type) {
+	case nil:
+		return 0
+	case string:
+		return 1
+	case float64:
+		return 2
+	case bool:
+		return 3
+	case []any:
+		return 4
+	}
+	return 5
+}
+
+// jsonContains повторяет оператор @> для значений, прошедших через encoding/json
+func jsonContains(stored, value any) bool {
+	switch want := value.(type) {
+	case map[string]any:
+		have, ok := stored.(map[string]any)
+		if !ok {
+			return false
+		}
+		for key, item := range want {
+			if field, exists := have[key]; !exists || !jsonContains(field, item) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		have, ok := stored.([]any)
+		if !ok {
+			return false
+		}
+		for _, item := range want {
+			if !slices.ContainsFunc(have, func(element any) bool { return jsonContains(element, item) }) {
+				return false
+			}
+		}
+		return true
+	}
+	return jsonRank(stored) == jsonRank(value) && compareJSON(stored, value) == 0
+}
+
+// jsonText - результат оператора ->>; JSON null дает NULL
+func jsonText(value any) (string, bool) {
+	switch value := value.(type) {
+	case nil:
+		return "", false
+	case string:
+		return value, true
+	}
+	text, err := json.Marshal(value)
+	return string(text), err == nil
+}
+
+// likePattern переводит шаблон ILIKE в регулярное выражение: % - любая строка, _ - любой символ, \ - экранирование
+func likePattern(pattern string) (*regexp.Regexp, error) {
+	var expr strings.Builder
+	expr.WriteString(`(?is)^`)
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); i++ {
+		switch runes[i] {
+		case '%':
+			expr.WriteString(`.*`)
+		case '_':
+			expr.WriteString(`.`)
+		case '\\':
+			if i+1 < len(runes) {
+				i++
+			}
+			expr.WriteString(regexp.QuoteMeta(string(runes[i])))
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(runes[i])))
+		}
+	}
+	expr.WriteString(`$`)
+	return regexp.Compile(expr.String())
+}
+
+func containsAll(tags, required []string) bool {
+	for _, tag := range required {
+		if !slices.Contains(tags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// storedTask приводит задачу к виду, в котором ее вернет Postgres: время округлено до микросекунд,
+// пользовательские поля прошли через jsonb
+func storedTask(task entity.Task) (entity.Task, error) {
+	stored, err := cloneTask(task)
+	if err != nil {
+		return entity.Task{}, err
+	}
+	stored.CreatedAt = dbTime(stored.CreatedAt)
+	stored.UpdatedAt = dbTime(stored.UpdatedAt)
+	stored.DueDate = dbTime(stored.DueDate)
+	stored.CompletedAt = dbTime(stored.CompletedAt)
+	stored.SLAResponseDueAt = dbTime(stored.SLAResponseDueAt)
+	stored.SLADueAt = dbTime(stored.SLADueAt)
+	stored.RespondedAt = dbTime(stored.RespondedAt)
+	stored.StartAt = dbTime(stored.StartAt)
+	stored.SnoozedUntil = dbTime(stored.SnoozedUntil)
+	return stored, nil
+}
+
+// cloneTask копирует задачу, чтобы вызывающий код не менял хранимые срезы и карты
+func cloneTask(task entity.Task) (entity.Task, error) {
+	task.Tags = slices.Clone(task.Tags)
+	customFields, err := marshalCustomFields(task.CustomFields)
+	if err != nil {
+		return entity.Task{}, err
+	}
+	task.CustomFields = nil
+	if err := json.Unmarshal(customFields, &task.CustomFields); err != nil {
+		return entity.Task{}, err
+	}
+	return task, nil
+}
+
+func cloneTasks(tasks []entity.Task) ([]entity.Task, error) {
+	for i := range tasks {
+		task, err := cloneTask(tasks[i])
+		if err != nil {
+			return nil, err
+		}
+		tasks[i] = task
+	}
+	return tasks, nil
+}
+
+// normalizeJSON приводит значение к типам encoding/json, как при передаче параметра в jsonb
+func normalizeJSON(value any) (any, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var normalized any
+	err = json.Unmarshal(data, &normalized)
+	return normalized, err
+}
+
+// dbTime округляет время до точности timestamptz
+func dbTime(t time.Time) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	return t.Round(time.Microsecond)
+}
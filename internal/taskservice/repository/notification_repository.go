@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/infrastructure/outbox"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrNotificationNotFound = errors.New("notification not found")
+)
+
+type NotificationRepository interface {
+	// CreateNotifications сохраняет уведомления и пишет их письма в outbox; уже существующие тройки
+	// пользователь/событие/тип пропускаются вместе с письмом
+	CreateNotifications(ctx context.Context, notifications []entity.Notification) error
+	ListNotifications(ctx context.Context, userId string, unreadOnly bool, limit, offset int) ([]entity.Notification, error)
+	CountUnreadNotifications(ctx context.Context, userId string) (int, error)
+	// MarkNotificationRead отмечает уведомление прочитанным; повторная отметка не меняет read_at
+	MarkNotificationRead(ctx context.Context, userId, notificationId string, readAt time.Time) error
+	// MarkAllNotificationsRead отмечает прочитанными все уведомления пользователя и возвращает их число
+	MarkAllNotificationsRead(ctx context.Context, userId string, readAt time.Time) (int, error)
+}
+
+const notificationColumns = `id, user_id, type, title, body, task_id, event_id, read_at, created_at`
+
+type notificationRepository struct {
+	db  *sql.DB
+	Log *zap.Logger
+}
+
+// NewNotificationRepository создает репозиторий уведомлений в приложении
+func NewNotificationRepository(db *sql.DB, Log *zap.Logger) NotificationRepository {
+	return &notificationRepository{db: db, Log: Log}
+}
+
+func (r *notificationRepository) CreateNotifications(ctx context.Context, notifications []entity.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO notifications (` + notificationColumns + `, in_app, email_pending)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NULL, $8, $9, $10)
+		ON CONFLICT (user_id, event_id, type) DO NOTHING
+	`
+	now := time.Now()
+	for i := range notifications {
+		notification := &notifications[i]
+		randomUUID, err := uuid.NewV4()
+		if err != nil {
+			r.Log.Error("Failed generate random UUID", zap.Error(err))
+			return err
+		}
+		notification.ID = randomUUID.String()
+		if notification.CreatedAt.IsZero() {
+			notification.CreatedAt = now
+		}
+
+		result, err := tx.ExecContext(ctx, query,
+			notification.ID,
+			notification.UserID,
+			notification.Type,
+			notification.Title,
+			notification.Body,
+			nullString(notification.TaskID),
+			notification.EventID,
+			notification.CreatedAt,
+			notification.InApp,
+			notification.EmailPending,
+		)
+		if err != nil {
+			r.Log.Error("SQL error caused in repo's CreateNotifications", zap.Error(err))
+			return err
+		}
+		inserted, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if inserted > 0 && notification.Email != nil {
+			email := notification.Email
+			if err := outbox.WriteAt(ctx, tx, entity.EventNotificationEmail, notification.ID, email, email.SendAfter); err != nil {
+				r.Log.Error("SQL error caused in repo's CreateNotifications while writing outbox event", zap.Error(err))
+				return err
+			}
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *notificationRepository) ListNotifications(ctx context.Context, userId string, unreadOnly bool, limit, offset int) ([]entity.Notification, error) {
+	query := `
+		SELECT ` + notificationColumns + `
+		FROM notifications
+		WHERE user_id = $1 AND in_app AND (NOT $2 OR read_at IS NULL)
+		ORDER BY created_at DESC, id
+		LIMIT $3 OFFSET $4
+	`
+	rows, err := r.db.QueryContext(ctx, query, userId, unreadOnly, limit, offset)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's ListNotifications", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []entity.Notification
+	for rows.Next() {
+		notification, err := scanNotification(rows)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, notification)
+	}
+	return notifications, rows.Err()
+}
+
+func (r *notificationRepository) CountUnreadNotifications(ctx context.Context, userId string) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND in_app AND read_at IS NULL`, userId,
+	).Scan(&count)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's CountUnreadNotifications", zap.Error(err))
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *notificationRepository) MarkNotificationRead(ctx context.Context, userId, notificationId string, readAt time.Time) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE notifications SET read_at = COALESCE(read_at, $3) WHERE id = $1 AND user_id = $2 AND in_app`,
+		notificationId, userId, readAt,
+	)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's MarkNotificationRead", zap.Error(err))
+		return err
+	}
+	return expectAffected(result, ErrNotificationNotFound)
+}
+
+func (r *notificationRepository) MarkAllNotificationsRead(ctx context.Context, userId string, readAt time.Time) (int, error) {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE notifications SET read_at = $2 WHERE user_id = $1 AND in_app AND read_at IS NULL`,
+		userId, readAt,
+	)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's MarkAllNotificationsRead", zap.Error(err))
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(affected), nil
+}
+
+func scanNotification(row rowScanner) (entity.Notification, error) {
+	var (
+		notification entity.Notification
+		taskID       sql.NullString
+		readAt       sql.NullTime
+	)
+	err := row.Scan(
+		&notification.ID,
+		&notification.UserID,
+		&notification.Type,
+		&notification.Title,
+		&notification.Body,
+		&taskID,
+		&notification.EventID,
+		&readAt,
+		&notification.CreatedAt,
+	)
+	if err != nil {
+		return notification, err
+	}
+	notification.TaskID = taskID.String
+	if readAt.Valid {
+		notification.Read = true
+		notification.ReadAt = &readAt.Time
+	}
+	return notification, nil
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+// PreferenceRepository читает настройки уведомлений, которые ведет auth service,
+// чтобы производители уведомлений проверяли их перед публикацией
+type PreferenceRepository interface {
+	// GetNotificationRecipient возвращает адресата с настройками; nil без ошибки - пользователь удален или неактивен
+	GetNotificationRecipient(ctx context.Context, userId string) (*entity.NotificationRecipient, error)
+}
+
+type preferenceRepository struct {
+	db  *sql.DB
+	Log *zap.Logger
+}
+
+// NewPreferenceRepository создает репозиторий настроек уведомлений
+func NewPreferenceRepository(db *sql.DB, Log *zap.Logger) PreferenceRepository {
+	return &preferenceRepository{db: db, Log: Log}
+}
+
+func (r *preferenceRepository) GetNotificationRecipient(ctx context.Context, userId string) (*entity.NotificationRecipient, error) {
+	query := `
+		SELECT u.email, u.username, u.timezone, u.digest_enabled,
+		       p.channels, p.quiet_hours_enabled, p.quiet_hours_start, p.quiet_hours_end, p.digest_batching
+		FROM users u
+		LEFT JOIN notification_preferences p ON p.user_id = u.id
+		WHERE u.id = $1 AND u.active
+	`
+	var (
+		recipient  = entity.NotificationRecipient{UserID: userId, Preferences: entity.DefaultNotificationPreferences()}
+		channels   []byte
+		quietOn    sql.NullBool
+		quietStart sql.NullString
+		quietEnd   sql.NullString
+		batching   sql.NullBool
+	)
+	err := r.db.QueryRowContext(ctx, query, userId).Scan(
+		&recipient.Email,
+		&recipient.Username,
+		&recipient.Preferences.Timezone,
+		&recipient.DigestEnabled,
+		&channels,
+		&quietOn,
+		&quietStart,
+		&quietEnd,
+		&batching,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's GetNotificationRecipient", zap.Error(err))
+		return nil, err
+	}
+
+	if channels != nil {
+		var stored map[string][]string
+		if err := json.Unmarshal(channels, &stored); err != nil {
+			return nil, err
+		}
+		recipient.Preferences.Channels = entity.WithDefaultChannels(stored)
+	}
+	if quietStart.Valid {
+		recipient.Preferences.QuietHours = entity.QuietHours{Enabled: quietOn.Bool, Start: quietStart.String, End: quietEnd.String}
+	}
+	recipient.Preferences.DigestBatching = batching.Bool
+	return &recipient, nil
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrPublicLinkNotFound = errors.New("public link not found")
+)
+
+type PublicLinkRepository interface {
+	// CreateLink сохраняет ссылку; tokenHash - SHA-256 токена в hex, сам токен в базу не попадает
+	CreateLink(ctx context.Context, link *entity.PublicLink, tokenHash string) error
+	// ListLinks возвращает ссылки задачи, включая отозванные и истекшие, от новых к старым
+	ListLinks(ctx context.Context, taskId string) ([]entity.PublicLink, error)
+	// RevokeLink отзывает действующую ссылку; повторный отзыв возвращает ErrPublicLinkNotFound
+	RevokeLink(ctx context.Context, taskId, linkId string) (*entity.PublicLink, error)
+	// ViewLink находит действующую ссылку по хешу токена и в том же запросе увеличивает счетчик
+	// просмотров. Отозванная, истекшая и неизвестная ссылки одинаково дают ErrPublicLinkNotFound
+	ViewLink(ctx context.Context, tokenHash string) (*entity.PublicLink, error)
+}
+
+type publicLinkRepository struct {
+	db  *sql.DB
+	Log *zap.Logger
+}
+
+// NewPublicLinkRepository создает репозиторий публичных ссылок на задачи
+func NewPublicLinkRepository(db *sql.DB, Log *zap.Logger) PublicLinkRepository {
+	return &publicLinkRepository{db: db, Log: Log}
+}
+
+func (r *publicLinkRepository) CreateLink(ctx context.Context, link *entity.PublicLink, tokenHash string) error {
+	query := `
+		INSERT INTO task_public_links (id, task_id, user_id, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	randomUUID, err := uuid.NewV4()
+	if err != nil {
+		r.Log.Error("Failed generate random UUID", zap.Error(err))
+		return err
+	}
+	link.ID = randomUUID.String()
+	link.CreatedAt = time.Now()
+
+	_, err = r.db.ExecContext(ctx, query, link.ID, link.TaskID, link.UserID, tokenHash, link.ExpiresAt, link.CreatedAt)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's CreateLink", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *publicLinkRepository) ListLinks(ctx context.Context, taskId string) ([]entity.PublicLink, error) {
+	query := `
+		SELECT id, task_id, user_id, expires_at, revoked_at, view_count, last_viewed_at, created_at
+		FROM task_public_links
+		WHERE task_id = $1
+		ORDER BY created_at DESC, id
+	`
+	rows, err := r.db.QueryContext(ctx, query, taskId)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's ListLinks", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var links []entity.PublicLink
+	for rows.Next() {
+		link, err := scanPublicLink(rows)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, *link)
+	}
+	return links, rows.Err()
+}
+
+func (r *publicLinkRepository) RevokeLink(ctx context.Context, taskId, linkId string) (*entity.PublicLink, error) {
+	query := `
+		UPDATE task_public_links
+		SET revoked_at = NOW()
+		WHERE id = $1 AND task_id = $2 AND revoked_at IS NULL
+		RETURNING id, task_id, user_id, expires_at, revoked_at, view_count, last_viewed_at, created_at
+	`
+	link, err := scanPublicLink(r.db.QueryRowContext(ctx, query, linkId, taskId))
+	if err == sql.ErrNoRows {
+		return nil, ErrPublicLinkNotFound
+	}
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's RevokeLink", zap.Error(err))
+		return nil, err
+	}
+	return link, nil
+}
+
+func (r *publicLinkRepository) ViewLink(ctx context.Context, tokenHash string) (*entity.PublicLink, error) {
+	query := `
+		UPDATE task_public_links
+		SET view_count = view_count + 1, last_viewed_at = NOW()
+		WHERE token_hash = $1 AND revoked_at IS NULL AND expires_at > NOW()
+		RETURNING id, task_id, user_id, expires_at, revoked_at, view_count, last_viewed_at, created_at
+	`
+	link, err := scanPublicLink(r.db.QueryRowContext(ctx, query, tokenHash))
+	if err == sql.ErrNoRows {
+		return nil, ErrPublicLinkNotFound
+	}
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's ViewLink", zap.Error(err))
+		return nil, err
+	}
+	return link, nil
+}
+
+func scanPublicLink(row rowScanner) (*entity.PublicLink, error) {
+	var (
+		link       entity.PublicLink
+		revokedAt  sql.NullTime
+		lastViewed sql.NullTime
+	)
+	err := row.Scan(&link.ID, &link.TaskID, &link.UserID, &link.ExpiresAt, &revokedAt, &link.ViewCount, &lastViewed, &link.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if revokedAt.Valid {
+		link.RevokedAt = &revokedAt.Time
+	}
+	if lastViewed.Valid {
+		link.LastViewedAt = &lastViewed.Time
+	}
+	return &link, nil
+}
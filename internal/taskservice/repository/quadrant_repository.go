@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"go.uber.org/zap"
+)
+
+type QuadrantRepository interface {
+	// ListQuadrantOverrides возвращает закрепленные квадранты задач пользователя по id задачи
+	ListQuadrantOverrides(ctx context.Context, userId string) (map[string]string, error)
+	SetQuadrantOverride(ctx context.Context, userId, taskId, quadrant string) error
+	DeleteQuadrantOverride(ctx context.Context, userId, taskId string) error
+}
+
+type quadrantRepository struct {
+	db  *sql.DB
+	Log *zap.Logger
+}
+
+// NewQuadrantRepository создает репозиторий ручных квадрантов матрицы Эйзенхауэра
+func NewQuadrantRepository(db *sql.DB, Log *zap.Logger) QuadrantRepository {
+	return &quadrantRepository{db: db, Log: Log}
+}
+
+func (r *quadrantRepository) ListQuadrantOverrides(ctx context.Context, userId string) (map[string]string, error) {
+	query := `SELECT task_id, quadrant FROM task_quadrant_overrides WHERE user_id = $1`
+	rows, err := r.db.QueryContext(ctx, query, userId)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's ListQuadrantOverrides", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	overrides := make(map[string]string)
+	for rows.Next() {
+		var taskId, quadrant string
+		if err := rows.Scan(&taskId, &quadrant); err != nil {
+			return nil, err
+		}
+		overrides[taskId] = quadrant
+	}
+	return overrides, rows.Err()
+}
+
+func (r *quadrantRepository) SetQuadrantOverride(ctx context.Context, userId, taskId, quadrant string) error {
+	query := `
+		INSERT INTO task_quadrant_overrides (task_id, user_id, quadrant, updated_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (task_id) DO UPDATE SET quadrant = EXCLUDED.quadrant, updated_at = EXCLUDED.updated_at
+	`
+	if _, err := r.db.ExecContext(ctx, query, taskId, userId, quadrant); err != nil {
+		r.Log.Error("SQL error caused in repo's SetQuadrantOverride", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// DeleteQuadrantOverride не считает ошибкой отсутствие закрепления
+func (r *quadrantRepository) DeleteQuadrantOverride(ctx context.Context, userId, taskId string) error {
+	query := `DELETE FROM task_quadrant_overrides WHERE task_id = $1 AND user_id = $2`
+	if _, err := r.db.ExecContext(ctx, query, taskId, userId); err != nil {
+		r.Log.Error("SQL error caused in repo's DeleteQuadrantOverride", zap.Error(err))
+		return err
+	}
+	return nil
+}
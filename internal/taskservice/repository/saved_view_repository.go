@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/lib/pq"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrSavedViewNotFound = errors.New("saved view not found")
+	ErrSavedViewExists   = errors.New("saved view with this name already exists")
+)
+
+type SavedViewRepository interface {
+	Create(ctx context.Context, view *entity.SavedView) error
+	GetByID(ctx context.Context, viewId, userId string) (*entity.SavedView, error)
+	ListByUser(ctx context.Context, userId string) ([]entity.SavedView, error)
+	Update(ctx context.Context, view *entity.SavedView) error
+	Delete(ctx context.Context, viewId, userId string) error
+}
+
+type savedViewRepository struct {
+	db  *sql.DB
+	Log *zap.Logger
+}
+
+// NewSavedViewRepository создает репозиторий сохраненных представлений
+func NewSavedViewRepository(db *sql.DB, Log *zap.Logger) SavedViewRepository {
+	return &savedViewRepository{db: db, Log: Log}
+}
+
+func (r *savedViewRepository) Create(ctx context.Context, view *entity.SavedView) error {
+	query := `
+		INSERT INTO saved_views (id, user_id, name, filter, sort, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	randomUUID, err := uuid.NewV4()
+	if err != nil {
+		r.Log.Error("Failed generate random UUID", zap.Error(err))
+		return err
+	}
+	filter, sort, err := marshalView(view)
+	if err != nil {
+		return err
+	}
+
+	view.ID = randomUUID.String()
+	view.CreatedAt = time.Now()
+	view.UpdatedAt = view.CreatedAt
+
+	_, err = r.db.ExecContext(ctx, query,
+		view.ID,
+		view.UserID,
+		view.Name,
+		filter,
+		sort,
+		view.CreatedAt,
+		view.UpdatedAt,
+	)
+	if isUniqueViolation(err) {
+		return ErrSavedViewExists
+	}
+	return err
+}
+
+func (r *savedViewRepository) GetByID(ctx context.Context, viewId, userId string) (*entity.SavedView, error) {
+	query := `
+		SELECT id, user_id, name, filter, sort, created_at, updated_at
+		FROM saved_views
+		WHERE id = $1 AND user_id = $2
+	`
+	view, err := scanSavedView(r.db.QueryRowContext(ctx, query, viewId, userId))
+	if err == sql.ErrNoRows {
+		return nil, ErrSavedViewNotFound
+	}
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's GetByID saved view", zap.Error(err))
+		return nil, err
+	}
+	return view, nil
+}
+
+func (r *savedViewRepository) ListByUser(ctx context.Context, userId string) ([]entity.SavedView, error) {
+	query := `
+		SELECT id, user_id, name, filter, sort, created_at, updated_at
+		FROM saved_views
+		WHERE user_id = $1
+		ORDER BY name
+	`
+	rows, err := r.db.QueryContext(ctx, query, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var views []entity.SavedView
+	for rows.Next() {
+		view, err := scanSavedView(rows)
+		if err != nil {
+			return nil, err
+		}
+		views = append(views, *view)
+	}
+	return views, rows.Err()
+}
+
+func (r *savedViewRepository) Update(ctx context.Context, view *entity.SavedView) error {
+	query := `
+		UPDATE saved_views
+		SET name = $3, filter = $4, sort = $5, updated_at = $6
+		WHERE id = $1 AND user_id = $2
+	`
+	filter, sort, err := marshalView(view)
+	if err != nil {
+		return err
+	}
+	view.UpdatedAt = time.Now()
+
+	result, err := r.db.ExecContext(ctx, query, view.ID, view.UserID, view.Name, filter, sort, view.UpdatedAt)
+	if isUniqueViolation(err) {
+		return ErrSavedViewExists
+	}
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's Update saved view", zap.Error(err))
+		return err
+	}
+	return expectAffected(result, ErrSavedViewNotFound)
+}
+
+func (r *savedViewRepository) Delete(ctx context.Context, viewId, userId string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM saved_views WHERE id = $1 AND user_id = $2`, viewId, userId)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's Delete saved view", zap.Error(err))
+		return err
+	}
+	return expectAffected(result, ErrSavedViewNotFound)
+}
+
+func marshalView(view *entity.SavedView) ([]byte, []byte, error) {
+	filter, err := json.Marshal(view.Filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	sort, err := json.Marshal(view.Sort)
+	if err != nil {
+		return nil, nil, err
+	}
+	return filter, sort, nil
+}
+
+func scanSavedView(row rowScanner) (*entity.SavedView, error) {
+	var (
+		view         entity.SavedView
+		filter, sort []byte
+	)
+	err := row.Scan(&view.ID, &view.UserID, &view.Name, &filter, &sort, &view.CreatedAt, &view.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(filter, &view.Filter); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(sort, &view.Sort); err != nil {
+		return nil, err
+	}
+	return &view, nil
+}
+
+// expectAffected возвращает notFound, если запрос не изменил ни одной строки
+func expectAffected(result sql.Result, notFound error) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return notFound
+	}
+	return nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
+
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23503"
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/infrastructure/outbox"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrShareNotFound = errors.New("task share not found")
+)
+
+type ShareRepository interface {
+	// UpsertShare выдает доступ или меняет уровень уже выданного. Если доступ действительно выдан
+	// или изменен, в outbox той же транзакцией пишется событие task.shared
+	UpsertShare(ctx context.Context, share *entity.TaskShare, event *entity.TaskSharedEvent) error
+	DeleteShare(ctx context.Context, taskId, granteeId string) error
+	ListShares(ctx context.Context, taskId string) ([]entity.TaskShare, error)
+	// GetPermission возвращает уровень доступа пользователя к чужой задаче или ErrShareNotFound
+	GetPermission(ctx context.Context, taskId, granteeId string) (string, error)
+	// ListSharedTasks возвращает задачи, к которым выдан доступ пользователю filter.UserID,
+	// с теми же условиями и сортировкой, что и ListTasks
+	ListSharedTasks(ctx context.Context, filter entity.TaskFilter) ([]entity.Task, error)
+}
+
+type shareRepository struct {
+	db  *sql.DB
+	Log *zap.Logger
+}
+
+// NewShareRepository создает репозиторий доступов к задачам
+func NewShareRepository(db *sql.DB, Log *zap.Logger) ShareRepository {
+	return &shareRepository{db: db, Log: Log}
+}
+
+func (r *shareRepository) UpsertShare(ctx context.Context, share *entity.TaskShare, event *entity.TaskSharedEvent) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// повторная выдача того же уровня ничего не меняет: строка не возвращается и событие не пишется
+	query := `
+		INSERT INTO task_shares (task_id, grantee_id, permission, granted_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $5)
+		ON CONFLICT (task_id, grantee_id) DO UPDATE
+		SET permission = EXCLUDED.permission, granted_by = EXCLUDED.granted_by, updated_at = EXCLUDED.updated_at
+		WHERE task_shares.permission <> EXCLUDED.permission
+		RETURNING created_at, updated_at
+	`
+	now := time.Now()
+	err = tx.QueryRowContext(ctx, query, share.TaskID, share.GranteeID, share.Permission, share.GrantedBy, now).
+		Scan(&share.CreatedAt, &share.UpdatedAt)
+	if err == sql.ErrNoRows {
+		err = tx.QueryRowContext(ctx,
+			`SELECT granted_by, created_at, updated_at FROM task_shares WHERE task_id = $1 AND grantee_id = $2`,
+			share.TaskID, share.GranteeID,
+		).Scan(&share.GrantedBy, &share.CreatedAt, &share.UpdatedAt)
+		if err != nil {
+			r.Log.Error("SQL error caused in repo's UpsertShare", zap.Error(err))
+			return err
+		}
+		return tx.Commit()
+	}
+	if isForeignKeyViolation(err) {
+		return ErrUserNotFound
+	}
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's UpsertShare", zap.Error(err))
+		return err
+	}
+
+	if event != nil {
+		event.OccurredAt = share.UpdatedAt
+		if err := outbox.Write(ctx, tx, entity.EventTaskShared, share.TaskID, event); err != nil {
+			r.Log.Error("SQL error caused in repo's UpsertShare while writing outbox event", zap.Error(err))
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *shareRepository) DeleteShare(ctx context.Context, taskId, granteeId string) error {
+	query := `DELETE FROM task_shares WHERE task_id = $1 AND grantee_id = $2`
+	result, err := r.db.ExecContext(ctx, query, taskId, granteeId)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's DeleteShare", zap.Error(err))
+		return err
+	}
+	return expectAffected(result, ErrShareNotFound)
+}
+
+func (r *shareRepository) ListShares(ctx context.Context, taskId string) ([]entity.TaskShare, error) {
+	query := `
+		SELECT task_id, grantee_id, permission, granted_by, created_at, updated_at
+		FROM task_shares
+		WHERE task_id = $1
+		ORDER BY created_at, grantee_id
+	`
+	rows, err := r.db.QueryContext(ctx, query, taskId)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's ListShares", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var shares []entity.TaskShare
+	for rows.Next() {
+		var share entity.TaskShare
+		if err := rows.Scan(&share.TaskID, &share.GranteeID, &share.Permission, &share.GrantedBy, &share.CreatedAt, &share.UpdatedAt); err != nil {
+			return nil, err
+		}
+		shares = append(shares, share)
+	}
+	return shares, rows.Err()
+}
+
+func (r *shareRepository) GetPermission(ctx context.Context, taskId, granteeId string) (string, error) {
+	query := `SELECT permission FROM task_shares WHERE task_id = $1 AND grantee_id = $2`
+	var permission string
+	err := r.db.QueryRowContext(ctx, query, taskId, granteeId).Scan(&permission)
+	if err == sql.ErrNoRows {
+		return "", ErrShareNotFound
+	}
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's GetPermission", zap.Error(err))
+		return "", err
+	}
+	return permission, nil
+}
+
+func (r *shareRepository) ListSharedTasks(ctx context.Context, filter entity.TaskFilter) ([]entity.Task, error) {
+	filter.Shared = true
+	query, args := buildListQuery(filter)
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's ListSharedTasks", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tasks []entity.Task
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, rows.Err()
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/infrastructure/outbox"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrSLAPolicyNotFound = errors.New("sla policy not found")
+)
+
+type SLARepository interface {
+	GetSLAPolicy(ctx context.Context, priority string) (*entity.SLAPolicy, error)
+	ListSLAPolicies(ctx context.Context) ([]entity.SLAPolicy, error)
+	UpsertSLAPolicy(ctx context.Context, policy *entity.SLAPolicy) error
+	ListActiveSLATasks(ctx context.Context) ([]entity.Task, error)
+	// SetSLAStatus меняет статус, только если он все еще равен from, и, если escalation не nil,
+	// пишет событие task.sla_escalated в outbox той же транзакцией
+	SetSLAStatus(ctx context.Context, taskId, from, to string, escalation *entity.SLAEscalation) (bool, error)
+}
+
+type slaRepository struct {
+	db  *sql.DB
+	Log *zap.Logger
+}
+
+// NewSLARepository создает репозиторий SLA политик и статусов задач
+func NewSLARepository(db *sql.DB, Log *zap.Logger) SLARepository {
+	return &slaRepository{db: db, Log: Log}
+}
+
+func (r *slaRepository) GetSLAPolicy(ctx context.Context, priority string) (*entity.SLAPolicy, error) {
+	query := `
+		SELECT priority, response_minutes, resolution_minutes, at_risk_threshold, updated_at
+		FROM sla_policies WHERE priority = $1
+	`
+	var policy entity.SLAPolicy
+	err := r.db.QueryRowContext(ctx, query, priority).Scan(
+		&policy.Priority,
+		&policy.ResponseMinutes,
+		&policy.ResolutionMinutes,
+		&policy.AtRiskThreshold,
+		&policy.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, ErrSLAPolicyNotFound
+	}
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's GetSLAPolicy", zap.Error(err))
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func (r *slaRepository) ListSLAPolicies(ctx context.Context) ([]entity.SLAPolicy, error) {
+	query := `
+		SELECT priority, response_minutes, resolution_minutes, at_risk_threshold, updated_at
+		FROM sla_policies ORDER BY resolution_minutes
+	`
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's ListSLAPolicies", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var policies []entity.SLAPolicy
+	for rows.Next() {
+		var policy entity.SLAPolicy
+		err := rows.Scan(&policy.Priority, &policy.ResponseMinutes, &policy.ResolutionMinutes, &policy.AtRiskThreshold, &policy.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+	return policies, rows.Err()
+}
+
+// UpsertSLAPolicy создает или заменяет политику приоритета; уже созданные задачи сохраняют свои сроки
+func (r *slaRepository) UpsertSLAPolicy(ctx context.Context, policy *entity.SLAPolicy) error {
+	query := `
+		INSERT INTO sla_policies (priority, response_minutes, resolution_minutes, at_risk_threshold, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (priority) DO UPDATE
+		SET response_minutes = EXCLUDED.response_minutes,
+		    resolution_minutes = EXCLUDED.resolution_minutes,
+		    at_risk_threshold = EXCLUDED.at_risk_threshold,
+		    updated_at = EXCLUDED.updated_at
+	`
+	policy.UpdatedAt = time.Now()
+	_, err := r.db.ExecContext(ctx, query,
+		policy.Priority,
+		policy.ResponseMinutes,
+		policy.ResolutionMinutes,
+		policy.AtRiskThreshold,
+		policy.UpdatedAt,
+	)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's UpsertSLAPolicy", zap.Error(err))
+	}
+	return err
+}
+
+// ListActiveSLATasks возвращает открытые задачи, SLA которых еще не нарушено
+func (r *slaRepository) ListActiveSLATasks(ctx context.Context) ([]entity.Task, error) {
+	query := `
+	SELECT ` + taskColumns + `
+    FROM tasks
+    WHERE sla_status IN ($1, $2)
+      AND status NOT IN ($3, $4)
+    ORDER BY sla_due_at
+	`
+	rows, err := r.db.QueryContext(ctx, query,
+		entity.SLAStatusOnTrack, entity.SLAStatusAtRisk,
+		entity.StatusCompleted, entity.StatusCancelled,
+	)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's ListActiveSLATasks", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tasks []entity.Task
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, rows.Err()
+}
+
+func (r *slaRepository) SetSLAStatus(ctx context.Context, taskId, from, to string, escalation *entity.SLAEscalation) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx,
+		`UPDATE tasks SET sla_status = $3 WHERE id = $1 AND sla_status = $2`,
+		taskId, from, to,
+	)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's SetSLAStatus", zap.Error(err))
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if affected == 0 {
+		return false, nil
+	}
+
+	if escalation != nil {
+		if err := outbox.Write(ctx, tx, entity.EventSLAEscalated, taskId, escalation); err != nil {
+			r.Log.Error("SQL error caused in repo's SetSLAStatus while writing outbox event", zap.Error(err))
+			return false, err
+		}
+	}
+	return true, tx.Commit()
+}
@@ -3,25 +3,42 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/lib/pq"
 	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/infrastructure/outbox"
+	"github.com/oogway93/taskmanager/internal/taskservice/sla"
 	"go.uber.org/zap"
 )
 
 var (
 	ErrUserNotFound = errors.New("user not found")
+	ErrTaskNotFound = errors.New("task not found")
 )
 
 type TaskRepository interface {
 	CreateTask(ctx context.Context, task *entity.Task) error
-	ListTasks(ctx context.Context, userId string) ([]entity.Task, error)
+	ListTasks(ctx context.Context, filter entity.TaskFilter) ([]entity.Task, error)
 	GetTaskByID(ctx context.Context, taskId uuid.UUID) (entity.Task, error)
+	GetTaskByExternalUID(ctx context.Context, userId, externalUID string) (entity.Task, error)
+	CreateTaskTree(ctx context.Context, parent *entity.Task, subtasks []*entity.Task, checklist []entity.ChecklistItem) error
+	CloneTaskTree(ctx context.Context, copies []entity.TaskCopy) error
+	UpdateTask(ctx context.Context, task *entity.Task) error
+	ListTasksForStats(ctx context.Context, filter entity.StatsFilter) ([]entity.Task, error)
+	ListStatusHistory(ctx context.Context, taskIds []string) ([]entity.StatusChange, error)
+	ListCalendarTasks(ctx context.Context, filter entity.CalendarFilter) ([]entity.Task, error)
 }
 
+// taskColumns - порядок колонок, который ожидает scanTask
+const taskColumns = `id, title, description, priority, status, tags, user_id, created_at, updated_at, due_date, external_uid, completed_at, estimate, parent_id, project, custom_fields,
+	sla_response_due_at, sla_due_at, responded_at, sla_status, start_at, snoozed_until`
+
 type taskRepository struct {
 	db  *sql.DB
 	Log *zap.Logger
@@ -33,20 +50,97 @@ func NewTaskRepository(db *sql.DB, Log *zap.Logger) TaskRepository {
 }
 
 func (r *taskRepository) CreateTask(ctx context.Context, task *entity.Task) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := r.insertTask(ctx, tx, task); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// CreateTaskTree создает задачу, пункты ее чек-листа и подзадачи в одной транзакции
+func (r *taskRepository) CreateTaskTree(ctx context.Context, parent *entity.Task, subtasks []*entity.Task, checklist []entity.ChecklistItem) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := r.insertTask(ctx, tx, parent); err != nil {
+		return err
+	}
+	for i := range checklist {
+		checklist[i].TaskID = parent.ID
+		if err := insertChecklistItem(ctx, tx, &checklist[i]); err != nil {
+			r.Log.Error("SQL error caused in repo's CreateTaskTree while writing checklist", zap.Error(err))
+			return err
+		}
+	}
+	for _, subtask := range subtasks {
+		subtask.ParentID = parent.ID
+		if err := r.insertTask(ctx, tx, subtask); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// CloneTaskTree создает копии задач вместе с чек-листами в одной транзакции; ParentID копии
+// берется у уже созданного родителя по индексу Parent
+func (r *taskRepository) CloneTaskTree(ctx context.Context, copies []entity.TaskCopy) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, taskCopy := range copies {
+		if taskCopy.Parent >= 0 {
+			taskCopy.Task.ParentID = copies[taskCopy.Parent].Task.ID
+		}
+		if err := r.insertTask(ctx, tx, taskCopy.Task); err != nil {
+			r.Log.Error("SQL error caused in repo's CloneTaskTree", zap.Error(err))
+			return err
+		}
+		for i := range taskCopy.Checklist {
+			taskCopy.Checklist[i].TaskID = taskCopy.Task.ID
+			if err := insertChecklistItem(ctx, tx, &taskCopy.Checklist[i]); err != nil {
+				r.Log.Error("SQL error caused in repo's CloneTaskTree while copying checklist", zap.Error(err))
+				return err
+			}
+		}
+	}
+	return tx.Commit()
+}
+
+// insertTask вставляет задачу и первую запись истории статусов внутри транзакции tx
+func (r *taskRepository) insertTask(ctx context.Context, tx *sql.Tx, task *entity.Task) error {
 	query := `
-		INSERT INTO tasks (id, title, description, priority, status, user_id, tags, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) 
+		INSERT INTO tasks (id, title, description, priority, status, user_id, tags, created_at, updated_at, due_date, external_uid, completed_at, estimate, parent_id,
+		                   project, custom_fields, sla_response_due_at, sla_due_at, responded_at, sla_status, start_at, snoozed_until)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22) 
 	` //TODO: убрать raw sql, использовать gORM
 	randomUUID, err := uuid.NewV4()
 	if err != nil {
 		r.Log.Error("Failed generate random UUID", zap.Error(err))
 		return err
 	}
+	customFields, err := marshalCustomFields(task.CustomFields)
+	if err != nil {
+		return err
+	}
 	task.ID = randomUUID.String()
 	task.CreatedAt = time.Now()
 	task.UpdatedAt = time.Now()
+	if task.Status == entity.StatusCompleted {
+		task.CompletedAt = task.CreatedAt
+	}
 
-	_, err = r.db.ExecContext(ctx, query,
+	_, err = tx.ExecContext(ctx, query,
 		task.ID,
 		task.Title,
 		task.Description,
@@ -56,17 +150,61 @@ func (r *taskRepository) CreateTask(ctx context.Context, task *entity.Task) erro
 		pq.Array(task.Tags),
 		task.CreatedAt,
 		task.UpdatedAt,
+		nullTime(task.DueDate),
+		nullString(task.ExternalUID),
+		nullTime(task.CompletedAt),
+		task.Estimate,
+		nullString(task.ParentID),
+		task.Project,
+		customFields,
+		nullTime(task.SLAResponseDueAt),
+		nullTime(task.SLADueAt),
+		nullTime(task.RespondedAt),
+		task.SLAStatus,
+		nullTime(task.StartAt),
+		nullTime(task.SnoozedUntil),
 	)
+	if err != nil {
+		return err
+	}
 
-	return err
+	if err := insertStatusChange(ctx, tx, task.ID, task.Status, task.CreatedAt); err != nil {
+		r.Log.Error("SQL error caused in repo's CreateTask while writing status history", zap.Error(err))
+		return err
+	}
+	if err := outbox.Write(ctx, tx, entity.EventTaskCreated, task.ID, taskEvent(task, "", task.CreatedAt)); err != nil {
+		r.Log.Error("SQL error caused in repo's CreateTask while writing outbox event", zap.Error(err))
+		return err
+	}
+	return nil
 }
 
-func (r *taskRepository) ListTasks(ctx context.Context, userId string) ([]entity.Task, error) {
-	query := `
-	SELECT id, title, description, priority, status, tags, user_id, created_at, updated_at 
-    FROM tasks WHERE user_id = $1;	
-	`
-	rows, err := r.db.QueryContext(ctx, query, userId)
+// taskEvent собирает полезную нагрузку доменного события задачи
+func taskEvent(task *entity.Task, previousStatus string, occurredAt time.Time) entity.TaskEvent {
+	event := entity.TaskEvent{
+		TaskID:     task.ID,
+		UserID:     task.User_id,
+		ParentID:   task.ParentID,
+		Title:      task.Title,
+		Status:     task.Status,
+		Priority:   task.Priority,
+		Project:    task.Project,
+		Tags:       task.Tags,
+		OccurredAt: occurredAt,
+	}
+	if previousStatus != task.Status {
+		event.PreviousStatus = previousStatus
+	}
+	if !task.DueDate.IsZero() {
+		dueDate := task.DueDate
+		event.DueDate = &dueDate
+	}
+	return event
+}
+
+func (r *taskRepository) ListTasks(ctx context.Context, filter entity.TaskFilter) ([]entity.Task, error) {
+	query, args := buildListQuery(filter)
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -74,18 +212,7 @@ func (r *taskRepository) ListTasks(ctx context.Context, userId string) ([]entity
 
 	var tasks []entity.Task
 	for rows.Next() {
-		var task entity.Task
-		err := rows.Scan(
-			&task.ID,
-			&task.Title,
-			&task.Description,
-			&task.Priority,
-			&task.Status,
-			pq.Array(&task.Tags), // Используем pq.Array для сканирования массива
-			&task.User_id,
-			&task.CreatedAt,
-			&task.UpdatedAt,
-		)
+		task, err := scanTask(rows)
 		if err != nil {
 			return nil, err
 		}
@@ -93,8 +220,8 @@ func (r *taskRepository) ListTasks(ctx context.Context, userId string) ([]entity
 	}
 
 	if err = rows.Err(); err != nil {
-		r.Log.Fatal("SQL error caused in repo's ListTasks", zap.Error(err))
-		return nil, ErrUserNotFound
+		r.Log.Error("SQL error caused in repo's ListTasks", zap.Error(err))
+		return nil, err
 	}
 
 	return tasks, nil
@@ -102,7 +229,7 @@ func (r *taskRepository) ListTasks(ctx context.Context, userId string) ([]entity
 
 func (r *taskRepository) GetTaskByID(ctx context.Context, taskId uuid.UUID) (entity.Task, error) {
 	query := `
-	SELECT id, title, description, priority, status, tags, user_id, created_at, updated_at 
+	SELECT ` + taskColumns + `
     FROM tasks WHERE id = $1;	
 	`
 	row, err := r.db.QueryContext(ctx, query, taskId)
@@ -113,23 +240,391 @@ func (r *taskRepository) GetTaskByID(ctx context.Context, taskId uuid.UUID) (ent
 
 	var task entity.Task
 	for row.Next() {
-		err = row.Scan(
-			&task.ID,
-			&task.Title,
-			&task.Description,
-			&task.Priority,
-			&task.Status,
-			pq.Array(&task.Tags),
-			&task.User_id,
-			&task.CreatedAt,
-			&task.UpdatedAt,
-		)
+		task, err = scanTask(row)
+		if err != nil {
+			return entity.Task{}, err
+		}
 	}
 
 	if err = row.Err(); err != nil {
 		r.Log.Error("SQL error caused in repo's GetTask", zap.Error(err))
 		return entity.Task{}, ErrUserNotFound
 	}
+	if task.ID == "" {
+		return entity.Task{}, ErrTaskNotFound
+	}
 	r.Log.Info("data from repo", zap.String("id", task.ID), zap.String("title", task.Title))
 	return task, nil
 }
+
+// GetTaskByExternalUID ищет задачу пользователя, импортированную из внешнего источника
+func (r *taskRepository) GetTaskByExternalUID(ctx context.Context, userId, externalUID string) (entity.Task, error) {
+	query := `
+	SELECT ` + taskColumns + `
+    FROM tasks WHERE user_id = $1 AND external_uid = $2;
+	`
+	task, err := scanTask(r.db.QueryRowContext(ctx, query, userId, externalUID))
+	if err == sql.ErrNoRows {
+		return entity.Task{}, ErrTaskNotFound
+	}
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's GetTaskByExternalUID", zap.Error(err))
+		return entity.Task{}, err
+	}
+	return task, nil
+}
+
+// UpdateTask сохраняет изменения задачи; смена статуса записывается в task_status_history.
+// Статус SLA из task не сохраняется: его меняет фоновая проверка, поэтому при смене статуса задачи
+// он пересчитывается от значения, прочитанного под FOR UPDATE
+func (r *taskRepository) UpdateTask(ctx context.Context, task *entity.Task) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var (
+		previousStatus string
+		completedAt    sql.NullTime
+		slaStatus      string
+		slaDueAt       sql.NullTime
+	)
+	err = tx.QueryRowContext(ctx,
+		`SELECT status, completed_at, sla_status, sla_due_at FROM tasks WHERE id = $1 FOR UPDATE`, task.ID,
+	).Scan(&previousStatus, &completedAt, &slaStatus, &slaDueAt)
+	if err == sql.ErrNoRows {
+		return ErrTaskNotFound
+	}
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's UpdateTask", zap.Error(err))
+		return err
+	}
+
+	customFields, err := marshalCustomFields(task.CustomFields)
+	if err != nil {
+		return err
+	}
+	task.UpdatedAt = time.Now()
+	task.CompletedAt = completedAt.Time
+	task.SLAStatus = slaStatus
+	statusChanged := previousStatus != task.Status
+	if statusChanged {
+		task.CompletedAt = time.Time{}
+		if task.Status == entity.StatusCompleted {
+			task.CompletedAt = task.UpdatedAt
+		}
+		task.SLAStatus = sla.Transition(slaStatus, slaDueAt.Time, previousStatus, task.Status, task.UpdatedAt)
+	}
+
+	query := `
+		UPDATE tasks
+		SET title = $2, description = $3, priority = $4, status = $5, tags = $6, due_date = $7, updated_at = $8, completed_at = $9,
+		    estimate = $10, project = $11, custom_fields = $12, responded_at = $13, start_at = $14, snoozed_until = $15
+		WHERE id = $1
+	`
+	_, err = tx.ExecContext(ctx, query,
+		task.ID,
+		task.Title,
+		task.Description,
+		task.Priority,
+		task.Status,
+		pq.Array(task.Tags),
+		nullTime(task.DueDate),
+		task.UpdatedAt,
+		nullTime(task.CompletedAt),
+		task.Estimate,
+		task.Project,
+		customFields,
+		nullTime(task.RespondedAt),
+		nullTime(task.StartAt),
+		nullTime(task.SnoozedUntil),
+	)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's UpdateTask", zap.Error(err))
+		return err
+	}
+	if task.SLAStatus != slaStatus {
+		_, err = tx.ExecContext(ctx,
+			`UPDATE tasks SET sla_status = $3 WHERE id = $1 AND sla_status = $2`,
+			task.ID, slaStatus, task.SLAStatus,
+		)
+		if err != nil {
+			r.Log.Error("SQL error caused in repo's UpdateTask while writing sla status", zap.Error(err))
+			return err
+		}
+	}
+
+	if statusChanged {
+		if err := insertStatusChange(ctx, tx, task.ID, task.Status, task.UpdatedAt); err != nil {
+			r.Log.Error("SQL error caused in repo's UpdateTask while writing status history", zap.Error(err))
+			return err
+		}
+	}
+	if err := outbox.Write(ctx, tx, entity.EventTaskUpdated, task.ID, taskEvent(task, previousStatus, task.UpdatedAt)); err != nil {
+		r.Log.Error("SQL error caused in repo's UpdateTask while writing outbox event", zap.Error(err))
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ListTasksForStats возвращает задачи пользователя, существовавшие в периоде filter.From - filter.To.
+// С IncludeClosed в выборку попадают и задачи, завершенные до начала периода
+func (r *taskRepository) ListTasksForStats(ctx context.Context, filter entity.StatsFilter) ([]entity.Task, error) {
+	query := `
+	SELECT ` + taskColumns + `
+    FROM tasks
+    WHERE user_id = $1
+      AND created_at <= $3
+      AND ($5 OR completed_at IS NULL OR completed_at >= $2 OR (due_date IS NOT NULL AND due_date >= $2))
+      AND (cardinality($4::text[]) = 0 OR tags @> $4::text[])
+	`
+	rows, err := r.db.QueryContext(ctx, query, filter.UserID, filter.From, filter.To, pq.Array(filter.Tags), filter.IncludeClosed)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's ListTasksForStats", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tasks []entity.Task
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, rows.Err()
+}
+
+// ListCalendarTasks возвращает задачи, у которых срок или начало попадает в [From, To)
+func (r *taskRepository) ListCalendarTasks(ctx context.Context, filter entity.CalendarFilter) ([]entity.Task, error) {
+	query := `
+	SELECT ` + taskColumns + `
+    FROM tasks
+    WHERE user_id = $1
+      AND ((due_date >= $2 AND due_date < $3) OR (start_at >= $2 AND start_at < $3))
+      AND ($4 = '' OR project = $4)
+    ORDER BY created_at, id
+	`
+	rows, err := r.db.QueryContext(ctx, query, filter.UserID, filter.From, filter.To, filter.Project)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's ListCalendarTasks", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tasks []entity.Task
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, rows.Err()
+}
+
+// ListStatusHistory возвращает историю статусов задач, отсортированную по времени
+func (r *taskRepository) ListStatusHistory(ctx context.Context, taskIds []string) ([]entity.StatusChange, error) {
+	query := `
+	SELECT task_id, status, changed_at
+    FROM task_status_history
+    WHERE task_id = ANY($1::uuid[])
+    ORDER BY task_id, changed_at, id
+	`
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(taskIds))
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's ListStatusHistory", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var history []entity.StatusChange
+	for rows.Next() {
+		var change entity.StatusChange
+		if err := rows.Scan(&change.TaskID, &change.Status, &change.ChangedAt); err != nil {
+			return nil, err
+		}
+		history = append(history, change)
+	}
+	return history, rows.Err()
+}
+
+func insertStatusChange(ctx context.Context, tx *sql.Tx, taskId, status string, changedAt time.Time) error {
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO task_status_history (task_id, status, changed_at) VALUES ($1, $2, $3)`,
+		taskId, status, changedAt,
+	)
+	return err
+}
+
+// sortColumns - допустимые поля сортировки; значение подставляется в ORDER BY
+var sortColumns = map[string]string{
+	entity.SortByCreatedAt: "created_at",
+	entity.SortByUpdatedAt: "updated_at",
+	entity.SortByDueDate:   "due_date",
+	entity.SortByTitle:     "title",
+	entity.SortByPriority: `CASE priority
+		WHEN 'CRITICAL' THEN 3 WHEN 'HIGH' THEN 2 WHEN 'NORMAL' THEN 1 WHEN 'LOW' THEN 0 ELSE 1 END`,
+}
+
+// buildListQuery собирает SELECT по фильтру; пустые поля фильтра не ограничивают выборку
+func buildListQuery(filter entity.TaskFilter) (string, []any) {
+	var (
+		conditions = []string{"user_id = $1"}
+		args       = []any{filter.UserID}
+	)
+	if filter.Shared {
+		conditions[0] = "id IN (SELECT task_id FROM task_shares WHERE grantee_id = $1)"
+	}
+	arg := func(value any) string {
+		args = append(args, value)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	if len(filter.Statuses) > 0 {
+		conditions = append(conditions, "status = ANY("+arg(pq.Array(filter.Statuses))+")")
+	}
+	if len(filter.Priorities) > 0 {
+		conditions = append(conditions, "priority = ANY("+arg(pq.Array(filter.Priorities))+")")
+	}
+	if len(filter.Tags) > 0 {
+		conditions = append(conditions, "tags @> "+arg(pq.Array(filter.Tags))+"::text[]")
+	}
+	if filter.Search != "" {
+		pattern := arg("%" + filter.Search + "%")
+		conditions = append(conditions, "(title ILIKE "+pattern+" OR description ILIKE "+pattern+")")
+	}
+	if !filter.DueFrom.IsZero() {
+		conditions = append(conditions, "due_date >= "+arg(filter.DueFrom))
+	}
+	if !filter.DueTo.IsZero() {
+		conditions = append(conditions, "due_date <= "+arg(filter.DueTo))
+	}
+	if filter.Project != "" {
+		conditions = append(conditions, "project = "+arg(filter.Project))
+	}
+	if !filter.AvailableAt.IsZero() {
+		at := arg(filter.AvailableAt)
+		conditions = append(conditions, "(start_at IS NULL OR start_at <= "+at+") AND (snoozed_until IS NULL OR snoozed_until <= "+at+")")
+	}
+	for _, condition := range filter.CustomFields {
+		conditions = append(conditions, customFieldCondition(condition, arg))
+	}
+
+	order, ok := sortColumns[filter.SortBy]
+	if key, isCustom := strings.CutPrefix(filter.SortBy, entity.SortByCustomFieldPrefix); isCustom {
+		// jsonb сравнивает числа как числа, строки - лексикографически
+		order, ok = "custom_fields -> "+arg(key)+"::text", true
+	}
+	if !ok {
+		order = sortColumns[entity.SortByCreatedAt]
+	}
+	direction := " ASC NULLS LAST"
+	if filter.SortDesc {
+		direction = " DESC NULLS LAST"
+	}
+
+	query := `SELECT ` + taskColumns + ` FROM tasks WHERE ` + strings.Join(conditions, " AND ") +
+		` ORDER BY ` + order + direction + `, id`
+	return query, args
+}
+
+// customFieldOps - SQL операторы сравнения пользовательских полей
+var customFieldOps = map[string]string{
+	entity.CustomFieldOpGt:  ">",
+	entity.CustomFieldOpGte: ">=",
+	entity.CustomFieldOpLt:  "<",
+	entity.CustomFieldOpLte: "<=",
+}
+
+// customFieldCondition строит условие по пользовательскому полю.
+// Равенство проверяется через @>, чтобы использовать GIN индекс; числа сравниваются как numeric
+func customFieldCondition(condition entity.CustomFieldCondition, arg func(any) string) string {
+	op, ok := customFieldOps[condition.Op]
+	if !ok {
+		value, _ := json.Marshal(map[string]any{condition.Key: condition.Value})
+		return "custom_fields @> " + arg(string(value)) + "::jsonb"
+	}
+	if number, isNumber := condition.Value.(float64); isNumber {
+		return "(custom_fields ->> " + arg(condition.Key) + "::text)::numeric " + op + " " + arg(number)
+	}
+	return "custom_fields ->> " + arg(condition.Key) + "::text " + op + " " + arg(condition.Value)
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanTask(row rowScanner) (entity.Task, error) {
+	var (
+		task         entity.Task
+		dueDate      sql.NullTime
+		externalUID  sql.NullString
+		completedAt  sql.NullTime
+		parentID     sql.NullString
+		customFields []byte
+		slaResponse  sql.NullTime
+		slaDue       sql.NullTime
+		respondedAt  sql.NullTime
+		startAt      sql.NullTime
+		snoozedUntil sql.NullTime
+	)
+	err := row.Scan(
+		&task.ID,
+		&task.Title,
+		&task.Description,
+		&task.Priority,
+		&task.Status,
+		pq.Array(&task.Tags), // Используем pq.Array для сканирования массива
+		&task.User_id,
+		&task.CreatedAt,
+		&task.UpdatedAt,
+		&dueDate,
+		&externalUID,
+		&completedAt,
+		&task.Estimate,
+		&parentID,
+		&task.Project,
+		&customFields,
+		&slaResponse,
+		&slaDue,
+		&respondedAt,
+		&task.SLAStatus,
+		&startAt,
+		&snoozedUntil,
+	)
+	if err != nil {
+		return entity.Task{}, err
+	}
+	task.DueDate = dueDate.Time
+	task.ExternalUID = externalUID.String
+	task.CompletedAt = completedAt.Time
+	task.ParentID = parentID.String
+	task.SLAResponseDueAt = slaResponse.Time
+	task.SLADueAt = slaDue.Time
+	task.RespondedAt = respondedAt.Time
+	task.StartAt = startAt.Time
+	task.SnoozedUntil = snoozedUntil.Time
+	if err := json.Unmarshal(customFields, &task.CustomFields); err != nil {
+		return entity.Task{}, err
+	}
+	return task, nil
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+func marshalCustomFields(values map[string]any) ([]byte, error) {
+	if values == nil {
+		values = map[string]any{}
+	}
+	return json.Marshal(values)
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
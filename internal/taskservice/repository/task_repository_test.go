@@ -0,0 +1,670 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	_ "github.com/lib/pq"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+// Один набор проверок запускается для реализации в памяти и для Postgres. Postgres проверяется,
+// только если TEST_POSTGRES_DSN указывает на базу с примененными миграциями
+
+func TestMemoryTaskRepository(t *testing.T) {
+	testTaskRepository(t, func(t *testing.T) (TaskRepository, func() string) {
+		return NewMemoryTaskRepository(), func() string { return uuid.Must(uuid.NewV4()).String() }
+	})
+}
+
+func TestPostgresTaskRepository(t *testing.T) {
+	db := openTestDB(t)
+	// чек-листы задач читаются через ChecklistRepository, у репозитория в памяти есть свой ListItems
+	repo := struct {
+		TaskRepository
+		ChecklistRepository
+	}{NewTaskRepository(db, zap.NewNop()), NewChecklistRepository(db, zap.NewNop())}
+	testTaskRepository(t, func(t *testing.T) (TaskRepository, func() string) {
+		return repo, func() string { return createTestUser(t, db) }
+	})
+}
+
+// testTaskRepository проверяет контракт TaskRepository. newRepo возвращает репозиторий и функцию,
+// создающую нового владельца задач, поэтому проверки не видят задач друг друга
+func testTaskRepository(t *testing.T, newRepo func(t *testing.T) (TaskRepository, func() string)) {
+	ctx := context.Background()
+	setup := func(t *testing.T) (TaskRepository, string, func() string) {
+		repo, newUser := newRepo(t)
+		return repo, newUser(), newUser
+	}
+
+	t.Run("CreateAndGet", func(t *testing.T) {
+		repo, userId, _ := setup(t)
+		due := time.Date(2030, 5, 1, 12, 0, 0, 0, time.UTC)
+		task := &entity.Task{
+			Title:        "write docs",
+			Description:  "api reference",
+			Priority:     entity.PriorityHigh,
+			Status:       entity.StatusPending,
+			Tags:         []string{"docs", "api"},
+			DueDate:      due,
+			User_id:      userId,
+			Estimate:     2.5,
+			Project:      "core",
+			CustomFields: map[string]any{"points": 3, "team": "backend"},
+		}
+		if err := repo.CreateTask(ctx, task); err != nil {
+			t.Fatalf("CreateTask: %v", err)
+		}
+		if task.ID == "" || task.CreatedAt.IsZero() || task.UpdatedAt.IsZero() {
+			t.Fatalf("CreateTask did not fill id and timestamps: %+v", task)
+		}
+
+		got := mustGetTask(t, repo, task.ID)
+		if got.Title != task.Title || got.Description != task.Description || got.Priority != task.Priority ||
+			got.Status != task.Status || got.User_id != userId || got.Estimate != task.Estimate || got.Project != task.Project {
+			t.Errorf("GetTaskByID = %+v, want %+v", got, task)
+		}
+		if !slices.Equal(got.Tags, task.Tags) {
+			t.Errorf("tags = %v, want %v", got.Tags, task.Tags)
+		}
+		if !got.DueDate.Equal(due) {
+			t.Errorf("due date = %v, want %v", got.DueDate, due)
+		}
+		if !got.CompletedAt.IsZero() || got.ParentID != "" || got.ExternalUID != "" {
+			t.Errorf("unexpected optional fields: %+v", got)
+		}
+		if got.CustomFields["points"] != float64(3) || got.CustomFields["team"] != "backend" {
+			t.Errorf("custom fields = %v", got.CustomFields)
+		}
+		if got.CreatedAt.Sub(task.CreatedAt).Abs() > time.Millisecond {
+			t.Errorf("created at = %v, want %v", got.CreatedAt, task.CreatedAt)
+		}
+	})
+
+	t.Run("CompletedOnCreate", func(t *testing.T) {
+		repo, userId, _ := setup(t)
+		task := newTestTask(userId, "done already")
+		task.Status = entity.StatusCompleted
+		if err := repo.CreateTask(ctx, task); err != nil {
+			t.Fatalf("CreateTask: %v", err)
+		}
+		if task.CompletedAt.IsZero() || mustGetTask(t, repo, task.ID).CompletedAt.IsZero() {
+			t.Error("completed task has no completed_at")
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repo, userId, _ := setup(t)
+		missing := uuid.Must(uuid.NewV4())
+		if _, err := repo.GetTaskByID(ctx, missing); !errors.Is(err, ErrTaskNotFound) {
+			t.Errorf("GetTaskByID error = %v, want ErrTaskNotFound", err)
+		}
+		if _, err := repo.GetTaskByExternalUID(ctx, userId, "missing"); !errors.Is(err, ErrTaskNotFound) {
+			t.Errorf("GetTaskByExternalUID error = %v, want ErrTaskNotFound", err)
+		}
+		task := newTestTask(userId, "ghost")
+		task.ID = missing.String()
+		if err := repo.UpdateTask(ctx, task); !errors.Is(err, ErrTaskNotFound) {
+			t.Errorf("UpdateTask error = %v, want ErrTaskNotFound", err)
+		}
+	})
+
+	t.Run("ExternalUID", func(t *testing.T) {
+		repo, userId, newUser := setup(t)
+		task := newTestTask(userId, "imported")
+		task.ExternalUID = "ical-42"
+		mustCreateTask(t, repo, task)
+		mustCreateTask(t, repo, newTestTask(userId, "local"))
+
+		got, err := repo.GetTaskByExternalUID(ctx, userId, "ical-42")
+		if err != nil || got.ID != task.ID {
+			t.Fatalf("GetTaskByExternalUID = %v, %v, want %s", got.ID, err, task.ID)
+		}
+		if _, err := repo.GetTaskByExternalUID(ctx, newUser(), "ical-42"); !errors.Is(err, ErrTaskNotFound) {
+			t.Errorf("other user's lookup error = %v, want ErrTaskNotFound", err)
+		}
+		if _, err := repo.GetTaskByExternalUID(ctx, userId, ""); !errors.Is(err, ErrTaskNotFound) {
+			t.Errorf("empty uid lookup error = %v, want ErrTaskNotFound", err)
+		}
+	})
+
+	t.Run("CreateTaskTree", func(t *testing.T) {
+		repo, userId, _ := setup(t)
+		parent := newTestTask(userId, "release")
+		subtasks := []*entity.Task{newTestTask(userId, "build"), newTestTask(userId, "deploy")}
+		checklist := []entity.ChecklistItem{{Text: "tag", Position: 0}, {Text: "changelog", Position: 1}}
+		if err := repo.CreateTaskTree(ctx, parent, subtasks, checklist); err != nil {
+			t.Fatalf("CreateTaskTree: %v", err)
+		}
+		assertChecklist(t, repo, parent.ID, []string{"tag", "changelog"}, nil)
+		assertChecklist(t, repo, subtasks[0].ID, nil, nil)
+		for _, subtask := range subtasks {
+			if subtask.ParentID != parent.ID {
+				t.Errorf("subtask parent = %q, want %q", subtask.ParentID, parent.ID)
+			}
+			if got := mustGetTask(t, repo, subtask.ID); got.ParentID != parent.ID {
+				t.Errorf("stored subtask parent = %q, want %q", got.ParentID, parent.ID)
+			}
+		}
+	})
+
+	t.Run("CloneTaskTree", func(t *testing.T) {
+		repo, userId, _ := setup(t)
+		copies := []entity.TaskCopy{
+			{Task: newTestTask(userId, "release copy"), Parent: -1, Checklist: []entity.ChecklistItem{
+				{Text: "notes", Done: true, Position: 1},
+				{Text: "tag", Position: 0},
+			}},
+			{Task: newTestTask(userId, "build copy"), Parent: 0},
+			{Task: newTestTask(userId, "compile copy"), Parent: 1, Checklist: []entity.ChecklistItem{{Text: "lint", Position: 0}}},
+		}
+		if err := repo.CloneTaskTree(ctx, copies); err != nil {
+			t.Fatalf("CloneTaskTree: %v", err)
+		}
+		assertChecklist(t, repo, copies[0].Task.ID, []string{"tag", "notes"}, []bool{false, true})
+		assertChecklist(t, repo, copies[1].Task.ID, nil, nil)
+		assertChecklist(t, repo, copies[2].Task.ID, []string{"lint"}, nil)
+		if got := mustGetTask(t, repo, copies[0].Task.ID); got.ParentID != "" {
+			t.Errorf("root parent = %q, want none", got.ParentID)
+		}
+		for _, c := range copies[1:] {
+			want := copies[c.Parent].Task.ID
+			if got := mustGetTask(t, repo, c.Task.ID); got.ParentID != want {
+				t.Errorf("%s parent = %q, want %q", c.Task.Title, got.ParentID, want)
+			}
+		}
+	})
+
+	t.Run("UpdateTask", func(t *testing.T) {
+		repo, userId, newUser := setup(t)
+		task := newTestTask(userId, "draft")
+		task.ExternalUID = "ext-1"
+		mustCreateTask(t, repo, task)
+		createdAt := mustGetTask(t, repo, task.ID).CreatedAt
+
+		update := mustGetTask(t, repo, task.ID)
+		update.Title = "final"
+		update.Tags = []string{"release"}
+		update.CustomFields = map[string]any{"points": 5}
+		update.StartAt = time.Date(2030, 2, 1, 9, 0, 0, 0, time.UTC)
+		update.SnoozedUntil = time.Date(2030, 1, 15, 9, 0, 0, 0, time.UTC)
+		update.ExternalUID = "ignored"
+		update.User_id = newUser()
+		if err := repo.UpdateTask(ctx, &update); err != nil {
+			t.Fatalf("UpdateTask: %v", err)
+		}
+
+		got := mustGetTask(t, repo, task.ID)
+		if got.Title != "final" || !slices.Equal(got.Tags, []string{"release"}) || got.CustomFields["points"] != float64(5) {
+			t.Errorf("updated task = %+v", got)
+		}
+		if !got.StartAt.Equal(update.StartAt) || !got.SnoozedUntil.Equal(update.SnoozedUntil) {
+			t.Errorf("start at = %v, snoozed until = %v", got.StartAt, got.SnoozedUntil)
+		}
+		if got.User_id != userId || got.ExternalUID != "ext-1" || !got.CreatedAt.Equal(createdAt) {
+			t.Errorf("UpdateTask changed immutable fields: %+v", got)
+		}
+		if got.UpdatedAt.Before(createdAt) {
+			t.Errorf("updated at %v is before created at %v", got.UpdatedAt, createdAt)
+		}
+	})
+
+	t.Run("SLAStatus", func(t *testing.T) {
+		repo, userId, _ := setup(t)
+		task := newTestTask(userId, "tracked")
+		task.SLADueAt = time.Now().Add(time.Hour)
+		task.SLAStatus = entity.SLAStatusAtRisk
+		mustCreateTask(t, repo, task)
+
+		// устаревшая копия не затирает статус SLA, пока статус задачи не меняется
+		stale := mustGetTask(t, repo, task.ID)
+		stale.SLAStatus = entity.SLAStatusOnTrack
+		stale.Title = "renamed"
+		if err := repo.UpdateTask(ctx, &stale); err != nil {
+			t.Fatalf("UpdateTask: %v", err)
+		}
+		if got := mustGetTask(t, repo, task.ID).SLAStatus; got != entity.SLAStatusAtRisk {
+			t.Errorf("sla status after edit = %q, want %q", got, entity.SLAStatusAtRisk)
+		}
+
+		steps := []struct{ status, want string }{
+			{entity.StatusCompleted, entity.SLAStatusMet},
+			{entity.StatusInProgress, entity.SLAStatusOnTrack},
+		}
+		for _, step := range steps {
+			update := mustGetTask(t, repo, task.ID)
+			update.Status = step.status
+			update.SLAStatus = ""
+			if err := repo.UpdateTask(ctx, &update); err != nil {
+				t.Fatalf("UpdateTask(%s): %v", step.status, err)
+			}
+			if got := mustGetTask(t, repo, task.ID).SLAStatus; got != step.want || update.SLAStatus != step.want {
+				t.Errorf("sla status after %s = %q (returned %q), want %q", step.status, got, update.SLAStatus, step.want)
+			}
+		}
+	})
+
+	t.Run("StatusHistory", func(t *testing.T) {
+		repo, userId, _ := setup(t)
+		task := newTestTask(userId, "flow")
+		mustCreateTask(t, repo, task)
+		other := newTestTask(userId, "other")
+		mustCreateTask(t, repo, other)
+
+		for _, status := range []string{entity.StatusInProgress, entity.StatusInProgress, entity.StatusCompleted} {
+			task.Status = status
+			if err := repo.UpdateTask(ctx, task); err != nil {
+				t.Fatalf("UpdateTask: %v", err)
+			}
+		}
+		if got := mustGetTask(t, repo, task.ID); got.CompletedAt.IsZero() || !got.CompletedAt.Equal(got.UpdatedAt) {
+			t.Errorf("completed at = %v, updated at = %v", got.CompletedAt, got.UpdatedAt)
+		}
+
+		task.Status = entity.StatusPending
+		if err := repo.UpdateTask(ctx, task); err != nil {
+			t.Fatalf("UpdateTask: %v", err)
+		}
+		if got := mustGetTask(t, repo, task.ID); !got.CompletedAt.IsZero() {
+			t.Errorf("reopened task keeps completed at %v", got.CompletedAt)
+		}
+
+		history, err := repo.ListStatusHistory(ctx, []string{task.ID})
+		if err != nil {
+			t.Fatalf("ListStatusHistory: %v", err)
+		}
+		var statuses []string
+		for i, change := range history {
+			if change.TaskID != task.ID {
+				t.Errorf("history contains task %s", change.TaskID)
+			}
+			if i > 0 && change.ChangedAt.Before(history[i-1].ChangedAt) {
+				t.Errorf("history is not ordered by time: %v", history)
+			}
+			statuses = append(statuses, change.Status)
+		}
+		want := []string{entity.StatusPending, entity.StatusInProgress, entity.StatusCompleted, entity.StatusPending}
+		if !slices.Equal(statuses, want) {
+			t.Errorf("history = %v, want %v", statuses, want)
+		}
+	})
+
+	t.Run("ListFilters", func(t *testing.T) {
+		repo, userId, newUser := setup(t)
+		day := time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC)
+
+		bug := newTestTask(userId, "Fix login bug")
+		bug.Priority = entity.PriorityCritical
+		bug.Tags = []string{"bug", "auth"}
+		bug.DueDate = day
+		bug.Project = "web"
+		bug.CustomFields = map[string]any{"points": 8, "team": "auth"}
+
+		docs := newTestTask(userId, "Write docs")
+		docs.Description = "describe the LOGIN flow"
+		docs.Status = entity.StatusInProgress
+		docs.Tags = []string{"docs"}
+		docs.DueDate = day.AddDate(0, 0, 5)
+		docs.Project = "web"
+		docs.CustomFields = map[string]any{"points": 2, "team": "docs"}
+		docs.StartAt = day.AddDate(0, 0, 2)
+
+		chore := newTestTask(userId, "Upgrade deps")
+		chore.Priority = entity.PriorityLow
+		chore.Tags = []string{"chore", "bug"}
+		chore.SnoozedUntil = day.AddDate(0, 0, 1)
+
+		for _, task := range []*entity.Task{bug, docs, chore} {
+			mustCreateTask(t, repo, task)
+		}
+		mustCreateTask(t, repo, newTestTask(newUser(), "Fix someone else's bug"))
+
+		cases := []struct {
+			name   string
+			filter entity.TaskFilter
+			want   []*entity.Task
+		}{
+			{"all", entity.TaskFilter{}, []*entity.Task{bug, docs, chore}},
+			{"statuses", entity.TaskFilter{Statuses: []string{entity.StatusInProgress}}, []*entity.Task{docs}},
+			{"priorities", entity.TaskFilter{Priorities: []string{entity.PriorityCritical, entity.PriorityLow}}, []*entity.Task{bug, chore}},
+			{"tags", entity.TaskFilter{Tags: []string{"bug", "auth"}}, []*entity.Task{bug}},
+			{"search", entity.TaskFilter{Search: "login"}, []*entity.Task{bug, docs}},
+			{"due from", entity.TaskFilter{DueFrom: day.AddDate(0, 0, 1)}, []*entity.Task{docs}},
+			{"due to", entity.TaskFilter{DueTo: day}, []*entity.Task{bug}},
+			{"project", entity.TaskFilter{Project: "web"}, []*entity.Task{bug, docs}},
+			{"available", entity.TaskFilter{AvailableAt: day}, []*entity.Task{bug}},
+			{"available at start", entity.TaskFilter{AvailableAt: day.AddDate(0, 0, 2)}, []*entity.Task{bug, docs, chore}},
+			{"custom eq", entity.TaskFilter{CustomFields: []entity.CustomFieldCondition{
+				{Key: "team", Op: entity.CustomFieldOpEq, Value: "docs"},
+			}}, []*entity.Task{docs}},
+			{"custom numeric", entity.TaskFilter{CustomFields: []entity.CustomFieldCondition{
+				{Key: "points", Op: entity.CustomFieldOpGte, Value: float64(5)},
+			}}, []*entity.Task{bug}},
+			{"custom text", entity.TaskFilter{CustomFields: []entity.CustomFieldCondition{
+				{Key: "team", Op: entity.CustomFieldOpLt, Value: "b"},
+			}}, []*entity.Task{bug}},
+		}
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				tc.filter.UserID = userId
+				tasks, err := repo.ListTasks(ctx, tc.filter)
+				if err != nil {
+					t.Fatalf("ListTasks: %v", err)
+				}
+				got, want := taskIDs(tasks), createdIDs(tc.want)
+				slices.Sort(got)
+				slices.Sort(want)
+				if !slices.Equal(got, want) {
+					t.Errorf("ListTasks = %v, want %v", got, want)
+				}
+			})
+		}
+	})
+
+	t.Run("ListSorting", func(t *testing.T) {
+		repo, userId, _ := setup(t)
+		day := time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC)
+
+		alpha := newTestTask(userId, "alpha")
+		alpha.Priority = entity.PriorityLow
+		alpha.DueDate = day.AddDate(0, 0, 2)
+		alpha.CustomFields = map[string]any{"points": 10}
+
+		beta := newTestTask(userId, "beta")
+		beta.Priority = entity.PriorityCritical
+		beta.CustomFields = map[string]any{"points": 2}
+
+		gamma := newTestTask(userId, "gamma")
+		gamma.Priority = entity.PriorityHigh
+		gamma.DueDate = day
+
+		for _, task := range []*entity.Task{gamma, alpha, beta} {
+			mustCreateTask(t, repo, task)
+		}
+
+		cases := []struct {
+			sortBy string
+			desc   bool
+			want   []*entity.Task
+		}{
+			{entity.SortByTitle, false, []*entity.Task{alpha, beta, gamma}},
+			{entity.SortByTitle, true, []*entity.Task{gamma, beta, alpha}},
+			{entity.SortByPriority, true, []*entity.Task{beta, gamma, alpha}},
+			{entity.SortByDueDate, false, []*entity.Task{gamma, alpha, beta}},
+			{entity.SortByDueDate, true, []*entity.Task{alpha, gamma, beta}},
+			{entity.SortByCustomFieldPrefix + "points", false, []*entity.Task{beta, alpha, gamma}},
+			{entity.SortByCustomFieldPrefix + "points", true, []*entity.Task{alpha, beta, gamma}},
+		}
+		for _, tc := range cases {
+			tasks, err := repo.ListTasks(ctx, entity.TaskFilter{UserID: userId, SortBy: tc.sortBy, SortDesc: tc.desc})
+			if err != nil {
+				t.Fatalf("ListTasks: %v", err)
+			}
+			if got, want := taskIDs(tasks), createdIDs(tc.want); !slices.Equal(got, want) {
+				t.Errorf("sort %s desc=%v = %v, want %v", tc.sortBy, tc.desc, got, want)
+			}
+		}
+	})
+
+	t.Run("ListTasksForStats", func(t *testing.T) {
+		repo, userId, _ := setup(t)
+		open := newTestTask(userId, "open")
+		open.Tags = []string{"stats"}
+		closed := newTestTask(userId, "closed")
+		closed.Status = entity.StatusCompleted
+		closed.Tags = []string{"stats"}
+		closedLate := newTestTask(userId, "closed but due later")
+		closedLate.Status = entity.StatusCompleted
+		closedLate.DueDate = time.Now().Add(90 * time.Minute)
+		untagged := newTestTask(userId, "untagged")
+		for _, task := range []*entity.Task{open, closed, closedLate, untagged} {
+			mustCreateTask(t, repo, task)
+		}
+
+		// период целиком в будущем: задачи, закрытые сейчас, завершены до его начала
+		filter := entity.StatsFilter{UserID: userId, From: time.Now().Add(time.Hour), To: time.Now().Add(2 * time.Hour)}
+		cases := []struct {
+			name          string
+			tags          []string
+			includeClosed bool
+			want          []*entity.Task
+		}{
+			{"open period", nil, false, []*entity.Task{open, closedLate, untagged}},
+			{"include closed", nil, true, []*entity.Task{open, closed, closedLate, untagged}},
+			{"tags", []string{"stats"}, true, []*entity.Task{open, closed}},
+		}
+		for _, tc := range cases {
+			filter.Tags, filter.IncludeClosed = tc.tags, tc.includeClosed
+			tasks, err := repo.ListTasksForStats(ctx, filter)
+			if err != nil {
+				t.Fatalf("ListTasksForStats: %v", err)
+			}
+			got, want := taskIDs(tasks), createdIDs(tc.want)
+			slices.Sort(got)
+			slices.Sort(want)
+			if !slices.Equal(got, want) {
+				t.Errorf("%s: ListTasksForStats = %v, want %v", tc.name, got, want)
+			}
+		}
+
+		filter.To = time.Now().Add(-time.Hour)
+		if tasks, err := repo.ListTasksForStats(ctx, filter); err != nil || len(tasks) != 0 {
+			t.Errorf("tasks created after the period: %v, %v", taskIDs(tasks), err)
+		}
+	})
+
+	t.Run("ListCalendarTasks", func(t *testing.T) {
+		repo, userId, _ := setup(t)
+		from := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+		to := from.Add(48 * time.Hour)
+
+		due := newTestTask(userId, "due inside")
+		due.DueDate = from.Add(time.Hour)
+		starts := newTestTask(userId, "starts inside")
+		starts.StartAt = from
+		starts.Project = "calendar"
+		both := newTestTask(userId, "due after, starts inside")
+		both.StartAt = to.Add(-time.Minute)
+		both.DueDate = to.Add(time.Hour)
+		atEnd := newTestTask(userId, "due at the end")
+		atEnd.DueDate = to
+		undated := newTestTask(userId, "undated")
+		for _, task := range []*entity.Task{due, starts, both, atEnd, undated} {
+			mustCreateTask(t, repo, task)
+		}
+
+		cases := []struct {
+			name    string
+			project string
+			want    []*entity.Task
+		}{
+			{"range", "", []*entity.Task{due, starts, both}},
+			{"project", "calendar", []*entity.Task{starts}},
+		}
+		for _, tc := range cases {
+			tasks, err := repo.ListCalendarTasks(ctx, entity.CalendarFilter{UserID: userId, Project: tc.project, From: from, To: to})
+			if err != nil {
+				t.Fatalf("ListCalendarTasks: %v", err)
+			}
+			got, want := taskIDs(tasks), createdIDs(tc.want)
+			slices.Sort(got)
+			slices.Sort(want)
+			if !slices.Equal(got, want) {
+				t.Errorf("%s: ListCalendarTasks = %v, want %v", tc.name, got, want)
+			}
+		}
+	})
+
+	t.Run("ReturnsCopies", func(t *testing.T) {
+		repo, userId, _ := setup(t)
+		task := newTestTask(userId, "immutable")
+		task.Tags = []string{"a"}
+		task.CustomFields = map[string]any{"team": "core"}
+		mustCreateTask(t, repo, task)
+
+		task.Tags[0] = "changed"
+		task.CustomFields["team"] = "changed"
+		got := mustGetTask(t, repo, task.ID)
+		got.Tags[0] = "changed"
+		got.CustomFields["team"] = "changed"
+
+		got = mustGetTask(t, repo, task.ID)
+		if got.Tags[0] != "a" || got.CustomFields["team"] != "core" {
+			t.Errorf("stored task was modified through a shared reference: %+v", got)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		repo, userId, _ := setup(t)
+		const workers = 8
+
+		var wg sync.WaitGroup
+		errs := make(chan error, workers)
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				task := newTestTask(userId, "parallel")
+				if err := repo.CreateTask(ctx, task); err != nil {
+					errs <- err
+					return
+				}
+				task.Status = entity.StatusInProgress
+				if err := repo.UpdateTask(ctx, task); err != nil {
+					errs <- err
+					return
+				}
+				if _, err := repo.ListTasks(ctx, entity.TaskFilter{UserID: userId}); err != nil {
+					errs <- err
+				}
+			}()
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			t.Error(err)
+		}
+
+		tasks, err := repo.ListTasks(ctx, entity.TaskFilter{UserID: userId, Statuses: []string{entity.StatusInProgress}})
+		if err != nil || len(tasks) != workers {
+			t.Errorf("ListTasks = %d tasks, %v, want %d", len(tasks), err, workers)
+		}
+	})
+}
+
+func newTestTask(userId, title string) *entity.Task {
+	return &entity.Task{
+		Title:    title,
+		Priority: entity.PriorityNormal,
+		Status:   entity.StatusPending,
+		Tags:     []string{},
+		User_id:  userId,
+	}
+}
+
+func mustCreateTask(t *testing.T, repo TaskRepository, task *entity.Task) {
+	t.Helper()
+	if err := repo.CreateTask(context.Background(), task); err != nil {
+		t.Fatalf("CreateTask(%q): %v", task.Title, err)
+	}
+}
+
+func mustGetTask(t *testing.T, repo TaskRepository, taskId string) entity.Task {
+	t.Helper()
+	task, err := repo.GetTaskByID(context.Background(), uuid.FromStringOrNil(taskId))
+	if err != nil {
+		t.Fatalf("GetTaskByID(%s): %v", taskId, err)
+	}
+	return task
+}
+
+// assertChecklist сравнивает тексты и отметки пунктов чек-листа задачи; done = nil - ни один не выполнен
+func assertChecklist(t *testing.T, repo TaskRepository, taskId string, texts []string, done []bool) {
+	t.Helper()
+	lister, ok := repo.(interface {
+		ListItems(ctx context.Context, taskId string) ([]entity.ChecklistItem, error)
+	})
+	if !ok {
+		t.Fatalf("%T cannot list checklist items", repo)
+	}
+	items, err := lister.ListItems(context.Background(), taskId)
+	if err != nil {
+		t.Fatalf("ListItems(%s): %v", taskId, err)
+	}
+	if done == nil {
+		done = make([]bool, len(texts))
+	}
+	var gotTexts []string
+	var gotDone []bool
+	for _, item := range items {
+		if item.ID == "" || item.TaskID != taskId || item.CreatedAt.IsZero() {
+			t.Errorf("checklist item = %+v", item)
+		}
+		gotTexts = append(gotTexts, item.Text)
+		gotDone = append(gotDone, item.Done)
+	}
+	if !slices.Equal(gotTexts, texts) || !slices.Equal(gotDone, done) {
+		t.Errorf("checklist of %s = %v %v, want %v %v", taskId, gotTexts, gotDone, texts, done)
+	}
+}
+
+func taskIDs(tasks []entity.Task) []string {
+	ids := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	return ids
+}
+
+func createdIDs(tasks []*entity.Task) []string {
+	ids := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	return ids
+}
+
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN is not set")
+	}
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("open postgres: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := db.Ping(); err != nil {
+		t.Fatalf("ping postgres: %v", err)
+	}
+	return db
+}
+
+// createTestUser добавляет владельца задач; его удаление каскадно удаляет задачи и историю статусов
+func createTestUser(t *testing.T, db *sql.DB) string {
+	t.Helper()
+	userId := uuid.Must(uuid.NewV4()).String()
+	_, err := db.Exec(
+		`INSERT INTO users (id, email, password_hash, username) VALUES ($1, $2, 'hash', 'conformance')`,
+		userId, userId+"@example.test",
+	)
+	if err != nil {
+		t.Fatalf("create test user: %v", err)
+	}
+	t.Cleanup(func() {
+		if _, err := db.Exec(`DELETE FROM users WHERE id = $1`, userId); err != nil {
+			t.Errorf("delete test user: %v", err)
+		}
+	})
+	return userId
+}
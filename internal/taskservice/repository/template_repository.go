@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/lib/pq"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrTemplateNotFound = errors.New("task template not found")
+	ErrTemplateExists   = errors.New("task template with this name already exists")
+)
+
+type TemplateRepository interface {
+	Create(ctx context.Context, template *entity.TaskTemplate) error
+	GetByID(ctx context.Context, templateId, userId string) (*entity.TaskTemplate, error)
+	ListByUser(ctx context.Context, userId string) ([]entity.TaskTemplate, error)
+	Update(ctx context.Context, template *entity.TaskTemplate) error
+	Delete(ctx context.Context, templateId, userId string) error
+}
+
+const templateColumns = `id, user_id, name, title, description, priority, tags, due_offset, checklist, subtasks, created_at, updated_at`
+
+type templateRepository struct {
+	db  *sql.DB
+	Log *zap.Logger
+}
+
+// NewTemplateRepository создает репозиторий шаблонов задач
+func NewTemplateRepository(db *sql.DB, Log *zap.Logger) TemplateRepository {
+	return &templateRepository{db: db, Log: Log}
+}
+
+func (r *templateRepository) Create(ctx context.Context, template *entity.TaskTemplate) error {
+	query := `
+		INSERT INTO task_templates (` + templateColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+	randomUUID, err := uuid.NewV4()
+	if err != nil {
+		r.Log.Error("Failed generate random UUID", zap.Error(err))
+		return err
+	}
+	checklist, subtasks, err := marshalTemplate(template)
+	if err != nil {
+		return err
+	}
+
+	template.ID = randomUUID.String()
+	template.CreatedAt = time.Now()
+	template.UpdatedAt = template.CreatedAt
+
+	_, err = r.db.ExecContext(ctx, query,
+		template.ID,
+		template.UserID,
+		template.Name,
+		template.Title,
+		template.Description,
+		template.Priority,
+		pq.Array(template.Tags),
+		template.DueOffset,
+		checklist,
+		subtasks,
+		template.CreatedAt,
+		template.UpdatedAt,
+	)
+	if isUniqueViolation(err) {
+		return ErrTemplateExists
+	}
+	return err
+}
+
+func (r *templateRepository) GetByID(ctx context.Context, templateId, userId string) (*entity.TaskTemplate, error) {
+	query := `SELECT ` + templateColumns + ` FROM task_templates WHERE id = $1 AND user_id = $2`
+	template, err := scanTemplate(r.db.QueryRowContext(ctx, query, templateId, userId))
+	if err == sql.ErrNoRows {
+		return nil, ErrTemplateNotFound
+	}
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's GetByID task template", zap.Error(err))
+		return nil, err
+	}
+	return template, nil
+}
+
+func (r *templateRepository) ListByUser(ctx context.Context, userId string) ([]entity.TaskTemplate, error) {
+	query := `SELECT ` + templateColumns + ` FROM task_templates WHERE user_id = $1 ORDER BY name`
+	rows, err := r.db.QueryContext(ctx, query, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var templates []entity.TaskTemplate
+	for rows.Next() {
+		template, err := scanTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, *template)
+	}
+	return templates, rows.Err()
+}
+
+func (r *templateRepository) Update(ctx context.Context, template *entity.TaskTemplate) error {
+	query := `
+		UPDATE task_templates
+		SET name = $3, title = $4, description = $5, priority = $6, tags = $7, due_offset = $8,
+		    checklist = $9, subtasks = $10, updated_at = $11
+		WHERE id = $1 AND user_id = $2
+	`
+	checklist, subtasks, err := marshalTemplate(template)
+	if err != nil {
+		return err
+	}
+	template.UpdatedAt = time.Now()
+
+	result, err := r.db.ExecContext(ctx, query,
+		template.ID,
+		template.UserID,
+		template.Name,
+		template.Title,
+		template.Description,
+		template.Priority,
+		pq.Array(template.Tags),
+		template.DueOffset,
+		checklist,
+		subtasks,
+		template.UpdatedAt,
+	)
+	if isUniqueViolation(err) {
+		return ErrTemplateExists
+	}
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's Update task template", zap.Error(err))
+		return err
+	}
+	return expectAffected(result, ErrTemplateNotFound)
+}
+
+func (r *templateRepository) Delete(ctx context.Context, templateId, userId string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM task_templates WHERE id = $1 AND user_id = $2`, templateId, userId)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's Delete task template", zap.Error(err))
+		return err
+	}
+	return expectAffected(result, ErrTemplateNotFound)
+}
+
+func marshalTemplate(template *entity.TaskTemplate) ([]byte, []byte, error) {
+	checklist := template.Checklist
+	if checklist == nil {
+		checklist = []string{}
+	}
+	subtasks := template.Subtasks
+	if subtasks == nil {
+		subtasks = []entity.SubtaskBlueprint{}
+	}
+
+	checklistJSON, err := json.Marshal(checklist)
+	if err != nil {
+		return nil, nil, err
+	}
+	subtasksJSON, err := json.Marshal(subtasks)
+	if err != nil {
+		return nil, nil, err
+	}
+	return checklistJSON, subtasksJSON, nil
+}
+
+func scanTemplate(row rowScanner) (*entity.TaskTemplate, error) {
+	var (
+		template            entity.TaskTemplate
+		checklist, subtasks []byte
+	)
+	err := row.Scan(
+		&template.ID,
+		&template.UserID,
+		&template.Name,
+		&template.Title,
+		&template.Description,
+		&template.Priority,
+		pq.Array(&template.Tags),
+		&template.DueOffset,
+		&checklist,
+		&subtasks,
+		&template.CreatedAt,
+		&template.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(checklist, &template.Checklist); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(subtasks, &template.Subtasks); err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
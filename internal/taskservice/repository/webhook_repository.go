@@ -0,0 +1,385 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/lib/pq"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrWebhookNotFound         = errors.New("webhook not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+)
+
+type WebhookRepository interface {
+	Create(ctx context.Context, hook *entity.WebhookSubscription) error
+	GetByID(ctx context.Context, webhookId, userId string) (*entity.WebhookSubscription, error)
+	ListByUser(ctx context.Context, userId string) ([]entity.WebhookSubscription, error)
+	// Update меняет подписку; пустой Secret оставляет прежний секрет
+	Update(ctx context.Context, hook *entity.WebhookSubscription) error
+	Delete(ctx context.Context, webhookId, userId string) error
+
+	// ListMatchingWebhooks возвращает активные подписки пользователя на событие задачи проекта
+	ListMatchingWebhooks(ctx context.Context, userId, project, eventType string) ([]entity.WebhookSubscription, error)
+	// CreateWebhookDeliveries ставит доставки в очередь; уже существующие пары подписка/событие пропускаются
+	CreateWebhookDeliveries(ctx context.Context, deliveries []entity.WebhookDelivery) error
+	ListWebhookDeliveries(ctx context.Context, webhookId, userId string, limit int) ([]entity.WebhookDelivery, error)
+	// RedeliverWebhook возвращает доставку в очередь с обнуленным счетчиком попыток
+	RedeliverWebhook(ctx context.Context, deliveryId, webhookId, userId string) (*entity.WebhookDelivery, error)
+	// ClaimWebhookDeliveries выбирает готовые к отправке доставки активных подписок и откладывает их
+	// до leaseUntil, чтобы другой экземпляр не отправил их одновременно
+	ClaimWebhookDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]entity.WebhookDelivery, error)
+	// SaveWebhookDelivery сохраняет результат попытки отправки
+	SaveWebhookDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error
+}
+
+const webhookColumns = `id, user_id, project, url, event_types, secret, active, created_at, updated_at`
+
+const webhookDeliveryColumns = `d.id, d.subscription_id, d.event_id, d.event_type, d.payload, d.status, d.attempts, d.response_status,
+	d.response_body, d.last_error, d.duration_ms, d.next_attempt_at, d.delivered_at, d.created_at, d.updated_at`
+
+type webhookRepository struct {
+	db  *sql.DB
+	Log *zap.Logger
+}
+
+// NewWebhookRepository создает репозиторий подписок на вебхуки и журнала доставок
+func NewWebhookRepository(db *sql.DB, Log *zap.Logger) WebhookRepository {
+	return &webhookRepository{db: db, Log: Log}
+}
+
+func (r *webhookRepository) Create(ctx context.Context, hook *entity.WebhookSubscription) error {
+	query := `
+		INSERT INTO webhook_subscriptions (` + webhookColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	randomUUID, err := uuid.NewV4()
+	if err != nil {
+		r.Log.Error("Failed generate random UUID", zap.Error(err))
+		return err
+	}
+	hook.ID = randomUUID.String()
+	hook.CreatedAt = time.Now()
+	hook.UpdatedAt = hook.CreatedAt
+
+	_, err = r.db.ExecContext(ctx, query,
+		hook.ID,
+		hook.UserID,
+		hook.Project,
+		hook.URL,
+		pq.Array(hook.EventTypes),
+		hook.Secret,
+		hook.Active,
+		hook.CreatedAt,
+		hook.UpdatedAt,
+	)
+	return err
+}
+
+func (r *webhookRepository) GetByID(ctx context.Context, webhookId, userId string) (*entity.WebhookSubscription, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhook_subscriptions WHERE id = $1 AND user_id = $2`
+	hook, err := scanWebhook(r.db.QueryRowContext(ctx, query, webhookId, userId))
+	if err == sql.ErrNoRows {
+		return nil, ErrWebhookNotFound
+	}
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's GetByID webhook", zap.Error(err))
+		return nil, err
+	}
+	return hook, nil
+}
+
+func (r *webhookRepository) ListByUser(ctx context.Context, userId string) ([]entity.WebhookSubscription, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhook_subscriptions WHERE user_id = $1 ORDER BY created_at`
+	return r.listWebhooks(ctx, query, userId)
+}
+
+func (r *webhookRepository) Update(ctx context.Context, hook *entity.WebhookSubscription) error {
+	query := `
+		UPDATE webhook_subscriptions
+		SET project = $3, url = $4, event_types = $5, secret = COALESCE(NULLIF($6, ''), secret), active = $7, updated_at = $8
+		WHERE id = $1 AND user_id = $2
+	`
+	hook.UpdatedAt = time.Now()
+	result, err := r.db.ExecContext(ctx, query,
+		hook.ID,
+		hook.UserID,
+		hook.Project,
+		hook.URL,
+		pq.Array(hook.EventTypes),
+		hook.Secret,
+		hook.Active,
+		hook.UpdatedAt,
+	)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's Update webhook", zap.Error(err))
+		return err
+	}
+	return expectAffected(result, ErrWebhookNotFound)
+}
+
+func (r *webhookRepository) Delete(ctx context.Context, webhookId, userId string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM webhook_subscriptions WHERE id = $1 AND user_id = $2`, webhookId, userId)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's Delete webhook", zap.Error(err))
+		return err
+	}
+	return expectAffected(result, ErrWebhookNotFound)
+}
+
+func (r *webhookRepository) ListMatchingWebhooks(ctx context.Context, userId, project, eventType string) ([]entity.WebhookSubscription, error) {
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM webhook_subscriptions
+		WHERE user_id = $1 AND active
+		  AND (project = '' OR project = $2)
+		  AND (cardinality(event_types) = 0 OR $3 = ANY(event_types))
+	`
+	return r.listWebhooks(ctx, query, userId, project, eventType)
+}
+
+func (r *webhookRepository) listWebhooks(ctx context.Context, query string, args ...any) ([]entity.WebhookSubscription, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's list webhooks", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var hooks []entity.WebhookSubscription
+	for rows.Next() {
+		hook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		hooks = append(hooks, *hook)
+	}
+	return hooks, rows.Err()
+}
+
+func (r *webhookRepository) CreateWebhookDeliveries(ctx context.Context, deliveries []entity.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO webhook_deliveries (id, subscription_id, event_id, event_type, payload, status, next_attempt_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $7, $7)
+		ON CONFLICT (subscription_id, event_id) DO NOTHING
+	`
+	now := time.Now()
+	for i := range deliveries {
+		delivery := &deliveries[i]
+		randomUUID, err := uuid.NewV4()
+		if err != nil {
+			r.Log.Error("Failed generate random UUID", zap.Error(err))
+			return err
+		}
+		delivery.ID = randomUUID.String()
+		delivery.Status = entity.WebhookDeliveryPending
+		delivery.CreatedAt = now
+		delivery.UpdatedAt = now
+
+		_, err = tx.ExecContext(ctx, query,
+			delivery.ID,
+			delivery.SubscriptionID,
+			delivery.EventID,
+			delivery.EventType,
+			[]byte(delivery.Payload),
+			delivery.Status,
+			now,
+		)
+		if err != nil {
+			r.Log.Error("SQL error caused in repo's CreateWebhookDeliveries", zap.Error(err))
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *webhookRepository) ListWebhookDeliveries(ctx context.Context, webhookId, userId string, limit int) ([]entity.WebhookDelivery, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries d
+		JOIN webhook_subscriptions s ON s.id = d.subscription_id
+		WHERE d.subscription_id = $1 AND s.user_id = $2
+		ORDER BY d.created_at DESC
+		LIMIT $3
+	`
+	rows, err := r.db.QueryContext(ctx, query, webhookId, userId, limit)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's ListWebhookDeliveries", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []entity.WebhookDelivery
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, *delivery)
+	}
+	return deliveries, rows.Err()
+}
+
+func (r *webhookRepository) RedeliverWebhook(ctx context.Context, deliveryId, webhookId, userId string) (*entity.WebhookDelivery, error) {
+	query := `
+		UPDATE webhook_deliveries d
+		SET status = $4, attempts = 0, last_error = '', next_attempt_at = $5, delivered_at = NULL, updated_at = $5
+		FROM webhook_subscriptions s
+		WHERE d.id = $1 AND d.subscription_id = $2 AND s.id = d.subscription_id AND s.user_id = $3
+		RETURNING ` + webhookDeliveryColumns
+	delivery, err := scanWebhookDelivery(r.db.QueryRowContext(ctx, query,
+		deliveryId, webhookId, userId, entity.WebhookDeliveryPending, time.Now(),
+	))
+	if err == sql.ErrNoRows {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's RedeliverWebhook", zap.Error(err))
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (r *webhookRepository) ClaimWebhookDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]entity.WebhookDelivery, error) {
+	query := `
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = $2, updated_at = $1
+		FROM webhook_subscriptions s
+		WHERE s.id = d.subscription_id
+		  AND d.id IN (
+			SELECT pending.id
+			FROM webhook_deliveries pending
+			JOIN webhook_subscriptions sub ON sub.id = pending.subscription_id
+			WHERE pending.status = $4 AND pending.next_attempt_at <= $1 AND sub.active
+			ORDER BY pending.next_attempt_at
+			LIMIT $3
+			FOR UPDATE OF pending SKIP LOCKED
+		  )
+		RETURNING ` + webhookDeliveryColumns + `, s.url, s.secret
+	`
+	rows, err := r.db.QueryContext(ctx, query, now, leaseUntil, limit, entity.WebhookDeliveryPending)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's ClaimWebhookDeliveries", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []entity.WebhookDelivery
+	for rows.Next() {
+		var url, secret string
+		delivery, err := scanWebhookDelivery(rows, &url, &secret)
+		if err != nil {
+			return nil, err
+		}
+		delivery.URL, delivery.Secret = url, secret
+		deliveries = append(deliveries, *delivery)
+	}
+	return deliveries, rows.Err()
+}
+
+func (r *webhookRepository) SaveWebhookDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = $2, attempts = $3, response_status = $4, response_body = $5, last_error = $6, duration_ms = $7,
+		    next_attempt_at = $8, delivered_at = $9, updated_at = $10
+		WHERE id = $1
+	`
+	delivery.UpdatedAt = time.Now()
+	var nextAttemptAt, deliveredAt time.Time
+	if delivery.NextAttemptAt != nil {
+		nextAttemptAt = *delivery.NextAttemptAt
+	}
+	if delivery.DeliveredAt != nil {
+		deliveredAt = *delivery.DeliveredAt
+	}
+
+	result, err := r.db.ExecContext(ctx, query,
+		delivery.ID,
+		delivery.Status,
+		delivery.Attempts,
+		delivery.ResponseStatus,
+		delivery.ResponseBody,
+		delivery.LastError,
+		delivery.DurationMs,
+		nullTime(nextAttemptAt),
+		nullTime(deliveredAt),
+		delivery.UpdatedAt,
+	)
+	if err != nil {
+		r.Log.Error("SQL error caused in repo's SaveWebhookDelivery", zap.Error(err))
+		return err
+	}
+	return expectAffected(result, ErrWebhookDeliveryNotFound)
+}
+
+func scanWebhook(row rowScanner) (*entity.WebhookSubscription, error) {
+	var hook entity.WebhookSubscription
+	err := row.Scan(
+		&hook.ID,
+		&hook.UserID,
+		&hook.Project,
+		&hook.URL,
+		pq.Array(&hook.EventTypes),
+		&hook.Secret,
+		&hook.Active,
+		&hook.CreatedAt,
+		&hook.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &hook, nil
+}
+
+// scanWebhookDelivery читает колонки webhookDeliveryColumns и дополнительные колонки extra
+func scanWebhookDelivery(row rowScanner, extra ...any) (*entity.WebhookDelivery, error) {
+	var (
+		delivery      entity.WebhookDelivery
+		payload       []byte
+		nextAttemptAt sql.NullTime
+		deliveredAt   sql.NullTime
+	)
+	dest := []any{
+		&delivery.ID,
+		&delivery.SubscriptionID,
+		&delivery.EventID,
+		&delivery.EventType,
+		&payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.ResponseStatus,
+		&delivery.ResponseBody,
+		&delivery.LastError,
+		&delivery.DurationMs,
+		&nextAttemptAt,
+		&deliveredAt,
+		&delivery.CreatedAt,
+		&delivery.UpdatedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	delivery.Payload = payload
+	if nextAttemptAt.Valid && delivery.Status == entity.WebhookDeliveryPending {
+		delivery.NextAttemptAt = &nextAttemptAt.Time
+	}
+	if deliveredAt.Valid {
+		delivery.DeliveredAt = &deliveredAt.Time
+	}
+	return &delivery, nil
+}
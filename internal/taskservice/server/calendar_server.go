@@ -0,0 +1,42 @@
+package server
+
+import (
+	"context"
+
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *TaskServer) GetCalendar(ctx context.Context, req *task.GetCalendarRequest) (*task.GetCalendarResponse, error) {
+	calendar, err := s.taskService.GetCalendar(ctx, entity.CalendarQuery{
+		UserID:   req.UserId,
+		From:     req.From,
+		To:       req.To,
+		Timezone: req.Timezone,
+		Project:  req.Project,
+	})
+	if err != nil {
+		s.Log.Error("Error caused after calling the func GetCalendar", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &task.GetCalendarResponse{
+		From:     calendar.From,
+		To:       calendar.To,
+		Timezone: calendar.Timezone,
+	}
+	for _, day := range calendar.Days {
+		protoDay := &task.CalendarDay{Date: day.Date}
+		for _, entry := range day.Entries {
+			protoDay.Entries = append(protoDay.Entries, &task.CalendarEntry{
+				Kind: entry.Kind,
+				At:   timestamppb.New(entry.At),
+				Task: s.taskToProto(entry.Task),
+			})
+		}
+		resp.Days = append(resp.Days, protoDay)
+	}
+	return resp, nil
+}
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *TaskServer) GetChecklist(ctx context.Context, req *task.ChecklistRequest) (*task.ChecklistResponse, error) {
+	checklist, err := s.taskService.GetChecklist(ctx, req.UserId, req.TaskId)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func GetChecklist", zap.Error(err))
+		return nil, err
+	}
+	return checklistToProto(checklist), nil
+}
+
+func (s *TaskServer) AddChecklistItem(ctx context.Context, req *task.AddChecklistItemRequest) (*task.ChecklistResponse, error) {
+	checklist, err := s.taskService.AddChecklistItem(ctx, req.UserId, req.TaskId, req.Text)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func AddChecklistItem", zap.Error(err))
+		return nil, err
+	}
+	return checklistToProto(checklist), nil
+}
+
+func (s *TaskServer) ToggleChecklistItem(ctx context.Context, req *task.ChecklistItemRequest) (*task.ChecklistResponse, error) {
+	checklist, err := s.taskService.ToggleChecklistItem(ctx, req.UserId, req.TaskId, req.ItemId)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func ToggleChecklistItem", zap.Error(err))
+		return nil, err
+	}
+	return checklistToProto(checklist), nil
+}
+
+func (s *TaskServer) ReorderChecklist(ctx context.Context, req *task.ReorderChecklistRequest) (*task.ChecklistResponse, error) {
+	checklist, err := s.taskService.ReorderChecklist(ctx, req.UserId, req.TaskId, req.ItemIds)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func ReorderChecklist", zap.Error(err))
+		return nil, err
+	}
+	return checklistToProto(checklist), nil
+}
+
+func (s *TaskServer) DeleteChecklistItem(ctx context.Context, req *task.ChecklistItemRequest) (*task.ChecklistResponse, error) {
+	checklist, err := s.taskService.DeleteChecklistItem(ctx, req.UserId, req.TaskId, req.ItemId)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func DeleteChecklistItem", zap.Error(err))
+		return nil, err
+	}
+	return checklistToProto(checklist), nil
+}
+
+func (s *TaskServer) ConvertChecklistItem(ctx context.Context, req *task.ChecklistItemRequest) (*task.TaskResponse, error) {
+	createdTask, err := s.taskService.ConvertChecklistItem(ctx, req.UserId, req.TaskId, req.ItemId)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func ConvertChecklistItem", zap.Error(err))
+		return nil, err
+	}
+	return &task.TaskResponse{
+		Task: s.taskToProto(createdTask),
+	}, nil
+}
+
+func checklistToProto(checklist *entity.Checklist) *task.ChecklistResponse {
+	resp := &task.ChecklistResponse{Progress: checklistProgressToProto(checklist.Progress)}
+	for _, item := range checklist.Items {
+		resp.Items = append(resp.Items, &task.ChecklistItem{
+			Id:        item.ID,
+			TaskId:    item.TaskID,
+			Text:      item.Text,
+			Done:      item.Done,
+			Position:  int32(item.Position),
+			CreatedAt: timestamppb.New(item.CreatedAt),
+			UpdatedAt: timestamppb.New(item.UpdatedAt),
+		})
+	}
+	return resp
+}
+
+func checklistProgressToProto(progress entity.ChecklistProgress) *task.ChecklistProgress {
+	return &task.ChecklistProgress{
+		Done:  int32(progress.Done),
+		Total: int32(progress.Total),
+	}
+}
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *TaskServer) CreateCustomField(ctx context.Context, req *task.CustomFieldDefinition) (*task.CustomFieldDefinition, error) {
+	field, err := s.taskService.CreateCustomField(ctx, protoToCustomField(req))
+	if err != nil {
+		s.Log.Error("Error caused after calling the func CreateCustomField", zap.Error(err))
+		return nil, err
+	}
+	return customFieldToProto(field), nil
+}
+
+func (s *TaskServer) ListCustomFields(ctx context.Context, req *task.ListCustomFieldsRequest) (*task.ListCustomFieldsResponse, error) {
+	fields, err := s.taskService.ListCustomFields(ctx, req.UserId, req.Project)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func ListCustomFields", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &task.ListCustomFieldsResponse{Total: int32(len(fields))}
+	for i := range fields {
+		resp.Fields = append(resp.Fields, customFieldToProto(&fields[i]))
+	}
+	return resp, nil
+}
+
+func (s *TaskServer) UpdateCustomField(ctx context.Context, req *task.CustomFieldDefinition) (*task.CustomFieldDefinition, error) {
+	field, err := s.taskService.UpdateCustomField(ctx, protoToCustomField(req))
+	if err != nil {
+		s.Log.Error("Error caused after calling the func UpdateCustomField", zap.Error(err))
+		return nil, err
+	}
+	return customFieldToProto(field), nil
+}
+
+func (s *TaskServer) DeleteCustomField(ctx context.Context, req *task.CustomFieldRequest) (*task.DeleteCustomFieldResponse, error) {
+	if err := s.taskService.DeleteCustomField(ctx, req.FieldId, req.UserId); err != nil {
+		s.Log.Error("Error caused after calling the func DeleteCustomField", zap.Error(err))
+		return nil, err
+	}
+	return &task.DeleteCustomFieldResponse{Success: true}, nil
+}
+
+func customFieldToProto(field *entity.CustomFieldDefinition) *task.CustomFieldDefinition {
+	return &task.CustomFieldDefinition{
+		Id:        field.ID,
+		UserId:    field.UserID,
+		Project:   field.Project,
+		Key:       field.Key,
+		Name:      field.Name,
+		Type:      field.Type,
+		Options:   field.Options,
+		Required:  field.Required,
+		CreatedAt: timestamppb.New(field.CreatedAt),
+		UpdatedAt: timestamppb.New(field.UpdatedAt),
+	}
+}
+
+func protoToCustomField(field *task.CustomFieldDefinition) *entity.CustomFieldDefinition {
+	return &entity.CustomFieldDefinition{
+		ID:       field.Id,
+		UserID:   field.UserId,
+		Project:  field.Project,
+		Key:      field.Key,
+		Name:     field.Name,
+		Type:     field.Type,
+		Options:  field.Options,
+		Required: field.Required,
+	}
+}
+
+// conditionsFromProto оставляет значения строками: к типу поля их приводит сервис
+func conditionsFromProto(conditions []*task.CustomFieldCondition) []entity.CustomFieldCondition {
+	var result []entity.CustomFieldCondition
+	for _, condition := range conditions {
+		result = append(result, entity.CustomFieldCondition{
+			Key:   condition.Key,
+			Op:    condition.Op,
+			Value: condition.Value,
+		})
+	}
+	return result
+}
+
+func conditionsToProto(conditions []entity.CustomFieldCondition) []*task.CustomFieldCondition {
+	var result []*task.CustomFieldCondition
+	for _, condition := range conditions {
+		result = append(result, &task.CustomFieldCondition{
+			Key:   condition.Key,
+			Op:    condition.Op,
+			Value: fmt.Sprint(condition.Value),
+		})
+	}
+	return result
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+func (s *TaskServer) GetEisenhowerMatrix(ctx context.Context, req *task.GetEisenhowerMatrixRequest) (*task.GetEisenhowerMatrixResponse, error) {
+	query := entity.EisenhowerQuery{UserID: req.UserId, Project: req.Project}
+	if req.Thresholds != nil {
+		query.Thresholds = entity.MatrixThresholds{
+			ImportantPriority: req.Thresholds.ImportantPriority,
+			UrgentWithinHours: int(req.Thresholds.UrgentWithinHours),
+			UrgentSLAStatus:   req.Thresholds.UrgentSlaStatus,
+		}
+	}
+
+	matrix, err := s.taskService.GetEisenhowerMatrix(ctx, query)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func GetEisenhowerMatrix", zap.Error(err))
+		return nil, err
+	}
+
+	return &task.GetEisenhowerMatrixResponse{
+		DoFirst:   s.matrixTasksToProto(matrix.DoFirst),
+		Schedule:  s.matrixTasksToProto(matrix.Schedule),
+		Delegate:  s.matrixTasksToProto(matrix.Delegate),
+		Eliminate: s.matrixTasksToProto(matrix.Eliminate),
+		Thresholds: &task.MatrixThresholds{
+			ImportantPriority: matrix.Thresholds.ImportantPriority,
+			UrgentWithinHours: int32(matrix.Thresholds.UrgentWithinHours),
+			UrgentSlaStatus:   matrix.Thresholds.UrgentSLAStatus,
+		},
+	}, nil
+}
+
+func (s *TaskServer) SetQuadrantOverride(ctx context.Context, req *task.SetQuadrantOverrideRequest) (*task.SetQuadrantOverrideResponse, error) {
+	quadrant, err := s.taskService.SetQuadrantOverride(ctx, req.UserId, req.TaskId, req.Quadrant)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func SetQuadrantOverride", zap.Error(err))
+		return nil, err
+	}
+	return &task.SetQuadrantOverrideResponse{TaskId: req.TaskId, Quadrant: quadrant}, nil
+}
+
+func (s *TaskServer) matrixTasksToProto(items []entity.MatrixTask) []*task.MatrixTask {
+	result := make([]*task.MatrixTask, 0, len(items))
+	for _, item := range items {
+		result = append(result, &task.MatrixTask{
+			Task:       s.taskToProto(item.Task),
+			Quadrant:   item.Quadrant,
+			Important:  item.Important,
+			Urgent:     item.Urgent,
+			Overridden: item.Overridden,
+			Reason:     item.Reason,
+		})
+	}
+	return result
+}
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/oogway93/taskmanager/internal/taskservice/ical"
+	"github.com/oogway93/taskmanager/internal/taskservice/quickadd"
+	"github.com/oogway93/taskmanager/internal/taskservice/repository"
+	"github.com/oogway93/taskmanager/internal/taskservice/service"
+	"github.com/oogway93/taskmanager/internal/taskservice/timeexpr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	notFoundErrors = []error{
+		repository.ErrTaskNotFound,
+		repository.ErrUserNotFound,
+		service.ErrUserNotFound,
+		repository.ErrSavedViewNotFound,
+		repository.ErrTemplateNotFound,
+		repository.ErrCustomFieldNotFound,
+		repository.ErrSLAPolicyNotFound,
+		repository.ErrWebhookNotFound,
+		repository.ErrWebhookDeliveryNotFound,
+		repository.ErrNotificationNotFound,
+		repository.ErrChecklistItemNotFound,
+		repository.ErrShareNotFound,
+		repository.ErrPublicLinkNotFound,
+	}
+	alreadyExistsErrors = []error{
+		repository.ErrSavedViewExists,
+		repository.ErrTemplateExists,
+		repository.ErrCustomFieldExists,
+	}
+	invalidArgumentErrors = []error{
+		service.ErrInvalidTimezone,
+		service.ErrInvalidStatus,
+		service.ErrInvalidPriority,
+		service.ErrInvalidRange,
+		service.ErrInvalidSavedView,
+		service.ErrInvalidTemplate,
+		service.ErrMissingTemplateVariable,
+		service.ErrInvalidCustomField,
+		service.ErrUnknownCustomField,
+		service.ErrInvalidWebhook,
+		service.ErrInvalidScoreWeights,
+		service.ErrInvalidQuadrant,
+		service.ErrInvalidMatrixThresholds,
+		service.ErrInvalidSnooze,
+		service.ErrInvalidChecklistItem,
+		service.ErrInvalidChecklistOrder,
+		service.ErrInvalidClone,
+		service.ErrInvalidShare,
+		service.ErrInvalidPublicLink,
+		timeexpr.ErrInvalidExpression,
+		quickadd.ErrEmptyTitle,
+		ical.ErrInvalidCalendar,
+	}
+)
+
+// ErrorInterceptor переводит ошибки обработчиков в статусы gRPC, чтобы шлюз мог ответить
+// правильным HTTP кодом. Уже готовые статусы проходят без изменений
+func ErrorInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, toStatus(err)
+	}
+	return resp, nil
+}
+
+// toStatus сопоставляет доменную ошибку коду gRPC. Текст ошибок клиента уходит в статус как есть,
+// а для неизвестных ошибок скрывается: в нем могут быть детали SQL
+func toStatus(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var pqErr *pq.Error
+	switch {
+	case isOneOf(err, notFoundErrors):
+		return status.Error(codes.NotFound, err.Error())
+	case isOneOf(err, alreadyExistsErrors):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case isOneOf(err, invalidArgumentErrors):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.As(err, &pqErr) && pqErr.Code.Class() == "22":
+		// класс 22 - некорректные данные, например id не в формате UUID
+		return status.Error(codes.InvalidArgument, "invalid input value")
+	case errors.As(err, &pqErr) && pqErr.Code == "23503":
+		// ссылка на несуществующую запись, например доступ к задаче для неизвестного пользователя
+		return status.Error(codes.NotFound, "referenced object not found")
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, "deadline exceeded")
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, "request canceled")
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func isOneOf(err error, targets []error) bool {
+	for _, target := range targets {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,38 @@
+package server
+
+import (
+	"context"
+
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+func (s *TaskServer) GetNextTasks(ctx context.Context, req *task.GetNextTasksRequest) (*task.GetNextTasksResponse, error) {
+	next, err := s.taskService.GetNextTasks(ctx, entity.NextTasksQuery{
+		UserID:  req.UserId,
+		Project: req.Project,
+		Limit:   int(req.Limit),
+		Weights: req.Weights,
+	})
+	if err != nil {
+		s.Log.Error("Error caused after calling the func GetNextTasks", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &task.GetNextTasksResponse{Weights: next.Weights}
+	for _, scored := range next.Tasks {
+		item := &task.ScoredTask{Task: s.taskToProto(scored.Task), Score: scored.Score}
+		for _, factor := range scored.Factors {
+			item.Factors = append(item.Factors, &task.ScoreFactor{
+				Factor:       factor.Factor,
+				Value:        factor.Value,
+				Weight:       factor.Weight,
+				Contribution: factor.Contribution,
+				Reason:       factor.Reason,
+			})
+		}
+		resp.Tasks = append(resp.Tasks, item)
+	}
+	return resp, nil
+}
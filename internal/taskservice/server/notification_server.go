@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *TaskServer) ListNotifications(ctx context.Context, req *task.ListNotificationsRequest) (*task.ListNotificationsResponse, error) {
+	notifications, unread, err := s.taskService.ListNotifications(ctx, req.UserId, req.UnreadOnly, int(req.Limit), int(req.Offset))
+	if err != nil {
+		s.Log.Error("Error caused after calling the func ListNotifications", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &task.ListNotificationsResponse{Total: int32(len(notifications)), UnreadCount: int32(unread)}
+	for i := range notifications {
+		resp.Notifications = append(resp.Notifications, notificationToProto(&notifications[i]))
+	}
+	return resp, nil
+}
+
+func (s *TaskServer) MarkRead(ctx context.Context, req *task.MarkReadRequest) (*task.MarkReadResponse, error) {
+	unread, err := s.taskService.MarkRead(ctx, req.UserId, req.NotificationId)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func MarkRead", zap.Error(err))
+		return nil, err
+	}
+	return &task.MarkReadResponse{Updated: 1, UnreadCount: int32(unread)}, nil
+}
+
+func (s *TaskServer) MarkAllRead(ctx context.Context, req *task.MarkAllReadRequest) (*task.MarkReadResponse, error) {
+	updated, unread, err := s.taskService.MarkAllRead(ctx, req.UserId)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func MarkAllRead", zap.Error(err))
+		return nil, err
+	}
+	return &task.MarkReadResponse{Updated: int32(updated), UnreadCount: int32(unread)}, nil
+}
+
+func notificationToProto(notification *entity.Notification) *task.Notification {
+	return &task.Notification{
+		Id:        notification.ID,
+		UserId:    notification.UserID,
+		Type:      notification.Type,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		TaskId:    notification.TaskID,
+		ReadAt:    optionalTimeToProto(notification.ReadAt),
+		CreatedAt: timestamppb.New(notification.CreatedAt),
+	}
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"context"
+
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *TaskServer) CreatePublicLink(ctx context.Context, req *task.CreatePublicLinkRequest) (*task.PublicLink, error) {
+	link, err := s.taskService.CreatePublicLink(ctx, req.UserId, req.TaskId, req.ExpiresAt, req.Timezone)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func CreatePublicLink", zap.Error(err))
+		return nil, err
+	}
+	return publicLinkToProto(link), nil
+}
+
+func (s *TaskServer) ListPublicLinks(ctx context.Context, req *task.ListPublicLinksRequest) (*task.ListPublicLinksResponse, error) {
+	links, err := s.taskService.ListPublicLinks(ctx, req.UserId, req.TaskId)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func ListPublicLinks", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &task.ListPublicLinksResponse{}
+	for i := range links {
+		resp.Links = append(resp.Links, publicLinkToProto(&links[i]))
+	}
+	return resp, nil
+}
+
+func (s *TaskServer) RevokePublicLink(ctx context.Context, req *task.RevokePublicLinkRequest) (*task.PublicLink, error) {
+	link, err := s.taskService.RevokePublicLink(ctx, req.UserId, req.TaskId, req.LinkId)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func RevokePublicLink", zap.Error(err))
+		return nil, err
+	}
+	return publicLinkToProto(link), nil
+}
+
+// GetPublicTask не логирует токен: он дает доступ к задаче
+func (s *TaskServer) GetPublicTask(ctx context.Context, req *task.GetPublicTaskRequest) (*task.PublicTask, error) {
+	view, err := s.taskService.GetPublicTask(ctx, req.Token)
+	if err != nil {
+		s.Log.Info("Error caused after calling the func GetPublicTask", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &task.PublicTask{
+		Title:         view.Title,
+		Description:   view.Description,
+		Status:        view.Status,
+		Priority:      view.Priority,
+		DueDate:       optionalTimeToProto(view.DueDate),
+		CompletedAt:   optionalTimeToProto(view.CompletedAt),
+		UpdatedAt:     timeToProto(view.UpdatedAt),
+		LinkExpiresAt: timestamppb.New(view.LinkExpiresAt),
+	}
+	if view.Checklist != nil {
+		resp.Checklist = checklistProgressToProto(*view.Checklist)
+	}
+	return resp, nil
+}
+
+func publicLinkToProto(link *entity.PublicLink) *task.PublicLink {
+	return &task.PublicLink{
+		Id:           link.ID,
+		TaskId:       link.TaskID,
+		Token:        link.Token,
+		ExpiresAt:    timestamppb.New(link.ExpiresAt),
+		RevokedAt:    optionalTimeToProto(link.RevokedAt),
+		ViewCount:    link.ViewCount,
+		LastViewedAt: optionalTimeToProto(link.LastViewedAt),
+		CreatedAt:    timestamppb.New(link.CreatedAt),
+	}
+}
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *TaskServer) CreateSavedView(ctx context.Context, req *task.SavedView) (*task.SavedView, error) {
+	view, err := s.taskService.CreateSavedView(ctx, protoToSavedView(req))
+	if err != nil {
+		s.Log.Error("Error caused after calling the func CreateSavedView", zap.Error(err))
+		return nil, err
+	}
+	return savedViewToProto(view), nil
+}
+
+func (s *TaskServer) GetSavedView(ctx context.Context, req *task.SavedViewRequest) (*task.SavedView, error) {
+	view, err := s.taskService.GetSavedView(ctx, req.ViewId, req.UserId)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func GetSavedView", zap.Error(err))
+		return nil, err
+	}
+	return savedViewToProto(view), nil
+}
+
+func (s *TaskServer) ListSavedViews(ctx context.Context, req *task.ListSavedViewsRequest) (*task.ListSavedViewsResponse, error) {
+	views, err := s.taskService.ListSavedViews(ctx, req.UserId)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func ListSavedViews", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &task.ListSavedViewsResponse{Total: int32(len(views))}
+	for i := range views {
+		resp.Views = append(resp.Views, savedViewToProto(&views[i]))
+	}
+	return resp, nil
+}
+
+func (s *TaskServer) UpdateSavedView(ctx context.Context, req *task.SavedView) (*task.SavedView, error) {
+	view, err := s.taskService.UpdateSavedView(ctx, protoToSavedView(req))
+	if err != nil {
+		s.Log.Error("Error caused after calling the func UpdateSavedView", zap.Error(err))
+		return nil, err
+	}
+	return savedViewToProto(view), nil
+}
+
+func (s *TaskServer) DeleteSavedView(ctx context.Context, req *task.SavedViewRequest) (*task.DeleteSavedViewResponse, error) {
+	if err := s.taskService.DeleteSavedView(ctx, req.ViewId, req.UserId); err != nil {
+		s.Log.Error("Error caused after calling the func DeleteSavedView", zap.Error(err))
+		return nil, err
+	}
+	return &task.DeleteSavedViewResponse{Success: true}, nil
+}
+
+func savedViewToProto(view *entity.SavedView) *task.SavedView {
+	return &task.SavedView{
+		Id:     view.ID,
+		UserId: view.UserID,
+		Name:   view.Name,
+		Filter: &task.SavedViewFilter{
+			Statuses:     view.Filter.Statuses,
+			Priorities:   view.Filter.Priorities,
+			Tags:         view.Filter.Tags,
+			Search:       view.Filter.Search,
+			DueFrom:      view.Filter.DueFrom,
+			DueTo:        view.Filter.DueTo,
+			Project:      view.Filter.Project,
+			CustomFields: conditionsToProto(view.Filter.CustomFields),
+		},
+		Sort: &task.SavedViewSort{
+			Field: view.Sort.Field,
+			Desc:  view.Sort.Desc,
+		},
+		CreatedAt: timestamppb.New(view.CreatedAt),
+		UpdatedAt: timestamppb.New(view.UpdatedAt),
+	}
+}
+
+func protoToSavedView(view *task.SavedView) *entity.SavedView {
+	filter := view.GetFilter()
+	return &entity.SavedView{
+		ID:     view.Id,
+		UserID: view.UserId,
+		Name:   view.Name,
+		Filter: entity.SavedViewFilter{
+			Statuses:     filter.GetStatuses(),
+			Priorities:   filter.GetPriorities(),
+			Tags:         filter.GetTags(),
+			Search:       filter.GetSearch(),
+			DueFrom:      filter.GetDueFrom(),
+			DueTo:        filter.GetDueTo(),
+			Project:      filter.GetProject(),
+			CustomFields: conditionsFromProto(filter.GetCustomFields()),
+		},
+		Sort: entity.SavedViewSort{
+			Field: view.GetSort().GetField(),
+			Desc:  view.GetSort().GetDesc(),
+		},
+	}
+}
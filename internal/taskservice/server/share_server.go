@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *TaskServer) ShareTask(ctx context.Context, req *task.ShareTaskRequest) (*task.TaskShare, error) {
+	share, err := s.taskService.ShareTask(ctx, req.UserId, req.TaskId, req.GranteeId, req.Permission)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func ShareTask", zap.Error(err))
+		return nil, err
+	}
+	return shareToProto(share), nil
+}
+
+func (s *TaskServer) UnshareTask(ctx context.Context, req *task.UnshareTaskRequest) (*task.UnshareTaskResponse, error) {
+	if err := s.taskService.UnshareTask(ctx, req.UserId, req.TaskId, req.GranteeId); err != nil {
+		s.Log.Error("Error caused after calling the func UnshareTask", zap.Error(err))
+		return nil, err
+	}
+	return &task.UnshareTaskResponse{}, nil
+}
+
+func (s *TaskServer) ListShares(ctx context.Context, req *task.ListSharesRequest) (*task.ListSharesResponse, error) {
+	shares, err := s.taskService.ListShares(ctx, req.UserId, req.TaskId)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func ListShares", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &task.ListSharesResponse{}
+	for i := range shares {
+		resp.Shares = append(resp.Shares, shareToProto(&shares[i]))
+	}
+	return resp, nil
+}
+
+func shareToProto(share *entity.TaskShare) *task.TaskShare {
+	return &task.TaskShare{
+		TaskId:     share.TaskID,
+		GranteeId:  share.GranteeID,
+		Permission: share.Permission,
+		GrantedBy:  share.GrantedBy,
+		CreatedAt:  timestamppb.New(share.CreatedAt),
+		UpdatedAt:  timestamppb.New(share.UpdatedAt),
+	}
+}
@@ -0,0 +1,48 @@
+package server
+
+import (
+	"context"
+
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *TaskServer) ListSLAPolicies(ctx context.Context, req *task.ListSLAPoliciesRequest) (*task.ListSLAPoliciesResponse, error) {
+	policies, err := s.taskService.ListSLAPolicies(ctx)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func ListSLAPolicies", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &task.ListSLAPoliciesResponse{}
+	for i := range policies {
+		resp.Policies = append(resp.Policies, slaPolicyToProto(&policies[i]))
+	}
+	return resp, nil
+}
+
+func (s *TaskServer) UpdateSLAPolicy(ctx context.Context, req *task.SLAPolicy) (*task.SLAPolicy, error) {
+	policy, err := s.taskService.UpdateSLAPolicy(ctx, &entity.SLAPolicy{
+		Priority:          req.Priority,
+		ResponseMinutes:   req.ResponseMinutes,
+		ResolutionMinutes: req.ResolutionMinutes,
+		AtRiskThreshold:   req.AtRiskThreshold,
+	})
+	if err != nil {
+		s.Log.Error("Error caused after calling the func UpdateSLAPolicy", zap.Error(err))
+		return nil, err
+	}
+	return slaPolicyToProto(policy), nil
+}
+
+func slaPolicyToProto(policy *entity.SLAPolicy) *task.SLAPolicy {
+	return &task.SLAPolicy{
+		Priority:          policy.Priority,
+		ResponseMinutes:   policy.ResponseMinutes,
+		ResolutionMinutes: policy.ResolutionMinutes,
+		AtRiskThreshold:   policy.AtRiskThreshold,
+		UpdatedAt:         timestamppb.New(policy.UpdatedAt),
+	}
+}
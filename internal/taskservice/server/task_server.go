@@ -3,11 +3,13 @@ package server
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/oogway93/taskmanager/gen/task"
 	"github.com/oogway93/taskmanager/internal/entity"
 	"github.com/oogway93/taskmanager/internal/taskservice/service"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -43,7 +45,19 @@ func (s *TaskServer) CreateTask(ctx context.Context, req *task.Task) (*task.Task
 
 func (s *TaskServer) ListTasks(ctx context.Context, req *task.ListTasksRequest) (*task.ListTasksResponse, error) {
 	// Вызываем сервис
-	tasks, err := s.taskService.ListTasks(ctx, req.UserId)
+	query := entity.TaskListQuery{
+		UserID:       req.UserId,
+		ViewID:       req.ViewId,
+		Timezone:     req.Timezone,
+		Project:      req.Project,
+		CustomFields: conditionsFromProto(req.CustomFields),
+		SortBy:       req.SortBy,
+		SortDesc:     req.SortDesc,
+
+		IncludeDeferred: req.IncludeDeferred,
+		SharedWithMe:    req.SharedWithMe,
+	}
+	tasks, err := s.taskService.ListTasks(ctx, query)
 	if err != nil {
 		s.Log.Error("Error caused after calling the func ListTasks", zap.Error(err))
 		return nil, err
@@ -63,7 +77,7 @@ func (s *TaskServer) ListTasks(ctx context.Context, req *task.ListTasksRequest)
 
 func (s *TaskServer) GetTask(ctx context.Context, req *task.GetTaskRequest) (*task.TaskResponse, error) {
 	log.Println("input data from server grpc ", req.TaskId)
-	taskSer, err := s.taskService.GetTask(ctx, req.TaskId)
+	taskSer, err := s.taskService.GetTaskForUser(ctx, req.UserId, req.TaskId)
 	if err != nil {
 		s.Log.Error("Error caused after calling the func GetTask", zap.Error(err))
 		return nil, err
@@ -75,34 +89,271 @@ func (s *TaskServer) GetTask(ctx context.Context, req *task.GetTaskRequest) (*ta
 	}, nil
 }
 
+func (s *TaskServer) ImportICS(ctx context.Context, req *task.ImportICSRequest) (*task.ImportICSResponse, error) {
+	result, err := s.taskService.ImportICS(ctx, req.UserId, req.Data)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func ImportICS", zap.Error(err))
+		return nil, err
+	}
+
+	var tasksProto []*task.Task
+	for _, t := range result.Tasks {
+		tasksProto = append(tasksProto, s.taskToProto(t))
+	}
+
+	return &task.ImportICSResponse{
+		Created: result.Created,
+		Updated: result.Updated,
+		Skipped: result.Skipped,
+		Tasks:   tasksProto,
+	}, nil
+}
+
+func (s *TaskServer) QuickAddTask(ctx context.Context, req *task.QuickAddTaskRequest) (*task.QuickAddTaskResponse, error) {
+	parsed, saved, err := s.taskService.QuickAddTask(ctx, req.UserId, req.Text, req.Timezone, req.Preview)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func QuickAddTask", zap.Error(err))
+		return nil, err
+	}
+
+	return &task.QuickAddTaskResponse{
+		Task:  s.taskToProto(parsed),
+		Saved: saved,
+	}, nil
+}
+
+func (s *TaskServer) UpdateTask(ctx context.Context, req *task.UpdateTaskRequest) (*task.TaskResponse, error) {
+	update := entity.UpdateTaskRequest{
+		Title:       req.Title,
+		Description: req.Description,
+		Status:      req.Status,
+		Tags:        req.Tags,
+	}
+	if req.DueDate != nil {
+		dueDate := req.DueDate.AsTime()
+		update.DueDate = &dueDate
+	}
+	update.Estimate = req.Estimate
+	update.Project = req.Project
+	if req.StartAt != nil {
+		startAt := req.StartAt.AsTime()
+		update.StartAt = &startAt
+	}
+	if req.CustomFields != nil {
+		update.CustomFields = req.CustomFields.AsMap()
+	}
+
+	updatedTask, err := s.taskService.UpdateTask(ctx, req.UserId, req.TaskId, update)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func UpdateTask", zap.Error(err))
+		return nil, err
+	}
+
+	return &task.TaskResponse{
+		Task: s.taskToProto(updatedTask),
+	}, nil
+}
+
+func (s *TaskServer) SnoozeTask(ctx context.Context, req *task.SnoozeTaskRequest) (*task.TaskResponse, error) {
+	snoozedTask, err := s.taskService.SnoozeTask(ctx, req.UserId, req.TaskId, req.Until, req.Timezone)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func SnoozeTask", zap.Error(err))
+		return nil, err
+	}
+
+	return &task.TaskResponse{
+		Task: s.taskToProto(snoozedTask),
+	}, nil
+}
+
+func (s *TaskServer) CloneTask(ctx context.Context, req *task.CloneTaskRequest) (*task.TaskResponse, error) {
+	clonedTask, err := s.taskService.CloneTask(ctx, req.UserId, req.TaskId, entity.CloneTaskOptions{
+		IncludeSubtasks: req.IncludeSubtasks,
+		ResetStatus:     req.ResetStatus,
+		ShiftDueBy:      req.ShiftDueBy,
+		Timezone:        req.Timezone,
+	})
+	if err != nil {
+		s.Log.Error("Error caused after calling the func CloneTask", zap.Error(err))
+		return nil, err
+	}
+
+	return &task.TaskResponse{
+		Task: s.taskToProto(clonedTask),
+	}, nil
+}
+
+func (s *TaskServer) GetTaskStats(ctx context.Context, req *task.GetTaskStatsRequest) (*task.TaskStats, error) {
+	filter, err := s.statsFilterFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.taskService.GetTaskStats(ctx, filter)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func GetTaskStats", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &task.TaskStats{
+		From:              timestamppb.New(stats.From),
+		To:                timestamppb.New(stats.To),
+		Created:           stats.Created,
+		Completed:         stats.Completed,
+		Daily:             bucketsToProto(stats.Daily),
+		Weekly:            bucketsToProto(stats.Weekly),
+		AvgCycleTimeHours: stats.AvgCycleTimeHours,
+		P90CycleTimeHours: stats.P90CycleTimeHours,
+		OverdueRate:       stats.OverdueRate,
+	}
+	for _, d := range stats.TimeInStatus {
+		resp.TimeInStatus = append(resp.TimeInStatus, &task.StatusDuration{
+			Status:     d.Status,
+			TotalHours: d.TotalHours,
+			AvgHours:   d.AvgHours,
+			Tasks:      d.Tasks,
+		})
+	}
+	return resp, nil
+}
+
+func (s *TaskServer) GetBurndown(ctx context.Context, req *task.GetTaskStatsRequest) (*task.BurndownResponse, error) {
+	filter, err := s.statsFilterFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	burndown, err := s.taskService.GetBurndown(ctx, filter)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func GetBurndown", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &task.BurndownResponse{}
+	for _, p := range burndown.Points {
+		resp.Points = append(resp.Points, &task.BurndownPoint{
+			Date:              p.Date,
+			OpenTasks:         p.OpenTasks,
+			RemainingEstimate: p.RemainingEstimate,
+			IdealEstimate:     p.IdealEstimate,
+		})
+	}
+	return resp, nil
+}
+
+func (s *TaskServer) GetCumulativeFlow(ctx context.Context, req *task.GetTaskStatsRequest) (*task.CumulativeFlowResponse, error) {
+	filter, err := s.statsFilterFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	flow, err := s.taskService.GetCumulativeFlow(ctx, filter)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func GetCumulativeFlow", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &task.CumulativeFlowResponse{Statuses: flow.Statuses}
+	for _, p := range flow.Points {
+		resp.Points = append(resp.Points, &task.CumulativeFlowPoint{
+			Date:   p.Date,
+			Counts: p.Counts,
+		})
+	}
+	return resp, nil
+}
+
+func (s *TaskServer) statsFilterFromProto(req *task.GetTaskStatsRequest) (entity.StatsFilter, error) {
+	loc, err := time.LoadLocation(req.Timezone)
+	if err != nil {
+		s.Log.Error("Invalid timezone in stats request", zap.String("timezone", req.Timezone), zap.Error(err))
+		return entity.StatsFilter{}, service.ErrInvalidTimezone
+	}
+	return entity.StatsFilter{
+		UserID:   req.UserId,
+		Tags:     req.Tags,
+		From:     protoToTime(req.From),
+		To:       protoToTime(req.To),
+		Location: loc,
+	}, nil
+}
+
+func bucketsToProto(buckets []entity.StatsBucket) []*task.StatsBucket {
+	result := make([]*task.StatsBucket, 0, len(buckets))
+	for _, b := range buckets {
+		result = append(result, &task.StatsBucket{
+			Period:    b.Period,
+			Created:   b.Created,
+			Completed: b.Completed,
+		})
+	}
+	return result
+}
+
 func (s *TaskServer) taskToProto(taskReq *entity.Task) *task.Task {
+	customFields, err := structpb.NewStruct(taskReq.CustomFields)
+	if err != nil {
+		s.Log.Error("Failed conversion of custom fields to protobuf struct", zap.String("task_id", taskReq.ID), zap.Error(err))
+	}
 	return &task.Task{
-		Id:          taskReq.ID,
-		Title:       taskReq.Title,
-		Description: taskReq.Description,
-		Priority:    taskReq.Priority,
-		Status:      taskReq.Status,
-		UserId:      taskReq.User_id,
-		Tags:        taskReq.Tags,
-		CreatedAt:   timestamppb.New(taskReq.CreatedAt),
-		UpdatedAt:   timestamppb.New(taskReq.UpdatedAt),
-		// DueDate:     timestamppb.New(taskReq),
+		Id:           taskReq.ID,
+		Title:        taskReq.Title,
+		Description:  taskReq.Description,
+		Priority:     taskReq.Priority,
+		Status:       taskReq.Status,
+		UserId:       taskReq.User_id,
+		Tags:         taskReq.Tags,
+		CreatedAt:    timestamppb.New(taskReq.CreatedAt),
+		UpdatedAt:    timestamppb.New(taskReq.UpdatedAt),
+		DueDate:      timeToProto(taskReq.DueDate),
+		Estimate:     taskReq.Estimate,
+		ParentId:     taskReq.ParentID,
+		Project:      taskReq.Project,
+		CustomFields: customFields,
+
+		SlaResponseDueAt: timeToProto(taskReq.SLAResponseDueAt),
+		SlaDueAt:         timeToProto(taskReq.SLADueAt),
+		RespondedAt:      timeToProto(taskReq.RespondedAt),
+		SlaStatus:        taskReq.SLAStatus,
+		StartAt:          timeToProto(taskReq.StartAt),
+		SnoozedUntil:     timeToProto(taskReq.SnoozedUntil),
+		Checklist:        checklistProgressToProto(taskReq.Checklist),
 	}
 }
 
 func (s *TaskServer) protoToTask(taskProto *task.Task) *entity.Task {
 	return &entity.Task{
-		ID:          taskProto.Id,
-		Title:       taskProto.Title,
-		Description: taskProto.Description,
-		Priority:    taskProto.Priority,
-		Status:      taskProto.Status,
-		User_id:     taskProto.UserId,
-		Tags:        taskProto.Tags,
-		CreatedAt:   taskProto.CreatedAt.AsTime(),
-		UpdatedAt:   taskProto.UpdatedAt.AsTime(),
-		// DueDate:     timestamppb.New(taskReq),
+		ID:           taskProto.Id,
+		Title:        taskProto.Title,
+		Description:  taskProto.Description,
+		Priority:     taskProto.Priority,
+		Status:       taskProto.Status,
+		User_id:      taskProto.UserId,
+		Tags:         taskProto.Tags,
+		CreatedAt:    taskProto.CreatedAt.AsTime(),
+		UpdatedAt:    taskProto.UpdatedAt.AsTime(),
+		DueDate:      protoToTime(taskProto.DueDate),
+		Estimate:     taskProto.Estimate,
+		ParentID:     taskProto.ParentId,
+		Project:      taskProto.Project,
+		CustomFields: taskProto.CustomFields.AsMap(),
+		StartAt:      protoToTime(taskProto.StartAt),
+	}
+}
+
+// timeToProto возвращает nil для нулевого времени, чтобы не отдавать 0001-01-01
+func timeToProto(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func protoToTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
 	}
+	return ts.AsTime()
 }
 
 // func (s *TaskServer) Register(ctx context.Context, req *auth.RegisterRequest) (*auth.RegisterResponse, error) {
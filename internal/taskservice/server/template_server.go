@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *TaskServer) CreateTaskTemplate(ctx context.Context, req *task.TaskTemplate) (*task.TaskTemplate, error) {
+	template, err := s.taskService.CreateTaskTemplate(ctx, protoToTemplate(req))
+	if err != nil {
+		s.Log.Error("Error caused after calling the func CreateTaskTemplate", zap.Error(err))
+		return nil, err
+	}
+	return templateToProto(template), nil
+}
+
+func (s *TaskServer) GetTaskTemplate(ctx context.Context, req *task.TaskTemplateRequest) (*task.TaskTemplate, error) {
+	template, err := s.taskService.GetTaskTemplate(ctx, req.TemplateId, req.UserId)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func GetTaskTemplate", zap.Error(err))
+		return nil, err
+	}
+	return templateToProto(template), nil
+}
+
+func (s *TaskServer) ListTaskTemplates(ctx context.Context, req *task.ListTaskTemplatesRequest) (*task.ListTaskTemplatesResponse, error) {
+	templates, err := s.taskService.ListTaskTemplates(ctx, req.UserId)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func ListTaskTemplates", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &task.ListTaskTemplatesResponse{Total: int32(len(templates))}
+	for i := range templates {
+		resp.Templates = append(resp.Templates, templateToProto(&templates[i]))
+	}
+	return resp, nil
+}
+
+func (s *TaskServer) UpdateTaskTemplate(ctx context.Context, req *task.TaskTemplate) (*task.TaskTemplate, error) {
+	template, err := s.taskService.UpdateTaskTemplate(ctx, protoToTemplate(req))
+	if err != nil {
+		s.Log.Error("Error caused after calling the func UpdateTaskTemplate", zap.Error(err))
+		return nil, err
+	}
+	return templateToProto(template), nil
+}
+
+func (s *TaskServer) DeleteTaskTemplate(ctx context.Context, req *task.TaskTemplateRequest) (*task.DeleteTaskTemplateResponse, error) {
+	if err := s.taskService.DeleteTaskTemplate(ctx, req.TemplateId, req.UserId); err != nil {
+		s.Log.Error("Error caused after calling the func DeleteTaskTemplate", zap.Error(err))
+		return nil, err
+	}
+	return &task.DeleteTaskTemplateResponse{Success: true}, nil
+}
+
+func (s *TaskServer) InstantiateTemplate(ctx context.Context, req *task.InstantiateTemplateRequest) (*task.InstantiateTemplateResponse, error) {
+	parent, subtasks, err := s.taskService.InstantiateTemplate(ctx, req.UserId, req.TemplateId, req.Variables, req.Timezone)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func InstantiateTemplate", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &task.InstantiateTemplateResponse{Task: s.taskToProto(parent)}
+	for _, subtask := range subtasks {
+		resp.Subtasks = append(resp.Subtasks, s.taskToProto(subtask))
+	}
+	return resp, nil
+}
+
+func templateToProto(template *entity.TaskTemplate) *task.TaskTemplate {
+	resp := &task.TaskTemplate{
+		Id:          template.ID,
+		UserId:      template.UserID,
+		Name:        template.Name,
+		Title:       template.Title,
+		Description: template.Description,
+		Priority:    template.Priority,
+		Tags:        template.Tags,
+		DueOffset:   template.DueOffset,
+		Checklist:   template.Checklist,
+		CreatedAt:   timestamppb.New(template.CreatedAt),
+		UpdatedAt:   timestamppb.New(template.UpdatedAt),
+	}
+	for _, blueprint := range template.Subtasks {
+		resp.Subtasks = append(resp.Subtasks, &task.SubtaskBlueprint{
+			Title:       blueprint.Title,
+			Description: blueprint.Description,
+			Priority:    blueprint.Priority,
+			DueOffset:   blueprint.DueOffset,
+		})
+	}
+	return resp
+}
+
+func protoToTemplate(template *task.TaskTemplate) *entity.TaskTemplate {
+	result := &entity.TaskTemplate{
+		ID:          template.Id,
+		UserID:      template.UserId,
+		Name:        template.Name,
+		Title:       template.Title,
+		Description: template.Description,
+		Priority:    template.Priority,
+		Tags:        template.Tags,
+		DueOffset:   template.DueOffset,
+		Checklist:   template.Checklist,
+	}
+	for _, blueprint := range template.Subtasks {
+		result.Subtasks = append(result.Subtasks, entity.SubtaskBlueprint{
+			Title:       blueprint.Title,
+			Description: blueprint.Description,
+			Priority:    blueprint.Priority,
+			DueOffset:   blueprint.DueOffset,
+		})
+	}
+	return result
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/oogway93/taskmanager/gen/task"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *TaskServer) CreateWebhook(ctx context.Context, req *task.Webhook) (*task.Webhook, error) {
+	hook, err := s.taskService.CreateWebhook(ctx, protoToWebhook(req))
+	if err != nil {
+		s.Log.Error("Error caused after calling the func CreateWebhook", zap.Error(err))
+		return nil, err
+	}
+	return webhookToProto(hook), nil
+}
+
+func (s *TaskServer) ListWebhooks(ctx context.Context, req *task.ListWebhooksRequest) (*task.ListWebhooksResponse, error) {
+	hooks, err := s.taskService.ListWebhooks(ctx, req.UserId)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func ListWebhooks", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &task.ListWebhooksResponse{Total: int32(len(hooks))}
+	for i := range hooks {
+		resp.Webhooks = append(resp.Webhooks, webhookToProto(&hooks[i]))
+	}
+	return resp, nil
+}
+
+func (s *TaskServer) UpdateWebhook(ctx context.Context, req *task.Webhook) (*task.Webhook, error) {
+	hook, err := s.taskService.UpdateWebhook(ctx, protoToWebhook(req))
+	if err != nil {
+		s.Log.Error("Error caused after calling the func UpdateWebhook", zap.Error(err))
+		return nil, err
+	}
+	return webhookToProto(hook), nil
+}
+
+func (s *TaskServer) DeleteWebhook(ctx context.Context, req *task.WebhookRequest) (*task.DeleteWebhookResponse, error) {
+	if err := s.taskService.DeleteWebhook(ctx, req.WebhookId, req.UserId); err != nil {
+		s.Log.Error("Error caused after calling the func DeleteWebhook", zap.Error(err))
+		return nil, err
+	}
+	return &task.DeleteWebhookResponse{Success: true}, nil
+}
+
+func (s *TaskServer) ListWebhookDeliveries(ctx context.Context, req *task.ListWebhookDeliveriesRequest) (*task.ListWebhookDeliveriesResponse, error) {
+	deliveries, err := s.taskService.ListWebhookDeliveries(ctx, req.WebhookId, req.UserId, int(req.Limit))
+	if err != nil {
+		s.Log.Error("Error caused after calling the func ListWebhookDeliveries", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &task.ListWebhookDeliveriesResponse{Total: int32(len(deliveries))}
+	for i := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, webhookDeliveryToProto(&deliveries[i]))
+	}
+	return resp, nil
+}
+
+func (s *TaskServer) RedeliverWebhook(ctx context.Context, req *task.RedeliverWebhookRequest) (*task.WebhookDelivery, error) {
+	delivery, err := s.taskService.RedeliverWebhook(ctx, req.UserId, req.WebhookId, req.DeliveryId)
+	if err != nil {
+		s.Log.Error("Error caused after calling the func RedeliverWebhook", zap.Error(err))
+		return nil, err
+	}
+	return webhookDeliveryToProto(delivery), nil
+}
+
+func webhookToProto(hook *entity.WebhookSubscription) *task.Webhook {
+	return &task.Webhook{
+		Id:         hook.ID,
+		UserId:     hook.UserID,
+		Project:    hook.Project,
+		Url:        hook.URL,
+		EventTypes: hook.EventTypes,
+		Secret:     hook.Secret,
+		Active:     hook.Active,
+		CreatedAt:  timestamppb.New(hook.CreatedAt),
+		UpdatedAt:  timestamppb.New(hook.UpdatedAt),
+	}
+}
+
+func protoToWebhook(hook *task.Webhook) *entity.WebhookSubscription {
+	return &entity.WebhookSubscription{
+		ID:         hook.Id,
+		UserID:     hook.UserId,
+		Project:    hook.Project,
+		URL:        hook.Url,
+		EventTypes: hook.EventTypes,
+		Secret:     hook.Secret,
+		Active:     hook.Active,
+	}
+}
+
+func webhookDeliveryToProto(delivery *entity.WebhookDelivery) *task.WebhookDelivery {
+	return &task.WebhookDelivery{
+		Id:             delivery.ID,
+		SubscriptionId: delivery.SubscriptionID,
+		EventId:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Payload:        string(delivery.Payload),
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		ResponseBody:   delivery.ResponseBody,
+		LastError:      delivery.LastError,
+		DurationMs:     delivery.DurationMs,
+		NextAttemptAt:  optionalTimeToProto(delivery.NextAttemptAt),
+		DeliveredAt:    optionalTimeToProto(delivery.DeliveredAt),
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
+		UpdatedAt:      timestamppb.New(delivery.UpdatedAt),
+	}
+}
+
+func optionalTimeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timeToProto(*t)
+}
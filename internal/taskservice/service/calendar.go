@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+const (
+	calendarDateLayout = "2006-01-02"
+	// defaultCalendarDays - длина диапазона, если не передана дата to
+	defaultCalendarDays = 7
+	// maxCalendarDays - самый длинный диапазон, примерно квартал
+	maxCalendarDays = 92
+)
+
+// GetCalendar раскладывает задачи пользователя по локальным дням диапазона: задача попадает в день
+// своего срока и в день начала. Границы дней считаются в часовом поясе запроса, поэтому дни
+// с переходом на летнее время длятся 23 или 25 часов
+func (s *taskService) GetCalendar(ctx context.Context, query entity.CalendarQuery) (*entity.Calendar, error) {
+	if query.Timezone == "" {
+		query.Timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(query.Timezone)
+	if err != nil {
+		return nil, ErrInvalidTimezone
+	}
+
+	from, to, err := calendarRange(query.From, query.To, time.Now(), loc)
+	if err != nil {
+		return nil, err
+	}
+	days := calendarDays(from, to)
+
+	tasks, err := s.taskRepo.ListCalendarTasks(ctx, entity.CalendarFilter{
+		UserID:  query.UserID,
+		Project: strings.TrimSpace(query.Project),
+		From:    from,
+		// to - начало последнего дня, полуинтервал заканчивается началом следующего
+		To: days[len(days)-1].end,
+	})
+	if err != nil {
+		s.Log.Error("Error caused, after calling repo's ListCalendarTasks, in task service", zap.Error(err))
+		return nil, err
+	}
+
+	return &entity.Calendar{
+		From:     from.Format(calendarDateLayout),
+		To:       to.Format(calendarDateLayout),
+		Timezone: loc.String(),
+		Days:     buildCalendar(tasks, days),
+	}, nil
+}
+
+// calendarRange разбирает локальные даты; пустой from означает сегодня, пустой to - неделю от from
+func calendarRange(fromValue, toValue string, now time.Time, loc *time.Location) (time.Time, time.Time, error) {
+	now = now.In(loc)
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	if fromValue != "" {
+		parsed, err := time.ParseInLocation(calendarDateLayout, fromValue, loc)
+		if err != nil {
+			return from, from, ErrInvalidRange
+		}
+		from = parsed
+	}
+
+	to := from.AddDate(0, 0, defaultCalendarDays-1)
+	if toValue != "" {
+		parsed, err := time.ParseInLocation(calendarDateLayout, toValue, loc)
+		if err != nil {
+			return from, to, ErrInvalidRange
+		}
+		to = parsed
+	}
+
+	if to.Before(from) || to.After(from.AddDate(0, 0, maxCalendarDays-1)) {
+		return from, to, ErrInvalidRange
+	}
+	return from, to, nil
+}
+
+// calendarDay - локальный день с его границами [start, end)
+type calendarDay struct {
+	date       string
+	start, end time.Time
+}
+
+// calendarDays строит дни от from до to включительно. Следующий день берется через AddDate
+// от полуночи, а не прибавлением 24 часов, чтобы переход на летнее время не сдвигал границы
+func calendarDays(from, to time.Time) []calendarDay {
+	var days []calendarDay
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		days = append(days, calendarDay{
+			date:  day.Format(calendarDateLayout),
+			start: day,
+			end:   day.AddDate(0, 0, 1),
+		})
+	}
+	return days
+}
+
+// buildCalendar раскладывает записи задач по дням; внутри дня записи идут по времени,
+// при равенстве срок раньше начала
+func buildCalendar(tasks []entity.Task, days []calendarDay) []entity.CalendarDay {
+	result := make([]entity.CalendarDay, len(days))
+	for i, day := range days {
+		result[i] = entity.CalendarDay{Date: day.date, Entries: []entity.CalendarEntry{}}
+	}
+
+	add := func(task *entity.Task, kind string, at time.Time) {
+		if at.IsZero() {
+			return
+		}
+		// дни идут подряд, поэтому день события находится бинарным поиском по концу дня
+		i := sort.Search(len(days), func(i int) bool { return at.Before(days[i].end) })
+		if i == len(days) || at.Before(days[i].start) {
+			return
+		}
+		result[i].Entries = append(result[i].Entries, entity.CalendarEntry{Kind: kind, At: at, Task: task})
+	}
+	for i := range tasks {
+		add(&tasks[i], entity.CalendarEntryDue, tasks[i].DueDate)
+		add(&tasks[i], entity.CalendarEntryStart, tasks[i].StartAt)
+	}
+
+	for i := range result {
+		entries := result[i].Entries
+		sort.SliceStable(entries, func(a, b int) bool {
+			if !entries[a].At.Equal(entries[b].At) {
+				return entries[a].At.Before(entries[b].At)
+			}
+			return entries[a].Kind == entity.CalendarEntryDue && entries[b].Kind != entity.CalendarEntryDue
+		})
+	}
+	return result
+}
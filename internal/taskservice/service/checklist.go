@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidChecklistItem  = errors.New("invalid checklist item")
+	ErrInvalidChecklistOrder = errors.New("invalid checklist order")
+)
+
+const (
+	// maxChecklistItems - длинному списку шагов место в подзадачах
+	maxChecklistItems = 100
+	// maxChecklistItemLength совпадает с размером колонки text
+	maxChecklistItemLength = 500
+)
+
+// GetChecklist возвращает чек-лист задачи владельцу и получателям доступа
+func (s *taskService) GetChecklist(ctx context.Context, userId, taskId string) (*entity.Checklist, error) {
+	task, err := s.sharedTask(ctx, userId, taskId, entity.SharePermissionRead)
+	if err != nil {
+		return nil, err
+	}
+	return s.checklist(ctx, task.ID)
+}
+
+// AddChecklistItem добавляет пункт в конец чек-листа. Пункты меняют владелец и получатели доступа EDIT
+func (s *taskService) AddChecklistItem(ctx context.Context, userId, taskId, text string) (*entity.Checklist, error) {
+	text = strings.TrimSpace(text)
+	if text == "" || utf8.RuneCountInString(text) > maxChecklistItemLength {
+		return nil, fmt.Errorf("%w: text must be 1-%d characters", ErrInvalidChecklistItem, maxChecklistItemLength)
+	}
+	task, err := s.sharedTask(ctx, userId, taskId, entity.SharePermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+	if task.Checklist.Total >= maxChecklistItems {
+		return nil, fmt.Errorf("%w: checklist is limited to %d items", ErrInvalidChecklistItem, maxChecklistItems)
+	}
+
+	if err := s.checklistRepo.AddItem(ctx, &entity.ChecklistItem{TaskID: task.ID, Text: text}); err != nil {
+		s.Log.Error("Error caused, after calling repo's AddItem checklist, in task service", zap.Error(err))
+		return nil, err
+	}
+	return s.checklist(ctx, task.ID)
+}
+
+// ToggleChecklistItem переключает отметку о выполнении пункта
+func (s *taskService) ToggleChecklistItem(ctx context.Context, userId, taskId, itemId string) (*entity.Checklist, error) {
+	task, err := s.sharedTask(ctx, userId, taskId, entity.SharePermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checklistRepo.ToggleItem(ctx, task.ID, itemId); err != nil {
+		s.Log.Error("Error caused, after calling repo's ToggleItem checklist, in task service", zap.Error(err))
+		return nil, err
+	}
+	return s.checklist(ctx, task.ID)
+}
+
+// ReorderChecklist расставляет пункты в переданном порядке; itemIds должен перечислять
+// все пункты чек-листа ровно по одному разу, чтобы параллельно добавленный пункт не потерял позицию
+func (s *taskService) ReorderChecklist(ctx context.Context, userId, taskId string, itemIds []string) (*entity.Checklist, error) {
+	task, err := s.sharedTask(ctx, userId, taskId, entity.SharePermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.checklistRepo.ListItems(ctx, task.ID)
+	if err != nil {
+		s.Log.Error("Error caused, after calling repo's ListItems checklist, in task service", zap.Error(err))
+		return nil, err
+	}
+
+	if len(itemIds) != len(items) {
+		return nil, fmt.Errorf("%w: expected %d item ids, got %d", ErrInvalidChecklistOrder, len(items), len(itemIds))
+	}
+	known := make(map[string]bool, len(items))
+	for _, item := range items {
+		known[item.ID] = true
+	}
+	for _, itemId := range itemIds {
+		if !known[itemId] {
+			return nil, fmt.Errorf("%w: unknown or duplicate item %q", ErrInvalidChecklistOrder, itemId)
+		}
+		delete(known, itemId)
+	}
+
+	if err := s.checklistRepo.ReorderItems(ctx, task.ID, itemIds); err != nil {
+		s.Log.Error("Error caused, after calling repo's ReorderItems checklist, in task service", zap.Error(err))
+		return nil, err
+	}
+	return s.checklist(ctx, task.ID)
+}
+
+func (s *taskService) DeleteChecklistItem(ctx context.Context, userId, taskId, itemId string) (*entity.Checklist, error) {
+	task, err := s.sharedTask(ctx, userId, taskId, entity.SharePermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checklistRepo.DeleteItem(ctx, task.ID, itemId); err != nil {
+		s.Log.Error("Error caused, after calling repo's DeleteItem checklist, in task service", zap.Error(err))
+		return nil, err
+	}
+	return s.checklist(ctx, task.ID)
+}
+
+// ConvertChecklistItem превращает пункт в подзадачу с тем же проектом и приоритетом и удаляет пункт.
+// Задача создается первой: если удалить пункт не удалось, текст не теряется. Подзадача создается от имени
+// владельца, поэтому преобразование доступно только ему
+func (s *taskService) ConvertChecklistItem(ctx context.Context, userId, taskId, itemId string) (*entity.Task, error) {
+	parent, err := s.ownedTask(ctx, userId, taskId)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.checklistRepo.GetItem(ctx, parent.ID, itemId)
+	if err != nil {
+		s.Log.Error("Error caused, after calling repo's GetItem checklist, in task service", zap.Error(err))
+		return nil, err
+	}
+
+	subtask := &entity.Task{
+		Title:    item.Text,
+		Priority: parent.Priority,
+		Status:   entity.StatusPending,
+		Tags:     []string{},
+		User_id:  parent.User_id,
+		ParentID: parent.ID,
+		Project:  parent.Project,
+	}
+	if item.Done {
+		subtask.Status = entity.StatusCompleted
+	}
+	created, err := s.CreateTask(ctx, subtask)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checklistRepo.DeleteItem(ctx, parent.ID, item.ID); err != nil {
+		s.Log.Error("Error caused, after calling repo's DeleteItem checklist, in ConvertChecklistItem", zap.String("task_id", created.ID), zap.Error(err))
+		return nil, err
+	}
+	return created, nil
+}
+
+// ownedTask возвращает задачу, если она принадлежит пользователю. Как и в sharedTask, без доступа
+// к задаче - ErrTaskNotFound, а ErrForbidden получает только пользователь, которому задача расшарена
+func (s *taskService) ownedTask(ctx context.Context, userId, taskId string) (*entity.Task, error) {
+	task, err := s.sharedTask(ctx, userId, taskId, entity.SharePermissionRead)
+	if err != nil {
+		return nil, err
+	}
+	if task.User_id != userId {
+		return nil, ErrForbidden
+	}
+	return task, nil
+}
+
+func (s *taskService) checklist(ctx context.Context, taskId string) (*entity.Checklist, error) {
+	items, err := s.checklistRepo.ListItems(ctx, taskId)
+	if err != nil {
+		s.Log.Error("Error caused, after calling repo's ListItems checklist, in task service", zap.Error(err))
+		return nil, err
+	}
+	if items == nil {
+		items = []entity.ChecklistItem{}
+	}
+	return &entity.Checklist{Items: items, Progress: checklistProgress(items)}, nil
+}
+
+// attachChecklistProgress заполняет прогресс чек-листов одним запросом на все задачи
+func (s *taskService) attachChecklistProgress(ctx context.Context, tasks []entity.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+	taskIds := make([]string, len(tasks))
+	for i := range tasks {
+		taskIds[i] = tasks[i].ID
+	}
+	progress, err := s.checklistRepo.GetProgress(ctx, taskIds)
+	if err != nil {
+		s.Log.Error("Error caused, after calling repo's GetProgress checklist, in task service", zap.Error(err))
+		return err
+	}
+	for i := range tasks {
+		tasks[i].Checklist = progress[tasks[i].ID]
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/taskservice/timeexpr"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidClone = errors.New("invalid clone options")
+)
+
+// maxCloneTasks ограничивает размер копируемого дерева: копия создается одной транзакцией
+const maxCloneTasks = 500
+
+// CloneTask копирует задачу владельца, а с IncludeSubtasks - все дерево подзадач, вместе с тегами,
+// пользовательскими полями и чек-листами, и возвращает новый корень. Копия корня остается рядом
+// с оригиналом: у подзадачи она получает того же родителя. SLA считается заново от момента копирования,
+// external_uid и откладывание не копируются
+func (s *taskService) CloneTask(ctx context.Context, userId, taskId string, options entity.CloneTaskOptions) (*entity.Task, error) {
+	loc := time.UTC
+	if options.Timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(options.Timezone); err != nil {
+			return nil, ErrInvalidTimezone
+		}
+	}
+	shift := func(t time.Time) (time.Time, error) {
+		if t.IsZero() || options.ShiftDueBy == "" {
+			return t, nil
+		}
+		return timeexpr.Shift(t, options.ShiftDueBy, loc)
+	}
+	if _, err := timeexpr.Shift(time.Now(), options.ShiftDueBy, loc); options.ShiftDueBy != "" && err != nil {
+		return nil, fmt.Errorf("%w: shift_due_by must look like +7d, -2h or +1w", ErrInvalidClone)
+	}
+
+	root, err := s.ownedTask(ctx, userId, taskId)
+	if err != nil {
+		return nil, err
+	}
+	originals := []entity.Task{*root}
+	if options.IncludeSubtasks {
+		if originals, err = s.subtaskTree(ctx, root); err != nil {
+			return nil, err
+		}
+	}
+	if len(originals) > maxCloneTasks {
+		return nil, fmt.Errorf("%w: task tree has more than %d tasks", ErrInvalidClone, maxCloneTasks)
+	}
+
+	index := make(map[string]int, len(originals))
+	copies := make([]entity.TaskCopy, 0, len(originals))
+	for i := range originals {
+		original := &originals[i]
+		index[original.ID] = i
+
+		clone := &entity.Task{
+			Title:        original.Title,
+			Description:  original.Description,
+			Priority:     original.Priority,
+			Status:       original.Status,
+			Tags:         slices.Clone(original.Tags),
+			User_id:      original.User_id,
+			Estimate:     original.Estimate,
+			Project:      original.Project,
+			CustomFields: maps.Clone(original.CustomFields),
+		}
+		if clone.Tags == nil {
+			clone.Tags = []string{}
+		}
+		if options.ResetStatus {
+			clone.Status = entity.StatusPending
+		}
+		if clone.DueDate, err = shift(original.DueDate); err != nil {
+			return nil, err
+		}
+		if clone.StartAt, err = shift(original.StartAt); err != nil {
+			return nil, err
+		}
+		if err := s.applySLA(ctx, clone); err != nil {
+			return nil, err
+		}
+
+		parent := -1
+		if i == 0 {
+			clone.ParentID = original.ParentID
+		} else {
+			parent = index[original.ParentID]
+		}
+
+		checklist, err := s.checklistRepo.ListItems(ctx, original.ID)
+		if err != nil {
+			s.Log.Error("Error caused, after calling repo's ListItems checklist, in CloneTask", zap.Error(err))
+			return nil, err
+		}
+		if options.ResetStatus {
+			for j := range checklist {
+				checklist[j].Done = false
+			}
+		}
+		copies = append(copies, entity.TaskCopy{Task: clone, Parent: parent, Checklist: checklist})
+	}
+
+	if err := s.taskRepo.CloneTaskTree(ctx, copies); err != nil {
+		s.Log.Error("Error caused, after calling repo's CloneTaskTree, in task service", zap.Error(err))
+		return nil, err
+	}
+	clone := copies[0].Task
+	clone.Checklist = checklistProgress(copies[0].Checklist)
+	return clone, nil
+}
+
+// subtaskTree возвращает root и всех его потомков так, что родитель всегда идет раньше подзадач
+func (s *taskService) subtaskTree(ctx context.Context, root *entity.Task) ([]entity.Task, error) {
+	tasks, err := s.taskRepo.ListTasks(ctx, entity.TaskFilter{UserID: root.User_id})
+	if err != nil {
+		s.Log.Error("Error caused, after calling repo's ListTasks, in subtaskTree", zap.Error(err))
+		return nil, err
+	}
+	children := make(map[string][]entity.Task)
+	for _, task := range tasks {
+		if task.ParentID != "" {
+			children[task.ParentID] = append(children[task.ParentID], task)
+		}
+	}
+
+	tree := []entity.Task{*root}
+	for i := 0; i < len(tree) && len(tree) <= maxCloneTasks; i++ {
+		tree = append(tree, children[tree[i].ID]...)
+	}
+	return tree, nil
+}
+
+func checklistProgress(items []entity.ChecklistItem) entity.ChecklistProgress {
+	progress := entity.ChecklistProgress{Total: len(items)}
+	for _, item := range items {
+		if item.Done {
+			progress.Done++
+		}
+	}
+	return progress
+}
@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidCustomField = errors.New("invalid custom field")
+	ErrUnknownCustomField = errors.New("unknown custom field")
+)
+
+// customFieldKeyRe - ключ поля используется в JSONB и в параметрах запроса (cf.<key>)
+var customFieldKeyRe = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+func (s *taskService) CreateCustomField(ctx context.Context, field *entity.CustomFieldDefinition) (*entity.CustomFieldDefinition, error) {
+	field.Key = strings.ToLower(strings.TrimSpace(field.Key))
+	field.Type = strings.ToLower(strings.TrimSpace(field.Type))
+	field.Project = strings.TrimSpace(field.Project)
+	if !customFieldKeyRe.MatchString(field.Key) {
+		return nil, fmt.Errorf("%w: key must match %s", ErrInvalidCustomField, customFieldKeyRe)
+	}
+	if err := normalizeCustomField(field); err != nil {
+		return nil, err
+	}
+
+	if err := s.fieldRepo.Create(ctx, field); err != nil {
+		s.Log.Error("Error caused, after calling repo's Create custom field, in task service", zap.Error(err))
+		return nil, err
+	}
+	return field, nil
+}
+
+func (s *taskService) ListCustomFields(ctx context.Context, userId, project string) ([]entity.CustomFieldDefinition, error) {
+	return s.fieldRepo.ListByUser(ctx, userId, project)
+}
+
+// UpdateCustomField меняет название, варианты и обязательность; ключ, тип и проект остаются прежними
+func (s *taskService) UpdateCustomField(ctx context.Context, field *entity.CustomFieldDefinition) (*entity.CustomFieldDefinition, error) {
+	current, err := s.fieldRepo.GetByID(ctx, field.ID, field.UserID)
+	if err != nil {
+		return nil, err
+	}
+	current.Name = field.Name
+	current.Options = field.Options
+	current.Required = field.Required
+	if err := normalizeCustomField(current); err != nil {
+		return nil, err
+	}
+
+	if err := s.fieldRepo.Update(ctx, current); err != nil {
+		s.Log.Error("Error caused, after calling repo's Update custom field, in task service", zap.Error(err))
+		return nil, err
+	}
+	return current, nil
+}
+
+func (s *taskService) DeleteCustomField(ctx context.Context, fieldId, userId string) error {
+	return s.fieldRepo.Delete(ctx, fieldId, userId)
+}
+
+// customFieldDefinitions возвращает поля, действующие для задач проекта, по ключу.
+// Поле проекта перекрывает общее поле пользователя с тем же ключом
+func (s *taskService) customFieldDefinitions(ctx context.Context, userId, project string) (map[string]entity.CustomFieldDefinition, error) {
+	fields, err := s.fieldRepo.ListByUser(ctx, userId, project)
+	if err != nil {
+		s.Log.Error("Error caused, after calling repo's ListByUser custom fields, in task service", zap.Error(err))
+		return nil, err
+	}
+
+	defs := make(map[string]entity.CustomFieldDefinition, len(fields))
+	for _, field := range fields {
+		if field.Project != "" && field.Project != project {
+			continue
+		}
+		if existing, ok := defs[field.Key]; ok && existing.Project != "" {
+			continue
+		}
+		defs[field.Key] = field
+	}
+	return defs, nil
+}
+
+func normalizeCustomField(field *entity.CustomFieldDefinition) error {
+	field.Name = strings.TrimSpace(field.Name)
+	if field.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidCustomField)
+	}
+
+	switch field.Type {
+	case entity.CustomFieldText, entity.CustomFieldNumber, entity.CustomFieldDate:
+		if len(field.Options) > 0 {
+			return fmt.Errorf("%w: options are allowed only for select fields", ErrInvalidCustomField)
+		}
+	case entity.CustomFieldSingleSelect, entity.CustomFieldMultiSelect:
+		seen := make(map[string]bool, len(field.Options))
+		options := make([]string, 0, len(field.Options))
+		for _, option := range field.Options {
+			option = strings.TrimSpace(option)
+			if option == "" || seen[option] {
+				continue
+			}
+			seen[option] = true
+			options = append(options, option)
+		}
+		if len(options) == 0 {
+			return fmt.Errorf("%w: select field needs options", ErrInvalidCustomField)
+		}
+		field.Options = options
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidCustomField, field.Type)
+	}
+	return nil
+}
+
+// validateCustomFields проверяет значения по определениям и приводит их к хранимому виду:
+// number - float64, date - "YYYY-MM-DD", multi_select - список без повторов.
+// nil-значение означает удаление поля и в результат не попадает
+func validateCustomFields(defs map[string]entity.CustomFieldDefinition, values map[string]any) (map[string]any, error) {
+	result := make(map[string]any, len(values))
+	for key, value := range values {
+		def, ok := defs[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownCustomField, key)
+		}
+		if value == nil {
+			continue
+		}
+		normalized, err := normalizeCustomValue(def, value)
+		if err != nil {
+			return nil, err
+		}
+		result[key] = normalized
+	}
+	return result, nil
+}
+
+// checkRequiredFields возвращает ошибку, если у задачи нет значения обязательного поля
+func checkRequiredFields(defs map[string]entity.CustomFieldDefinition, values map[string]any) error {
+	for key, def := range defs {
+		if _, ok := values[key]; def.Required && !ok {
+			return fmt.Errorf("%w: %s is required", ErrInvalidCustomField, key)
+		}
+	}
+	return nil
+}
+
+func normalizeCustomValue(def entity.CustomFieldDefinition, value any) (any, error) {
+	invalid := fmt.Errorf("%w: %s expects %s", ErrInvalidCustomField, def.Key, def.Type)
+	switch def.Type {
+	case entity.CustomFieldText:
+		text, ok := value.(string)
+		if !ok {
+			return nil, invalid
+		}
+		return text, nil
+	case entity.CustomFieldNumber:
+		switch number := value.(type) {
+		case float64:
+			return number, nil
+		case int:
+			return float64(number), nil
+		}
+		return nil, invalid
+	case entity.CustomFieldDate:
+		text, ok := value.(string)
+		if !ok {
+			return nil, invalid
+		}
+		date, err := parseCustomDate(text)
+		if err != nil {
+			return nil, invalid
+		}
+		return date, nil
+	case entity.CustomFieldSingleSelect:
+		option, ok := value.(string)
+		if !ok || !hasOption(def, option) {
+			return nil, fmt.Errorf("%w: %s must be one of %s", ErrInvalidCustomField, def.Key, strings.Join(def.Options, ", "))
+		}
+		return option, nil
+	case entity.CustomFieldMultiSelect:
+		items, ok := value.([]any)
+		if !ok {
+			return nil, invalid
+		}
+		seen := make(map[string]bool, len(items))
+		// []any, а не []string: значения задачи передаются через google.protobuf.Struct
+		options := make([]any, 0, len(items))
+		for _, item := range items {
+			option, ok := item.(string)
+			if !ok || !hasOption(def, option) {
+				return nil, fmt.Errorf("%w: %s must contain only %s", ErrInvalidCustomField, def.Key, strings.Join(def.Options, ", "))
+			}
+			if !seen[option] {
+				seen[option] = true
+				options = append(options, option)
+			}
+		}
+		return options, nil
+	}
+	return nil, invalid
+}
+
+// customFieldConditions приводит строковые значения условий к типу поля.
+// Для select-полей допустимо только равенство, для multi_select оно означает "содержит"
+func customFieldConditions(defs map[string]entity.CustomFieldDefinition, conditions []entity.CustomFieldCondition) ([]entity.CustomFieldCondition, error) {
+	result := make([]entity.CustomFieldCondition, 0, len(conditions))
+	for _, condition := range conditions {
+		def, ok := defs[condition.Key]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownCustomField, condition.Key)
+		}
+		op := condition.Op
+		if op == "" {
+			op = entity.CustomFieldOpEq
+		}
+		switch op {
+		case entity.CustomFieldOpEq, entity.CustomFieldOpGt, entity.CustomFieldOpGte, entity.CustomFieldOpLt, entity.CustomFieldOpLte:
+		default:
+			return nil, fmt.Errorf("%w: unknown operator %q", ErrInvalidCustomField, op)
+		}
+
+		raw := fmt.Sprint(condition.Value)
+		var value any = raw
+		switch def.Type {
+		case entity.CustomFieldNumber:
+			number, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s expects number", ErrInvalidCustomField, def.Key)
+			}
+			value = number
+		case entity.CustomFieldDate:
+			date, err := parseCustomDate(raw)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s expects date", ErrInvalidCustomField, def.Key)
+			}
+			value = date
+		case entity.CustomFieldSingleSelect, entity.CustomFieldMultiSelect:
+			if op != entity.CustomFieldOpEq {
+				return nil, fmt.Errorf("%w: %s supports only eq", ErrInvalidCustomField, def.Key)
+			}
+			if def.Type == entity.CustomFieldMultiSelect {
+				value = []any{raw}
+			}
+		}
+		result = append(result, entity.CustomFieldCondition{Key: def.Key, Op: op, Value: value})
+	}
+	return result, nil
+}
+
+func parseCustomDate(text string) (string, error) {
+	if t, err := time.Parse("2006-01-02", text); err == nil {
+		return t.Format("2006-01-02"), nil
+	}
+	t, err := time.Parse(time.RFC3339, text)
+	if err != nil {
+		return "", err
+	}
+	return t.Format("2006-01-02"), nil
+}
+
+func hasOption(def entity.CustomFieldDefinition, option string) bool {
+	for _, candidate := range def.Options {
+		if candidate == option {
+			return true
+		}
+	}
+	return false
+}
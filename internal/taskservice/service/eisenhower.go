@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidQuadrant         = errors.New("invalid eisenhower quadrant")
+	ErrInvalidMatrixThresholds = errors.New("invalid eisenhower matrix thresholds")
+)
+
+// Пороги матрицы по умолчанию
+const (
+	defaultImportantPriority = entity.PriorityHigh
+	defaultUrgentWithinHours = 48
+	defaultUrgentSLAStatus   = entity.SLAStatusAtRisk
+)
+
+// GetEisenhowerMatrix раскладывает открытые задачи пользователя по квадрантам: важность определяется
+// приоритетом, срочность - близостью срока и статусом SLA. Закрепленный вручную квадрант
+// перекрывает вычисленный
+func (s *taskService) GetEisenhowerMatrix(ctx context.Context, query entity.EisenhowerQuery) (*entity.EisenhowerMatrix, error) {
+	thresholds, err := matrixThresholds(query.Thresholds)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.taskRepo.ListTasks(ctx, entity.TaskFilter{
+		UserID:   query.UserID,
+		Statuses: []string{entity.StatusPending, entity.StatusInProgress},
+		Project:  strings.TrimSpace(query.Project),
+		// отложенные задачи не требуют решения, пока не вернутся в список
+		AvailableAt: availableAt(time.Now()),
+	})
+	if err != nil {
+		s.Log.Error("Error caused, after calling repo's ListTasks, in GetEisenhowerMatrix", zap.Error(err))
+		return nil, err
+	}
+	overrides, err := s.quadrantRepo.ListQuadrantOverrides(ctx, query.UserID)
+	if err != nil {
+		s.Log.Error("Error caused, after calling repo's ListQuadrantOverrides, in task service", zap.Error(err))
+		return nil, err
+	}
+
+	atRisk, err := s.atRiskThresholds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	matrix := buildMatrix(tasks, overrides, thresholds, atRisk, time.Now())
+	return &matrix, nil
+}
+
+// SetQuadrantOverride закрепляет задачу владельца в квадранте; пустой quadrant снимает закрепление
+func (s *taskService) SetQuadrantOverride(ctx context.Context, userId, taskId, quadrant string) (string, error) {
+	quadrant = strings.ToUpper(strings.TrimSpace(quadrant))
+	if quadrant != "" && !isValidQuadrant(quadrant) {
+		return "", ErrInvalidQuadrant
+	}
+
+	task, err := s.GetTask(ctx, taskId)
+	if err != nil {
+		return "", err
+	}
+	if task.User_id != userId {
+		return "", ErrForbidden
+	}
+
+	if quadrant == "" {
+		err = s.quadrantRepo.DeleteQuadrantOverride(ctx, userId, task.ID)
+	} else {
+		err = s.quadrantRepo.SetQuadrantOverride(ctx, userId, task.ID, quadrant)
+	}
+	if err != nil {
+		s.Log.Error("Error caused, after saving quadrant override, in task service", zap.Error(err))
+		return "", err
+	}
+	return quadrant, nil
+}
+
+// matrixThresholds проверяет пороги и подставляет значения по умолчанию вместо пустых
+func matrixThresholds(t entity.MatrixThresholds) (entity.MatrixThresholds, error) {
+	t.ImportantPriority = strings.ToUpper(strings.TrimSpace(t.ImportantPriority))
+	if t.ImportantPriority == "" {
+		t.ImportantPriority = defaultImportantPriority
+	}
+	if !isValidPriority(t.ImportantPriority) {
+		return t, fmt.Errorf("%w: unknown priority %q", ErrInvalidMatrixThresholds, t.ImportantPriority)
+	}
+
+	if t.UrgentWithinHours < 0 {
+		return t, fmt.Errorf("%w: urgent_within_hours must not be negative", ErrInvalidMatrixThresholds)
+	}
+	if t.UrgentWithinHours == 0 {
+		t.UrgentWithinHours = defaultUrgentWithinHours
+	}
+
+	t.UrgentSLAStatus = strings.ToUpper(strings.TrimSpace(t.UrgentSLAStatus))
+	switch t.UrgentSLAStatus {
+	case "":
+		t.UrgentSLAStatus = defaultUrgentSLAStatus
+	case entity.SLAStatusAtRisk, entity.SLAStatusBreached:
+	default:
+		return t, fmt.Errorf("%w: urgent_sla_status must be %s or %s", ErrInvalidMatrixThresholds, entity.SLAStatusAtRisk, entity.SLAStatusBreached)
+	}
+	return t, nil
+}
+
+// buildMatrix классифицирует задачи; внутри квадранта задачи идут по сроку, без срока - в конце
+func buildMatrix(tasks []entity.Task, overrides map[string]string, thresholds entity.MatrixThresholds, atRisk map[string]float64, now time.Time) entity.EisenhowerMatrix {
+	matrix := entity.EisenhowerMatrix{
+		DoFirst:    []entity.MatrixTask{},
+		Schedule:   []entity.MatrixTask{},
+		Delegate:   []entity.MatrixTask{},
+		Eliminate:  []entity.MatrixTask{},
+		Thresholds: thresholds,
+	}
+
+	sort.SliceStable(tasks, func(i, j int) bool {
+		a, b := tasks[i], tasks[j]
+		if !a.DueDate.Equal(b.DueDate) {
+			return !a.DueDate.IsZero() && (b.DueDate.IsZero() || a.DueDate.Before(b.DueDate))
+		}
+		return a.CreatedAt.Before(b.CreatedAt)
+	})
+
+	for i := range tasks {
+		item := classifyTask(&tasks[i], thresholds, atRisk[tasks[i].Priority], now)
+		if quadrant, ok := overrides[tasks[i].ID]; ok && isValidQuadrant(quadrant) {
+			item.Reason = fmt.Sprintf("pinned manually, computed %s: %s", item.Quadrant, item.Reason)
+			item.Quadrant = quadrant
+			item.Important = quadrant == entity.QuadrantDoFirst || quadrant == entity.QuadrantSchedule
+			item.Urgent = quadrant == entity.QuadrantDoFirst || quadrant == entity.QuadrantDelegate
+			item.Overridden = true
+		}
+
+		switch item.Quadrant {
+		case entity.QuadrantDoFirst:
+			matrix.DoFirst = append(matrix.DoFirst, item)
+		case entity.QuadrantSchedule:
+			matrix.Schedule = append(matrix.Schedule, item)
+		case entity.QuadrantDelegate:
+			matrix.Delegate = append(matrix.Delegate, item)
+		default:
+			matrix.Eliminate = append(matrix.Eliminate, item)
+		}
+	}
+	return matrix
+}
+
+// classifyTask вычисляет квадрант задачи по порогам и объясняет решение
+func classifyTask(task *entity.Task, thresholds entity.MatrixThresholds, atRiskThreshold float64, now time.Time) entity.MatrixTask {
+	item := entity.MatrixTask{Task: task}
+
+	var reasons []string
+	item.Important = priorityRank(task.Priority) >= priorityRank(thresholds.ImportantPriority)
+	if item.Important {
+		reasons = append(reasons, "important: "+strings.ToLower(task.Priority)+" priority")
+	} else {
+		reasons = append(reasons, "not important: "+strings.ToLower(task.Priority)+" priority")
+	}
+
+	var urgentBy []string
+	window := time.Duration(thresholds.UrgentWithinHours) * time.Hour
+	switch left := task.DueDate.Sub(now); {
+	case task.DueDate.IsZero():
+	case left <= 0:
+		urgentBy = append(urgentBy, "overdue by "+humanDuration(-left))
+	case left <= window:
+		urgentBy = append(urgentBy, "due in "+humanDuration(left))
+	}
+	if status := currentSLAStatus(task, atRiskThreshold, now); slaStatusRank(status) >= slaStatusRank(thresholds.UrgentSLAStatus) {
+		urgentBy = append(urgentBy, "SLA "+strings.ToLower(strings.ReplaceAll(status, "_", " ")))
+	}
+
+	item.Urgent = len(urgentBy) > 0
+	switch {
+	case item.Urgent:
+		reasons = append(reasons, "urgent: "+strings.Join(urgentBy, ", "))
+	case task.DueDate.IsZero():
+		reasons = append(reasons, "not urgent: no due date")
+	default:
+		reasons = append(reasons, "not urgent: due in "+humanDuration(task.DueDate.Sub(now)))
+	}
+
+	item.Quadrant = quadrantOf(item.Important, item.Urgent)
+	item.Reason = strings.Join(reasons, "; ")
+	return item
+}
+
+func quadrantOf(important, urgent bool) string {
+	switch {
+	case important && urgent:
+		return entity.QuadrantDoFirst
+	case important:
+		return entity.QuadrantSchedule
+	case urgent:
+		return entity.QuadrantDelegate
+	}
+	return entity.QuadrantEliminate
+}
+
+func isValidQuadrant(quadrant string) bool {
+	switch quadrant {
+	case entity.QuadrantDoFirst, entity.QuadrantSchedule, entity.QuadrantDelegate, entity.QuadrantEliminate:
+		return true
+	}
+	return false
+}
+
+// priorityRank - порядковый номер приоритета; неизвестный приоритет считается обычным
+func priorityRank(priority string) int {
+	switch priority {
+	case entity.PriorityLow:
+		return 0
+	case entity.PriorityHigh:
+		return 2
+	case entity.PriorityCritical:
+		return 3
+	}
+	return 1
+}
+
+// slaStatusRank - тяжесть статуса SLA; у задач без активного SLA ранг ниже любого порога
+func slaStatusRank(status string) int {
+	switch status {
+	case entity.SLAStatusOnTrack:
+		return 1
+	case entity.SLAStatusAtRisk:
+		return 2
+	case entity.SLAStatusBreached:
+		return 3
+	}
+	return 0
+}
@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/taskservice/sla"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidScoreWeights = errors.New("invalid score weights")
+)
+
+const (
+	defaultNextTasksLimit = 10
+	maxNextTasksLimit     = 100
+	// dueHorizon - за сколько до срока задача начинает набирать баллы за близость срока
+	dueHorizon = 14 * 24 * time.Hour
+	// ageHorizon - возраст, после которого фактор возраста перестает расти
+	ageHorizon = 30 * 24 * time.Hour
+)
+
+// defaultScoreWeights возвращает веса факторов оценки по умолчанию: срок и SLA важнее приоритета,
+// блокировки заметно сдвигают задачу, возраст только разводит равные задачи
+func defaultScoreWeights() map[string]float64 {
+	return map[string]float64{
+		entity.ScoreFactorPriority: 3,
+		entity.ScoreFactorDueDate:  4,
+		entity.ScoreFactorSLA:      3,
+		entity.ScoreFactorBlocking: 2,
+		entity.ScoreFactorAge:      1,
+	}
+}
+
+// GetNextTasks ранжирует открытые задачи пользователя по взвешенной сумме факторов
+// и объясняет вклад каждого фактора
+func (s *taskService) GetNextTasks(ctx context.Context, query entity.NextTasksQuery) (*entity.NextTasksResponse, error) {
+	weights, err := scoreWeights(query.Weights)
+	if err != nil {
+		return nil, err
+	}
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultNextTasksLimit
+	}
+	if limit > maxNextTasksLimit {
+		limit = maxNextTasksLimit
+	}
+
+	// блокировки считаются по всем открытым задачам: подзадача может быть в другом проекте
+	tasks, err := s.taskRepo.ListTasks(ctx, entity.TaskFilter{
+		UserID:   query.UserID,
+		Statuses: []string{entity.StatusPending, entity.StatusInProgress},
+	})
+	if err != nil {
+		s.Log.Error("Error caused, after calling repo's ListTasks, in GetNextTasks", zap.Error(err))
+		return nil, err
+	}
+
+	atRisk, err := s.atRiskThresholds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	scored := rankTasks(tasks, weights, atRisk, now)
+	response := &entity.NextTasksResponse{Tasks: []entity.ScoredTask{}, Weights: weights}
+	for _, task := range scored {
+		if query.Project != "" && task.Task.Project != query.Project {
+			continue
+		}
+		// отложенная задача все равно блокирует родителя, но предлагать ее рано
+		if isDeferred(task.Task, now) {
+			continue
+		}
+		response.Tasks = append(response.Tasks, task)
+		if len(response.Tasks) == limit {
+			break
+		}
+	}
+	return response, nil
+}
+
+// scoreWeights дополняет переданные веса значениями по умолчанию
+func scoreWeights(overrides map[string]float64) (map[string]float64, error) {
+	weights := defaultScoreWeights()
+	for factor, weight := range overrides {
+		factor = strings.ToLower(strings.TrimSpace(factor))
+		if _, ok := weights[factor]; !ok {
+			return nil, fmt.Errorf("%w: unknown factor %q", ErrInvalidScoreWeights, factor)
+		}
+		if weight < 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+			return nil, fmt.Errorf("%w: %s must be a non-negative number", ErrInvalidScoreWeights, factor)
+		}
+		weights[factor] = weight
+	}
+	return weights, nil
+}
+
+// rankTasks оценивает открытые задачи и сортирует их по убыванию оценки; при равенстве
+// выше задача с более ранним сроком, затем более старая
+func rankTasks(tasks []entity.Task, weights, atRisk map[string]float64, now time.Time) []entity.ScoredTask {
+	byID := make(map[string]*entity.Task, len(tasks))
+	openSubtasks := make(map[string]int)
+	for i := range tasks {
+		byID[tasks[i].ID] = &tasks[i]
+		if tasks[i].ParentID != "" {
+			openSubtasks[tasks[i].ParentID]++
+		}
+	}
+
+	scored := make([]entity.ScoredTask, 0, len(tasks))
+	for i := range tasks {
+		task := &tasks[i]
+		factors := []entity.ScoreFactor{
+			priorityFactor(task),
+			dueDateFactor(task, now),
+			slaFactor(task, atRisk[task.Priority], now),
+			blockingFactor(byID[task.ParentID], openSubtasks[task.ID]),
+			ageFactor(task, now),
+		}
+		var score float64
+		for j := range factors {
+			factors[j].Weight = weights[factors[j].Factor]
+			factors[j].Value = round3(factors[j].Value)
+			factors[j].Contribution = round3(factors[j].Weight * factors[j].Value)
+			score += factors[j].Contribution
+		}
+		scored = append(scored, entity.ScoredTask{Task: task, Score: round3(score), Factors: factors})
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		a, b := scored[i].Task, scored[j].Task
+		if scored[i].Score != scored[j].Score {
+			return scored[i].Score > scored[j].Score
+		}
+		if !a.DueDate.Equal(b.DueDate) {
+			return !a.DueDate.IsZero() && (b.DueDate.IsZero() || a.DueDate.Before(b.DueDate))
+		}
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+		return a.ID < b.ID
+	})
+	return scored
+}
+
+func priorityFactor(task *entity.Task) entity.ScoreFactor {
+	values := map[string]float64{
+		entity.PriorityCritical: 1,
+		entity.PriorityHigh:     0.75,
+		entity.PriorityNormal:   0.5,
+		entity.PriorityLow:      0.25,
+	}
+	value, ok := values[task.Priority]
+	if !ok {
+		value = values[entity.PriorityNormal]
+	}
+	return entity.ScoreFactor{
+		Factor: entity.ScoreFactorPriority,
+		Value:  value,
+		Reason: strings.ToLower(task.Priority) + " priority",
+	}
+}
+
+// dueDateFactor растет линейно от 0 за dueHorizon до срока до 1 в момент срока; просроченная задача получает 1
+func dueDateFactor(task *entity.Task, now time.Time) entity.ScoreFactor {
+	factor := entity.ScoreFactor{Factor: entity.ScoreFactorDueDate}
+	switch left := task.DueDate.Sub(now); {
+	case task.DueDate.IsZero():
+		factor.Reason = "no due date"
+	case left <= 0:
+		factor.Value = 1
+		factor.Reason = "overdue by " + humanDuration(-left)
+	default:
+		factor.Value = math.Max(0, 1-float64(left)/float64(dueHorizon))
+		factor.Reason = "due in " + humanDuration(left)
+	}
+	return factor
+}
+
+// currentSLAStatus возвращает статус SLA на now: сохраненный статус может отставать от фоновой
+// проверки, поэтому берется худший из сохраненного и вычисленного с порогом AT_RISK политики приоритета
+func currentSLAStatus(task *entity.Task, atRiskThreshold float64, now time.Time) string {
+	status := task.SLAStatus
+	if status == entity.SLAStatusOnTrack || status == entity.SLAStatusAtRisk {
+		if current, _, _ := sla.Evaluate(*task, atRiskThreshold, now); current != entity.SLAStatusOnTrack {
+			status = current
+		}
+	}
+	return status
+}
+
+func slaFactor(task *entity.Task, atRiskThreshold float64, now time.Time) entity.ScoreFactor {
+	factor := entity.ScoreFactor{Factor: entity.ScoreFactorSLA}
+	switch currentSLAStatus(task, atRiskThreshold, now) {
+	case "", entity.SLAStatusMet:
+		factor.Reason = "no active SLA"
+		return factor
+	case entity.SLAStatusBreached:
+		factor.Value = 1
+		factor.Reason = "SLA breached"
+	case entity.SLAStatusAtRisk:
+		factor.Value = 0.75
+		factor.Reason = "SLA at risk"
+	default:
+		factor.Value = 0.25
+		factor.Reason = "SLA on track"
+	}
+	if !task.SLADueAt.IsZero() {
+		factor.Reason += ", resolution due " + task.SLADueAt.UTC().Format("2006-01-02 15:04 MST")
+	}
+	return factor
+}
+
+// blockingFactor поднимает подзадачи, которые держат открытую родительскую задачу,
+// и опускает задачи, ожидающие своих открытых подзадач
+func blockingFactor(parent *entity.Task, openSubtasks int) entity.ScoreFactor {
+	factor := entity.ScoreFactor{Factor: entity.ScoreFactorBlocking}
+	var reasons []string
+	if parent != nil {
+		factor.Value++
+		reasons = append(reasons, fmt.Sprintf("blocks parent task %q", parent.Title))
+	}
+	if openSubtasks > 0 {
+		factor.Value--
+		reasons = append(reasons, fmt.Sprintf("waiting for %d open subtask(s)", openSubtasks))
+	}
+	factor.Reason = strings.Join(reasons, "; ")
+	if factor.Reason == "" {
+		factor.Reason = "no dependencies"
+	}
+	return factor
+}
+
+func ageFactor(task *entity.Task, now time.Time) entity.ScoreFactor {
+	age := now.Sub(task.CreatedAt)
+	if age < 0 {
+		age = 0
+	}
+	return entity.ScoreFactor{
+		Factor: entity.ScoreFactorAge,
+		Value:  math.Min(1, float64(age)/float64(ageHorizon)),
+		Reason: "open for " + humanDuration(age),
+	}
+}
+
+// humanDuration округляет длительность до дней, часов или минут
+func humanDuration(d time.Duration) string {
+	switch {
+	case d >= 24*time.Hour:
+		return fmt.Sprintf("%dd", int(d/(24*time.Hour)))
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh", int(d/time.Hour))
+	}
+	return fmt.Sprintf("%dm", int(d/time.Minute))
+}
+
+func round3(v float64) float64 {
+	return math.Round(v*1000) / 1000
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultNotificationsLimit = 50
+	maxNotificationsLimit     = 200
+)
+
+// ListNotifications возвращает уведомления пользователя, новые первыми, и число непрочитанных
+func (s *taskService) ListNotifications(ctx context.Context, userId string, unreadOnly bool, limit, offset int) ([]entity.Notification, int, error) {
+	if limit <= 0 {
+		limit = defaultNotificationsLimit
+	}
+	if limit > maxNotificationsLimit {
+		limit = maxNotificationsLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	notifications, err := s.notificationRepo.ListNotifications(ctx, userId, unreadOnly, limit, offset)
+	if err != nil {
+		s.Log.Error("Error caused, after calling repo's ListNotifications, in task service", zap.Error(err))
+		return nil, 0, err
+	}
+	unread, err := s.notificationRepo.CountUnreadNotifications(ctx, userId)
+	if err != nil {
+		return nil, 0, err
+	}
+	return notifications, unread, nil
+}
+
+// MarkRead отмечает уведомление прочитанным и возвращает оставшееся число непрочитанных
+func (s *taskService) MarkRead(ctx context.Context, userId, notificationId string) (int, error) {
+	if err := s.notificationRepo.MarkNotificationRead(ctx, userId, notificationId, time.Now()); err != nil {
+		s.Log.Error("Error caused, after calling repo's MarkNotificationRead, in task service", zap.Error(err))
+		return 0, err
+	}
+	return s.notificationRepo.CountUnreadNotifications(ctx, userId)
+}
+
+// MarkAllRead отмечает прочитанными все уведомления и возвращает их число и оставшееся число непрочитанных
+func (s *taskService) MarkAllRead(ctx context.Context, userId string) (int, int, error) {
+	updated, err := s.notificationRepo.MarkAllNotificationsRead(ctx, userId, time.Now())
+	if err != nil {
+		s.Log.Error("Error caused, after calling repo's MarkAllNotificationsRead, in task service", zap.Error(err))
+		return 0, 0, err
+	}
+	unread, err := s.notificationRepo.CountUnreadNotifications(ctx, userId)
+	if err != nil {
+		return 0, 0, err
+	}
+	return updated, unread, nil
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/taskservice/repository"
+	"github.com/oogway93/taskmanager/internal/taskservice/timeexpr"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidPublicLink = errors.New("invalid public link")
+)
+
+const (
+	// publicLinkTTL - срок действия ссылки, если expires_at не задан
+	publicLinkTTL = 7 * 24 * time.Hour
+	// maxPublicLinkTTL ограничивает срок, чтобы забытая ссылка не оставалась открытой навсегда
+	maxPublicLinkTTL = 90 * 24 * time.Hour
+	// publicTokenBytes - 256 бит случайности; в base64url это 43 символа
+	publicTokenBytes = 32
+)
+
+// CreatePublicLink создает ссылку на задачу владельца, открывающую ее только для чтения без входа.
+// expiresAt - RFC3339 или выражение timeexpr в часовом поясе timezone. Токен возвращается только здесь
+func (s *taskService) CreatePublicLink(ctx context.Context, userId, taskId, expiresAt, timezone string) (*entity.PublicLink, error) {
+	now := time.Now()
+	expires := now.Add(publicLinkTTL)
+	if strings.TrimSpace(expiresAt) != "" {
+		loc := time.UTC
+		if timezone != "" {
+			var err error
+			if loc, err = time.LoadLocation(timezone); err != nil {
+				return nil, ErrInvalidTimezone
+			}
+		}
+		var err error
+		if expires, err = timeexpr.Eval(expiresAt, now, loc); err != nil {
+			return nil, err
+		}
+	}
+	if !expires.After(now) || expires.Sub(now) > maxPublicLinkTTL {
+		return nil, fmt.Errorf("%w: expires_at must be in the future and at most %d days away", ErrInvalidPublicLink, int(maxPublicLinkTTL.Hours()/24))
+	}
+
+	task, err := s.ownedTask(ctx, userId, taskId)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := make([]byte, publicTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		s.Log.Error("Failed generate public link token", zap.Error(err))
+		return nil, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	link := &entity.PublicLink{
+		TaskID:    task.ID,
+		UserID:    userId,
+		ExpiresAt: expires,
+	}
+	if err := s.linkRepo.CreateLink(ctx, link, hashPublicToken(token)); err != nil {
+		s.Log.Error("Error caused, after calling repo's CreateLink, in task service", zap.Error(err))
+		return nil, err
+	}
+	link.Token = token
+	return link, nil
+}
+
+// ListPublicLinks возвращает ссылки задачи владельца вместе со счетчиками просмотров
+func (s *taskService) ListPublicLinks(ctx context.Context, userId, taskId string) ([]entity.PublicLink, error) {
+	task, err := s.ownedTask(ctx, userId, taskId)
+	if err != nil {
+		return nil, err
+	}
+	links, err := s.linkRepo.ListLinks(ctx, task.ID)
+	if err != nil {
+		s.Log.Error("Error caused, after calling repo's ListLinks, in task service", zap.Error(err))
+		return nil, err
+	}
+	return links, nil
+}
+
+// RevokePublicLink отзывает ссылку; после этого она перестает открываться сразу
+func (s *taskService) RevokePublicLink(ctx context.Context, userId, taskId, linkId string) (*entity.PublicLink, error) {
+	task, err := s.ownedTask(ctx, userId, taskId)
+	if err != nil {
+		return nil, err
+	}
+	link, err := s.linkRepo.RevokeLink(ctx, task.ID, linkId)
+	if err != nil {
+		s.Log.Error("Error caused, after calling repo's RevokeLink, in task service", zap.Error(err))
+		return nil, err
+	}
+	return link, nil
+}
+
+// GetPublicTask открывает задачу по токену публичной ссылки и засчитывает просмотр.
+// Наружу отдаются только поля PublicTaskView: владелец, теги и пользовательские поля остаются скрытыми
+func (s *taskService) GetPublicTask(ctx context.Context, token string) (*entity.PublicTaskView, error) {
+	if !validPublicToken(token) {
+		return nil, repository.ErrPublicLinkNotFound
+	}
+	link, err := s.linkRepo.ViewLink(ctx, hashPublicToken(token))
+	if err != nil {
+		if !errors.Is(err, repository.ErrPublicLinkNotFound) {
+			s.Log.Error("Error caused, after calling repo's ViewLink, in task service", zap.Error(err))
+		}
+		return nil, err
+	}
+	task, err := s.GetTask(ctx, link.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	view := &entity.PublicTaskView{
+		Title:         task.Title,
+		Description:   task.Description,
+		Status:        task.Status,
+		Priority:      task.Priority,
+		UpdatedAt:     task.UpdatedAt,
+		LinkExpiresAt: link.ExpiresAt,
+	}
+	if !task.DueDate.IsZero() {
+		view.DueDate = &task.DueDate
+	}
+	if !task.CompletedAt.IsZero() {
+		view.CompletedAt = &task.CompletedAt
+	}
+	if task.Checklist.Total > 0 {
+		view.Checklist = &task.Checklist
+	}
+	return view, nil
+}
+
+func hashPublicToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// validPublicToken отсекает заведомо чужие строки до запроса в базу
+func validPublicToken(token string) bool {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	return err == nil && len(raw) == publicTokenBytes
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/taskservice/timeexpr"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidSavedView = errors.New("invalid saved view")
+)
+
+func (s *taskService) CreateSavedView(ctx context.Context, view *entity.SavedView) (*entity.SavedView, error) {
+	if err := normalizeSavedView(view); err != nil {
+		return nil, err
+	}
+	if err := s.viewRepo.Create(ctx, view); err != nil {
+		s.Log.Error("Error caused, after calling repo's Create saved view, in task service", zap.Error(err))
+		return nil, err
+	}
+	return view, nil
+}
+
+func (s *taskService) GetSavedView(ctx context.Context, viewId, userId string) (*entity.SavedView, error) {
+	return s.viewRepo.GetByID(ctx, viewId, userId)
+}
+
+func (s *taskService) ListSavedViews(ctx context.Context, userId string) ([]entity.SavedView, error) {
+	return s.viewRepo.ListByUser(ctx, userId)
+}
+
+func (s *taskService) UpdateSavedView(ctx context.Context, view *entity.SavedView) (*entity.SavedView, error) {
+	if err := normalizeSavedView(view); err != nil {
+		return nil, err
+	}
+	if err := s.viewRepo.Update(ctx, view); err != nil {
+		s.Log.Error("Error caused, after calling repo's Update saved view, in task service", zap.Error(err))
+		return nil, err
+	}
+	return s.viewRepo.GetByID(ctx, view.ID, view.UserID)
+}
+
+func (s *taskService) DeleteSavedView(ctx context.Context, viewId, userId string) error {
+	return s.viewRepo.Delete(ctx, viewId, userId)
+}
+
+// normalizeSavedView приводит статусы/приоритеты к верхнему регистру и проверяет выражения дат
+func normalizeSavedView(view *entity.SavedView) error {
+	view.Name = strings.TrimSpace(view.Name)
+	if view.Name == "" {
+		return ErrInvalidSavedView
+	}
+
+	for i, status := range view.Filter.Statuses {
+		view.Filter.Statuses[i] = strings.ToUpper(status)
+		if !isValidStatus(view.Filter.Statuses[i]) {
+			return ErrInvalidStatus
+		}
+	}
+	for i, priority := range view.Filter.Priorities {
+		view.Filter.Priorities[i] = strings.ToUpper(priority)
+		if !isValidPriority(view.Filter.Priorities[i]) {
+			return ErrInvalidPriority
+		}
+	}
+	for _, expr := range []string{view.Filter.DueFrom, view.Filter.DueTo} {
+		if expr == "" {
+			continue
+		}
+		if err := timeexpr.Validate(expr); err != nil {
+			return err
+		}
+	}
+
+	view.Filter.Project = strings.TrimSpace(view.Filter.Project)
+	// существование полей проверяется при применении представления: определения могут меняться
+	for _, condition := range view.Filter.CustomFields {
+		if !customFieldKeyRe.MatchString(condition.Key) {
+			return fmt.Errorf("%w: %s", ErrUnknownCustomField, condition.Key)
+		}
+	}
+
+	if key, ok := strings.CutPrefix(view.Sort.Field, entity.SortByCustomFieldPrefix); ok {
+		if !customFieldKeyRe.MatchString(key) {
+			return ErrInvalidSavedView
+		}
+		return nil
+	}
+	switch view.Sort.Field {
+	case "", entity.SortByCreatedAt, entity.SortByUpdatedAt, entity.SortByDueDate, entity.SortByPriority, entity.SortByTitle:
+	default:
+		return ErrInvalidSavedView
+	}
+	return nil
+}
+
+// viewToFilter вычисляет относительные даты представления на момент запроса
+func viewToFilter(view *entity.SavedView, now time.Time, loc *time.Location) (entity.TaskFilter, error) {
+	filter := entity.TaskFilter{
+		UserID:     view.UserID,
+		Statuses:   view.Filter.Statuses,
+		Priorities: view.Filter.Priorities,
+		Tags:       view.Filter.Tags,
+		Search:     view.Filter.Search,
+		Project:    view.Filter.Project,
+		SortBy:     view.Sort.Field,
+		SortDesc:   view.Sort.Desc,
+	}
+	filter.CustomFields = append(filter.CustomFields, view.Filter.CustomFields...)
+
+	var err error
+	if view.Filter.DueFrom != "" {
+		if filter.DueFrom, err = timeexpr.Eval(view.Filter.DueFrom, now, loc); err != nil {
+			return entity.TaskFilter{}, err
+		}
+	}
+	if view.Filter.DueTo != "" {
+		if filter.DueTo, err = timeexpr.Eval(view.Filter.DueTo, now, loc); err != nil {
+			return entity.TaskFilter{}, err
+		}
+	}
+	return filter, nil
+}
+
+func isValidPriority(priority string) bool {
+	switch priority {
+	case entity.PriorityLow, entity.PriorityNormal, entity.PriorityHigh, entity.PriorityCritical:
+		return true
+	}
+	return false
+}
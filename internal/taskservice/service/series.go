@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+// statusOrder - порядок слоев на диаграмме накопленного потока
+var statusOrder = []string{
+	entity.StatusPending,
+	entity.StatusInProgress,
+	entity.StatusCompleted,
+	entity.StatusCancelled,
+}
+
+// GetBurndown строит дневной ряд оставшихся открытых задач и оценок за период
+func (s *taskService) GetBurndown(ctx context.Context, filter entity.StatsFilter) (*entity.BurndownResponse, error) {
+	tasks, history, filter, err := s.loadSeriesData(ctx, filter, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return computeBurndown(tasks, history, filter), nil
+}
+
+// GetCumulativeFlow строит дневной ряд количества задач в каждом статусе за период
+func (s *taskService) GetCumulativeFlow(ctx context.Context, filter entity.StatsFilter) (*entity.CumulativeFlowResponse, error) {
+	filter.IncludeClosed = true
+	tasks, history, filter, err := s.loadSeriesData(ctx, filter, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return computeCumulativeFlow(tasks, history, filter), nil
+}
+
+// loadSeriesData проверяет период, подставляя границы по умолчанию, и загружает задачи периода
+// с историей их статусов; общая часть статистики и дневных рядов
+func (s *taskService) loadSeriesData(ctx context.Context, filter entity.StatsFilter, now time.Time) ([]entity.Task, []entity.StatusChange, entity.StatsFilter, error) {
+	if filter.Location == nil {
+		filter.Location = time.UTC
+	}
+	// будущие дни ряда ничего не показывают, а по длине периода растет число точек
+	if filter.To.IsZero() || filter.To.After(now) {
+		filter.To = now
+	}
+	if filter.From.IsZero() {
+		filter.From = filter.To.Add(-defaultStatsPeriod)
+	}
+	if !filter.From.Before(filter.To) || filter.To.Sub(filter.From) > maxStatsPeriod {
+		return nil, nil, filter, ErrInvalidRange
+	}
+
+	tasks, err := s.taskRepo.ListTasksForStats(ctx, filter)
+	if err != nil {
+		s.Log.Error("Error caused, after calling repo's ListTasksForStats, in task service", zap.Error(err))
+		return nil, nil, filter, err
+	}
+
+	taskIds := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		taskIds = append(taskIds, task.ID)
+	}
+	history, err := s.taskRepo.ListStatusHistory(ctx, taskIds)
+	if err != nil {
+		s.Log.Error("Error caused, after calling repo's ListStatusHistory, in task service", zap.Error(err))
+		return nil, nil, filter, err
+	}
+	return tasks, history, filter, nil
+}
+
+func computeBurndown(tasks []entity.Task, history []entity.StatusChange, filter entity.StatsFilter) *entity.BurndownResponse {
+	timeline := newStatusTimeline(tasks, history)
+	days := localDays(filter)
+
+	resp := &entity.BurndownResponse{Points: make([]entity.BurndownPoint, 0, len(days))}
+	for _, day := range days {
+		point := entity.BurndownPoint{Date: day.label}
+		for _, task := range tasks {
+			status, exists := timeline.statusAt(task, day.end)
+			if !exists || !isOpenStatus(status) {
+				continue
+			}
+			point.OpenTasks++
+			point.RemainingEstimate += task.Estimate
+		}
+		point.RemainingEstimate = round2(point.RemainingEstimate)
+		resp.Points = append(resp.Points, point)
+	}
+
+	// идеальная линия: равномерное сгорание от остатка первого дня до нуля
+	if len(resp.Points) > 1 {
+		start := resp.Points[0].RemainingEstimate
+		last := float64(len(resp.Points) - 1)
+		for i := range resp.Points {
+			resp.Points[i].IdealEstimate = round2(start * (1 - float64(i)/last))
+		}
+	} else if len(resp.Points) == 1 {
+		resp.Points[0].IdealEstimate = resp.Points[0].RemainingEstimate
+	}
+	return resp
+}
+
+func computeCumulativeFlow(tasks []entity.Task, history []entity.StatusChange, filter entity.StatsFilter) *entity.CumulativeFlowResponse {
+	timeline := newStatusTimeline(tasks, history)
+	days := localDays(filter)
+
+	seen := make(map[string]bool)
+	resp := &entity.CumulativeFlowResponse{Points: make([]entity.CumulativeFlowPoint, 0, len(days))}
+	for _, day := range days {
+		point := entity.CumulativeFlowPoint{Date: day.label, Counts: make(map[string]int32)}
+		for _, status := range statusOrder {
+			point.Counts[status] = 0
+		}
+		for _, task := range tasks {
+			status, exists := timeline.statusAt(task, day.end)
+			if !exists {
+				continue
+			}
+			point.Counts[status]++
+			seen[status] = true
+		}
+		resp.Points = append(resp.Points, point)
+	}
+
+	resp.Statuses = append(resp.Statuses, statusOrder...)
+	var extra []string
+	for status := range seen {
+		if !isValidStatus(status) {
+			extra = append(extra, status)
+		}
+	}
+	sort.Strings(extra)
+	resp.Statuses = append(resp.Statuses, extra...)
+	return resp
+}
+
+// statusTimeline позволяет узнать статус задачи на любой момент времени
+type statusTimeline map[string][]entity.StatusChange
+
+func newStatusTimeline(tasks []entity.Task, history []entity.StatusChange) statusTimeline {
+	timeline := make(statusTimeline, len(tasks))
+	for _, change := range history {
+		timeline[change.TaskID] = append(timeline[change.TaskID], change)
+	}
+	return timeline
+}
+
+// statusAt возвращает статус задачи на момент at; exists = false, если задача еще не создана
+func (t statusTimeline) statusAt(task entity.Task, at time.Time) (string, bool) {
+	changes := t[task.ID]
+	if len(changes) == 0 {
+		// задачи без истории (до миграции) считаем в текущем статусе с момента создания
+		return task.Status, !task.CreatedAt.After(at)
+	}
+
+	idx := sort.Search(len(changes), func(i int) bool { return changes[i].ChangedAt.After(at) })
+	if idx == 0 {
+		return "", false
+	}
+	return changes[idx-1].Status, true
+}
+
+type seriesDay struct {
+	label string
+	end   time.Time
+}
+
+// localDays делит период на календарные дни в часовом поясе пользователя
+func localDays(filter entity.StatsFilter) []seriesDay {
+	from := filter.From.In(filter.Location)
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, filter.Location)
+
+	var days []seriesDay
+	for !day.After(filter.To) {
+		next := day.AddDate(0, 0, 1)
+		end := next.Add(-time.Nanosecond)
+		if end.After(filter.To) {
+			end = filter.To
+		}
+		days = append(days, seriesDay{label: day.Format("2006-01-02"), end: end})
+		day = next
+	}
+	return days
+}
+
+func isOpenStatus(status string) bool {
+	return status != entity.StatusCompleted && status != entity.StatusCancelled
+}
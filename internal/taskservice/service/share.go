@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/taskservice/repository"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidShare = errors.New("invalid task share")
+)
+
+// permissionOwner - уровень доступа владельца, выше любого выданного
+const permissionOwner = "OWNER"
+
+// GetTaskForUser возвращает задачу владельцу или пользователю, которому к ней выдан доступ
+func (s *taskService) GetTaskForUser(ctx context.Context, userId, taskId string) (*entity.Task, error) {
+	return s.sharedTask(ctx, userId, taskId, entity.SharePermissionRead)
+}
+
+// sharedTask возвращает задачу, если доступ пользователя не ниже required: READ - для чтения,
+// EDIT - для изменения. Чужая задача без доступа неотличима от несуществующей: ErrTaskNotFound
+// вместо ErrForbidden
+func (s *taskService) sharedTask(ctx context.Context, userId, taskId, required string) (*entity.Task, error) {
+	task, err := s.GetTask(ctx, taskId)
+	if err != nil {
+		return nil, err
+	}
+	permission, err := s.taskPermission(ctx, task, userId)
+	if errors.Is(err, ErrForbidden) {
+		return nil, repository.ErrTaskNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if required == entity.SharePermissionEdit && permission == entity.SharePermissionRead {
+		return nil, ErrForbidden
+	}
+	return task, nil
+}
+
+// ShareTask выдает пользователю доступ к задаче или меняет его уровень; делиться может только владелец
+func (s *taskService) ShareTask(ctx context.Context, userId, taskId, granteeId, permission string) (*entity.TaskShare, error) {
+	permission = strings.ToUpper(strings.TrimSpace(permission))
+	if permission == "" {
+		permission = entity.SharePermissionRead
+	}
+	if permission != entity.SharePermissionRead && permission != entity.SharePermissionEdit {
+		return nil, fmt.Errorf("%w: permission must be %s or %s", ErrInvalidShare, entity.SharePermissionRead, entity.SharePermissionEdit)
+	}
+	if _, err := uuid.FromString(granteeId); err != nil {
+		return nil, fmt.Errorf("%w: user_id must be a UUID", ErrInvalidShare)
+	}
+	if granteeId == userId {
+		return nil, fmt.Errorf("%w: task owner already has full access", ErrInvalidShare)
+	}
+
+	task, err := s.ownedTask(ctx, userId, taskId)
+	if err != nil {
+		return nil, err
+	}
+	share := &entity.TaskShare{
+		TaskID:     task.ID,
+		GranteeID:  granteeId,
+		Permission: permission,
+		GrantedBy:  userId,
+	}
+	event := &entity.TaskSharedEvent{
+		TaskID:     task.ID,
+		UserID:     userId,
+		GranteeID:  granteeId,
+		Title:      task.Title,
+		Permission: permission,
+	}
+	if err := s.shareRepo.UpsertShare(ctx, share, event); err != nil {
+		s.Log.Error("Error caused, after calling repo's UpsertShare, in task service", zap.Error(err))
+		return nil, err
+	}
+	return share, nil
+}
+
+// UnshareTask отзывает доступ. Владелец может отозвать любой доступ, получатель - отказаться от своего
+func (s *taskService) UnshareTask(ctx context.Context, userId, taskId, granteeId string) error {
+	task, err := s.sharedTask(ctx, userId, taskId, entity.SharePermissionRead)
+	if err != nil {
+		return err
+	}
+	if task.User_id != userId && granteeId != userId {
+		return ErrForbidden
+	}
+	if err := s.shareRepo.DeleteShare(ctx, task.ID, granteeId); err != nil {
+		s.Log.Error("Error caused, after calling repo's DeleteShare, in task service", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// ListShares возвращает выданные доступы к задаче владельца
+func (s *taskService) ListShares(ctx context.Context, userId, taskId string) ([]entity.TaskShare, error) {
+	task, err := s.ownedTask(ctx, userId, taskId)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := s.shareRepo.ListShares(ctx, task.ID)
+	if err != nil {
+		s.Log.Error("Error caused, after calling repo's ListShares, in task service", zap.Error(err))
+		return nil, err
+	}
+	return shares, nil
+}
+
+// taskPermission возвращает уровень доступа пользователя к задаче; без доступа - ErrForbidden
+func (s *taskService) taskPermission(ctx context.Context, task *entity.Task, userId string) (string, error) {
+	if userId == "" {
+		return "", ErrForbidden
+	}
+	if task.User_id == userId {
+		return permissionOwner, nil
+	}
+	permission, err := s.shareRepo.GetPermission(ctx, task.ID, userId)
+	if errors.Is(err, repository.ErrShareNotFound) {
+		return "", ErrForbidden
+	}
+	if err != nil {
+		s.Log.Error("Error caused, after calling repo's GetPermission, in task service", zap.Error(err))
+		return "", err
+	}
+	return permission, nil
+}
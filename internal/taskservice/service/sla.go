@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/taskservice/repository"
+	"github.com/oogway93/taskmanager/internal/taskservice/sla"
+	"go.uber.org/zap"
+)
+
+func (s *taskService) ListSLAPolicies(ctx context.Context) ([]entity.SLAPolicy, error) {
+	return s.slaRepo.ListSLAPolicies(ctx)
+}
+
+// UpdateSLAPolicy задает цели для приоритета; новые сроки применяются к задачам, созданным после изменения
+func (s *taskService) UpdateSLAPolicy(ctx context.Context, policy *entity.SLAPolicy) (*entity.SLAPolicy, error) {
+	policy.Priority = strings.ToUpper(policy.Priority)
+	if !isValidPriority(policy.Priority) {
+		return nil, ErrInvalidPriority
+	}
+	if policy.AtRiskThreshold == 0 {
+		policy.AtRiskThreshold = sla.DefaultAtRiskThreshold
+	}
+	if err := s.slaRepo.UpsertSLAPolicy(ctx, policy); err != nil {
+		s.Log.Error("Error caused, after calling repo's UpsertSLAPolicy, in task service", zap.Error(err))
+		return nil, err
+	}
+	return policy, nil
+}
+
+// applySLA проставляет сроки SLA новой задаче; без политики для приоритета SLA не отслеживается
+func (s *taskService) applySLA(ctx context.Context, task *entity.Task) error {
+	if task.Status == entity.StatusCompleted || task.Status == entity.StatusCancelled {
+		return nil
+	}
+	policy, err := s.slaRepo.GetSLAPolicy(ctx, task.Priority)
+	if errors.Is(err, repository.ErrSLAPolicyNotFound) {
+		return nil
+	}
+	if err != nil {
+		s.Log.Error("Error caused, after calling repo's GetSLAPolicy, in task service", zap.Error(err))
+		return err
+	}
+	sla.Apply(task, *policy, time.Now())
+	return nil
+}
+
+// atRiskThresholds возвращает пороги AT_RISK по приоритетам, как их берет sla.Checker; для приоритета
+// без политики sla.Evaluate использует порог по умолчанию
+func (s *taskService) atRiskThresholds(ctx context.Context) (map[string]float64, error) {
+	policies, err := s.slaRepo.ListSLAPolicies(ctx)
+	if err != nil {
+		s.Log.Error("Error caused, after calling repo's ListSLAPolicies, in task service", zap.Error(err))
+		return nil, err
+	}
+	thresholds := make(map[string]float64, len(policies))
+	for _, policy := range policies {
+		thresholds[policy.Priority] = policy.AtRiskThreshold
+	}
+	return thresholds, nil
+}
+
+// trackSLA фиксирует реакцию: задача ушла из PENDING. Статус SLA меняет только репозиторий
+// под блокировкой строки, иначе устаревшая копия задачи затерла бы результат фоновой проверки
+func trackSLA(task *entity.Task, now time.Time) {
+	if task.SLAStatus == "" {
+		return
+	}
+	if task.RespondedAt.IsZero() && task.Status == entity.StatusPending {
+		task.RespondedAt = now
+	}
+}
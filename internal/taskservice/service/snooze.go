@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/taskservice/timeexpr"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidSnooze = errors.New("task can be snoozed only until a future time and only while it is open")
+)
+
+// SnoozeTask скрывает открытую задачу владельца из списка до момента until. until - RFC3339
+// или относительное выражение timeexpr в часовом поясе timezone; пустой until снимает откладывание
+func (s *taskService) SnoozeTask(ctx context.Context, userId, taskId, until, timezone string) (*entity.Task, error) {
+	task, err := s.GetTask(ctx, taskId)
+	if err != nil {
+		return nil, err
+	}
+	if task.User_id != userId {
+		return nil, ErrForbidden
+	}
+
+	now := time.Now()
+	var snoozedUntil time.Time
+	if strings.TrimSpace(until) != "" {
+		loc, err := time.LoadLocation(timezone)
+		if err != nil {
+			return nil, ErrInvalidTimezone
+		}
+		if snoozedUntil, err = timeexpr.Eval(until, now, loc); err != nil {
+			return nil, err
+		}
+		if !snoozedUntil.After(now) || !isOpenStatus(task.Status) {
+			return nil, ErrInvalidSnooze
+		}
+	}
+
+	task.SnoozedUntil = snoozedUntil
+	if err := s.taskRepo.UpdateTask(ctx, task); err != nil {
+		s.Log.Error("Error caused, after calling repo's UpdateTask, in SnoozeTask", zap.Error(err))
+		return nil, err
+	}
+	return task, nil
+}
+
+// availableAt - момент, на который список скрывает еще не начатые и отложенные задачи. Округление
+// до минуты позволяет кэшу списков переиспользовать результат; задача возвращается в список
+// не позже чем через минуту после start_at или snoozed_until
+func availableAt(now time.Time) time.Time {
+	return now.Truncate(time.Minute)
+}
+
+// isDeferred сообщает, что задачу еще нельзя начать или она отложена
+func isDeferred(task *entity.Task, now time.Time) bool {
+	return task.StartAt.After(now) || task.SnoozedUntil.After(now)
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+)
+
+var (
+	ErrInvalidRange = errors.New("invalid date range")
+)
+
+const (
+	// defaultStatsPeriod - период статистики, если границы не переданы
+	defaultStatsPeriod = 30 * 24 * time.Hour
+	// maxStatsPeriod - самый длинный период: ряды строятся по дням, поэтому длина ограничена годом
+	maxStatsPeriod = 366 * 24 * time.Hour
+)
+
+// GetTaskStats считает пропускную способность, время цикла и узкие места за период
+func (s *taskService) GetTaskStats(ctx context.Context, filter entity.StatsFilter) (*entity.TaskStats, error) {
+	now := time.Now()
+	tasks, history, filter, err := s.loadSeriesData(ctx, filter, now)
+	if err != nil {
+		return nil, err
+	}
+	return computeStats(tasks, history, filter, now), nil
+}
+
+func computeStats(tasks []entity.Task, history []entity.StatusChange, filter entity.StatsFilter, now time.Time) *entity.TaskStats {
+	stats := &entity.TaskStats{From: filter.From, To: filter.To}
+	inRange := func(t time.Time) bool {
+		return !t.IsZero() && !t.Before(filter.From) && !t.After(filter.To)
+	}
+
+	daily := newBuckets(filter, dayKey)
+	weekly := newBuckets(filter, weekKey)
+
+	var (
+		cycleTimes []float64
+		withDue    int
+		overdue    int
+	)
+	for _, task := range tasks {
+		if inRange(task.CreatedAt) {
+			stats.Created++
+			daily.add(task.CreatedAt, true)
+			weekly.add(task.CreatedAt, true)
+		}
+		if task.Status == entity.StatusCompleted && inRange(task.CompletedAt) {
+			stats.Completed++
+			daily.add(task.CompletedAt, false)
+			weekly.add(task.CompletedAt, false)
+			cycleTimes = append(cycleTimes, task.CompletedAt.Sub(task.CreatedAt).Hours())
+		}
+
+		// просрочка считается только для задач, срок которых уже наступил в периоде
+		if inRange(task.DueDate) && task.DueDate.Before(now) && task.Status != entity.StatusCancelled {
+			withDue++
+			completedLate := task.Status == entity.StatusCompleted && task.CompletedAt.After(task.DueDate)
+			stillOpen := task.Status != entity.StatusCompleted
+			if completedLate || stillOpen {
+				overdue++
+			}
+		}
+	}
+
+	stats.Daily = daily.list()
+	stats.Weekly = weekly.list()
+	stats.AvgCycleTimeHours = round2(mean(cycleTimes))
+	stats.P90CycleTimeHours = round2(percentile(cycleTimes, 0.9))
+	if withDue > 0 {
+		stats.OverdueRate = round2(float64(overdue) / float64(withDue))
+	}
+	stats.TimeInStatus = timeInStatus(history, filter.From, filter.To)
+
+	return stats
+}
+
+// timeInStatus суммирует, сколько задачи провели в каждом статусе внутри периода
+func timeInStatus(history []entity.StatusChange, from, to time.Time) []entity.StatusDuration {
+	totals := make(map[string]float64)
+	tasksPerStatus := make(map[string]map[string]bool)
+
+	for i, change := range history {
+		// в финальных статусах задача уже не "ждет", узким местом они быть не могут
+		if change.Status == entity.StatusCompleted || change.Status == entity.StatusCancelled {
+			continue
+		}
+		end := to
+		if i+1 < len(history) && history[i+1].TaskID == change.TaskID {
+			end = history[i+1].ChangedAt
+		}
+		start := change.ChangedAt
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		if !end.After(start) {
+			continue
+		}
+
+		totals[change.Status] += end.Sub(start).Hours()
+		if tasksPerStatus[change.Status] == nil {
+			tasksPerStatus[change.Status] = make(map[string]bool)
+		}
+		tasksPerStatus[change.Status][change.TaskID] = true
+	}
+
+	result := make([]entity.StatusDuration, 0, len(totals))
+	for status, total := range totals {
+		count := len(tasksPerStatus[status])
+		result = append(result, entity.StatusDuration{
+			Status:     status,
+			TotalHours: round2(total),
+			AvgHours:   round2(total / float64(count)),
+			Tasks:      int32(count),
+		})
+	}
+	// самый "долгий" статус - первый кандидат в узкие места
+	sort.Slice(result, func(i, j int) bool { return result[i].AvgHours > result[j].AvgHours })
+	return result
+}
+
+type buckets struct {
+	keyFn func(time.Time, *time.Location) string
+	loc   *time.Location
+	order []string
+	data  map[string]*entity.StatsBucket
+}
+
+func newBuckets(filter entity.StatsFilter, keyFn func(time.Time, *time.Location) string) *buckets {
+	b := &buckets{keyFn: keyFn, loc: filter.Location, data: make(map[string]*entity.StatsBucket)}
+	// заранее создаем все периоды, чтобы на графике не было "дыр"
+	for day := filter.From; !day.After(filter.To); day = day.AddDate(0, 0, 1) {
+		b.bucket(day)
+	}
+	b.bucket(filter.To)
+	return b
+}
+
+func (b *buckets) bucket(t time.Time) *entity.StatsBucket {
+	key := b.keyFn(t, b.loc)
+	if bucket, ok := b.data[key]; ok {
+		return bucket
+	}
+	bucket := &entity.StatsBucket{Period: key}
+	b.data[key] = bucket
+	b.order = append(b.order, key)
+	return bucket
+}
+
+func (b *buckets) add(t time.Time, created bool) {
+	bucket := b.bucket(t)
+	if created {
+		bucket.Created++
+	} else {
+		bucket.Completed++
+	}
+}
+
+func (b *buckets) list() []entity.StatsBucket {
+	sort.Strings(b.order)
+	result := make([]entity.StatsBucket, 0, len(b.order))
+	for _, key := range b.order {
+		result = append(result, *b.data[key])
+	}
+	return result
+}
+
+func dayKey(t time.Time, loc *time.Location) string {
+	return t.In(loc).Format("2006-01-02")
+}
+
+// weekKey - дата понедельника недели, в которую попадает t
+func weekKey(t time.Time, loc *time.Location) string {
+	local := t.In(loc)
+	offset := (int(local.Weekday()) + 6) % 7
+	return local.AddDate(0, 0, -offset).Format("2006-01-02")
+}
+
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// percentile по методу nearest-rank
+func percentile(values []float64, p float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+)
+
+// Период тестов - со вторника 1 января 2030 до полудня 3 января, UTC
+
+func at(day, hour int) time.Time {
+	return time.Date(2030, time.January, day, hour, 0, 0, 0, time.UTC)
+}
+
+var statsPeriod = entity.StatsFilter{From: at(1, 0), To: at(3, 12), Location: time.UTC}
+
+// statsTasks: a проходит все статусы и закрыта после срока, b открыта и просрочена,
+// d закрыта в срок в день создания
+func statsTasks() ([]entity.Task, []entity.StatusChange) {
+	tasks := []entity.Task{
+		{ID: "a", Status: entity.StatusCompleted, CreatedAt: at(1, 10), CompletedAt: at(3, 10), DueDate: at(2, 12), Estimate: 4},
+		{ID: "b", Status: entity.StatusPending, CreatedAt: at(2, 8), DueDate: at(3, 11), Estimate: 2},
+		{ID: "d", Status: entity.StatusCompleted, CreatedAt: at(1, 12), CompletedAt: at(1, 18), DueDate: at(2, 0), Estimate: 1},
+	}
+	history := []entity.StatusChange{
+		{TaskID: "a", Status: entity.StatusPending, ChangedAt: at(1, 10)},
+		{TaskID: "a", Status: entity.StatusInProgress, ChangedAt: at(2, 10)},
+		{TaskID: "a", Status: entity.StatusCompleted, ChangedAt: at(3, 10)},
+		{TaskID: "b", Status: entity.StatusPending, ChangedAt: at(2, 8)},
+		{TaskID: "d", Status: entity.StatusPending, ChangedAt: at(1, 12)},
+		{TaskID: "d", Status: entity.StatusCompleted, ChangedAt: at(1, 18)},
+	}
+	return tasks, history
+}
+
+func TestComputeStats(t *testing.T) {
+	tasks, history := statsTasks()
+	days := func(buckets ...entity.StatsBucket) []entity.StatsBucket {
+		for i, period := range []string{"2030-01-01", "2030-01-02", "2030-01-03"} {
+			buckets[i].Period = period
+		}
+		return buckets
+	}
+
+	tests := []struct {
+		name    string
+		tasks   []entity.Task
+		history []entity.StatusChange
+		want    entity.TaskStats
+	}{
+		{
+			name: "no tasks",
+			want: entity.TaskStats{
+				Daily:        days(entity.StatsBucket{}, entity.StatsBucket{}, entity.StatsBucket{}),
+				Weekly:       []entity.StatsBucket{{Period: "2029-12-31"}},
+				TimeInStatus: []entity.StatusDuration{},
+			},
+		},
+		{
+			name:    "created, completed and overdue",
+			tasks:   tasks,
+			history: history,
+			want: entity.TaskStats{
+				Created:   3,
+				Completed: 2,
+				Daily: days(
+					entity.StatsBucket{Created: 2, Completed: 1},
+					entity.StatsBucket{Created: 1},
+					entity.StatsBucket{Completed: 1},
+				),
+				Weekly:            []entity.StatsBucket{{Period: "2029-12-31", Created: 3, Completed: 2}},
+				AvgCycleTimeHours: 27,
+				P90CycleTimeHours: 48,
+				// в ожидании: a 24ч, b 28ч до конца периода, d 6ч; в работе: a 24ч
+				TimeInStatus: []entity.StatusDuration{
+					{Status: entity.StatusInProgress, TotalHours: 24, AvgHours: 24, Tasks: 1},
+					{Status: entity.StatusPending, TotalHours: 58, AvgHours: 19.33, Tasks: 3},
+				},
+				OverdueRate: 0.67,
+			},
+		},
+		{
+			name: "created before the period",
+			tasks: []entity.Task{
+				{ID: "e", Status: entity.StatusCancelled, CreatedAt: at(1, 0).AddDate(0, 0, -2), DueDate: at(2, 0)},
+				{ID: "f", Status: entity.StatusCompleted, CreatedAt: at(1, 0).AddDate(0, 0, -2), CompletedAt: at(1, 6)},
+			},
+			want: entity.TaskStats{
+				Completed:         1,
+				Daily:             days(entity.StatsBucket{Completed: 1}, entity.StatsBucket{}, entity.StatsBucket{}),
+				Weekly:            []entity.StatsBucket{{Period: "2029-12-31", Completed: 1}},
+				AvgCycleTimeHours: 54,
+				P90CycleTimeHours: 54,
+				TimeInStatus:      []entity.StatusDuration{},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.want.From, tc.want.To = statsPeriod.From, statsPeriod.To
+			got := computeStats(tc.tasks, tc.history, statsPeriod, statsPeriod.To)
+			if !reflect.DeepEqual(*got, tc.want) {
+				t.Errorf("computeStats =\n%+v\nwant\n%+v", *got, tc.want)
+			}
+		})
+	}
+}
+
+func TestComputeBurndown(t *testing.T) {
+	tasks, history := statsTasks()
+
+	tests := []struct {
+		name    string
+		tasks   []entity.Task
+		history []entity.StatusChange
+		filter  entity.StatsFilter
+		want    []entity.BurndownPoint
+	}{
+		{
+			name:    "three days",
+			tasks:   tasks,
+			history: history,
+			filter:  statsPeriod,
+			want: []entity.BurndownPoint{
+				{Date: "2030-01-01", OpenTasks: 1, RemainingEstimate: 4, IdealEstimate: 4},
+				{Date: "2030-01-02", OpenTasks: 2, RemainingEstimate: 6, IdealEstimate: 2},
+				{Date: "2030-01-03", OpenTasks: 1, RemainingEstimate: 2, IdealEstimate: 0},
+			},
+		},
+		{
+			// d создана ровно в конце периода и уже считается открытой
+			name:    "single day",
+			tasks:   tasks,
+			history: history,
+			filter:  entity.StatsFilter{From: at(1, 0), To: at(1, 12), Location: time.UTC},
+			want:    []entity.BurndownPoint{{Date: "2030-01-01", OpenTasks: 2, RemainingEstimate: 5, IdealEstimate: 5}},
+		},
+		{
+			name:   "task without history",
+			tasks:  []entity.Task{{ID: "g", Status: entity.StatusInProgress, CreatedAt: at(2, 0), Estimate: 1.5}},
+			filter: statsPeriod,
+			want: []entity.BurndownPoint{
+				{Date: "2030-01-01"},
+				{Date: "2030-01-02", OpenTasks: 1, RemainingEstimate: 1.5},
+				{Date: "2030-01-03", OpenTasks: 1, RemainingEstimate: 1.5},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := computeBurndown(tc.tasks, tc.history, tc.filter)
+			if !reflect.DeepEqual(got.Points, tc.want) {
+				t.Errorf("computeBurndown = %+v, want %+v", got.Points, tc.want)
+			}
+		})
+	}
+}
+
+func TestComputeCumulativeFlow(t *testing.T) {
+	tasks, history := statsTasks()
+	counts := func(pending, inProgress, completed int32) map[string]int32 {
+		return map[string]int32{
+			entity.StatusPending:    pending,
+			entity.StatusInProgress: inProgress,
+			entity.StatusCompleted:  completed,
+			entity.StatusCancelled:  0,
+		}
+	}
+	withOnHold := func(count int32) map[string]int32 {
+		result := counts(0, 0, 0)
+		result["ON_HOLD"] = count
+		return result
+	}
+
+	tests := []struct {
+		name         string
+		tasks        []entity.Task
+		history      []entity.StatusChange
+		wantStatuses []string
+		want         []entity.CumulativeFlowPoint
+	}{
+		{
+			name:         "known statuses",
+			tasks:        tasks,
+			history:      history,
+			wantStatuses: statusOrder,
+			want: []entity.CumulativeFlowPoint{
+				{Date: "2030-01-01", Counts: counts(1, 0, 1)},
+				{Date: "2030-01-02", Counts: counts(1, 1, 1)},
+				{Date: "2030-01-03", Counts: counts(1, 0, 2)},
+			},
+		},
+		{
+			name:         "unknown status goes after known ones",
+			tasks:        []entity.Task{{ID: "x", Status: "ON_HOLD", CreatedAt: at(2, 0)}},
+			wantStatuses: append(append([]string{}, statusOrder...), "ON_HOLD"),
+			want: []entity.CumulativeFlowPoint{
+				{Date: "2030-01-01", Counts: counts(0, 0, 0)},
+				{Date: "2030-01-02", Counts: withOnHold(1)},
+				{Date: "2030-01-03", Counts: withOnHold(1)},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := computeCumulativeFlow(tc.tasks, tc.history, statsPeriod)
+			if !reflect.DeepEqual(got.Statuses, tc.wantStatuses) {
+				t.Errorf("statuses = %v, want %v", got.Statuses, tc.wantStatuses)
+			}
+			if !reflect.DeepEqual(got.Points, tc.want) {
+				t.Errorf("points = %+v, want %+v", got.Points, tc.want)
+			}
+		})
+	}
+}
@@ -1,12 +1,19 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/taskservice/ical"
+	"github.com/oogway93/taskmanager/internal/taskservice/quickadd"
 	"github.com/oogway93/taskmanager/internal/taskservice/repository"
+	"github.com/oogway93/taskmanager/internal/taskservice/webhook"
 	"go.uber.org/zap"
 )
 
@@ -15,37 +22,225 @@ var (
 	ErrInvalidCredentials = errors.New("invalid credentials")
 	ErrUserAlreadyExists  = errors.New("user already exists")
 	ErrUserInactive       = errors.New("user is already inactive ")
+	ErrInvalidTimezone    = errors.New("invalid timezone")
+	ErrInvalidStatus      = errors.New("invalid task status")
+	ErrInvalidPriority    = errors.New("invalid task priority")
+	ErrForbidden          = errors.New("access to task is forbidden")
 )
 
 type TaskService interface {
 	CreateTask(ctx context.Context, task *entity.Task) (*entity.Task, error)
-	ListTasks(ctx context.Context, userId string) ([]entity.Task, error)
+	ListTasks(ctx context.Context, query entity.TaskListQuery) ([]entity.Task, error)
 	GetTask(ctx context.Context, taskId string) (*entity.Task, error)
+	ImportICS(ctx context.Context, userId string, data []byte) (*entity.ImportResult, error)
+	QuickAddTask(ctx context.Context, userId, text, timezone string, preview bool) (*entity.Task, bool, error)
+	UpdateTask(ctx context.Context, userId, taskId string, update entity.UpdateTaskRequest) (*entity.Task, error)
+	GetTaskStats(ctx context.Context, filter entity.StatsFilter) (*entity.TaskStats, error)
+	GetBurndown(ctx context.Context, filter entity.StatsFilter) (*entity.BurndownResponse, error)
+	GetCumulativeFlow(ctx context.Context, filter entity.StatsFilter) (*entity.CumulativeFlowResponse, error)
+
+	CreateSavedView(ctx context.Context, view *entity.SavedView) (*entity.SavedView, error)
+	GetSavedView(ctx context.Context, viewId, userId string) (*entity.SavedView, error)
+	ListSavedViews(ctx context.Context, userId string) ([]entity.SavedView, error)
+	UpdateSavedView(ctx context.Context, view *entity.SavedView) (*entity.SavedView, error)
+	DeleteSavedView(ctx context.Context, viewId, userId string) error
+
+	CreateTaskTemplate(ctx context.Context, template *entity.TaskTemplate) (*entity.TaskTemplate, error)
+	GetTaskTemplate(ctx context.Context, templateId, userId string) (*entity.TaskTemplate, error)
+	ListTaskTemplates(ctx context.Context, userId string) ([]entity.TaskTemplate, error)
+	UpdateTaskTemplate(ctx context.Context, template *entity.TaskTemplate) (*entity.TaskTemplate, error)
+	DeleteTaskTemplate(ctx context.Context, templateId, userId string) error
+	InstantiateTemplate(ctx context.Context, userId, templateId string, variables map[string]string, timezone string) (*entity.Task, []*entity.Task, error)
+
+	CreateCustomField(ctx context.Context, field *entity.CustomFieldDefinition) (*entity.CustomFieldDefinition, error)
+	ListCustomFields(ctx context.Context, userId, project string) ([]entity.CustomFieldDefinition, error)
+	UpdateCustomField(ctx context.Context, field *entity.CustomFieldDefinition) (*entity.CustomFieldDefinition, error)
+	DeleteCustomField(ctx context.Context, fieldId, userId string) error
+
+	ListSLAPolicies(ctx context.Context) ([]entity.SLAPolicy, error)
+	UpdateSLAPolicy(ctx context.Context, policy *entity.SLAPolicy) (*entity.SLAPolicy, error)
+
+	CreateWebhook(ctx context.Context, hook *entity.WebhookSubscription) (*entity.WebhookSubscription, error)
+	ListWebhooks(ctx context.Context, userId string) ([]entity.WebhookSubscription, error)
+	UpdateWebhook(ctx context.Context, hook *entity.WebhookSubscription) (*entity.WebhookSubscription, error)
+	DeleteWebhook(ctx context.Context, webhookId, userId string) error
+	ListWebhookDeliveries(ctx context.Context, webhookId, userId string, limit int) ([]entity.WebhookDelivery, error)
+	RedeliverWebhook(ctx context.Context, userId, webhookId, deliveryId string) (*entity.WebhookDelivery, error)
+
+	ListNotifications(ctx context.Context, userId string, unreadOnly bool, limit, offset int) ([]entity.Notification, int, error)
+	MarkRead(ctx context.Context, userId, notificationId string) (int, error)
+	MarkAllRead(ctx context.Context, userId string) (int, int, error)
+
+	GetNextTasks(ctx context.Context, query entity.NextTasksQuery) (*entity.NextTasksResponse, error)
+	GetEisenhowerMatrix(ctx context.Context, query entity.EisenhowerQuery) (*entity.EisenhowerMatrix, error)
+	SetQuadrantOverride(ctx context.Context, userId, taskId, quadrant string) (string, error)
+
+	SnoozeTask(ctx context.Context, userId, taskId, until, timezone string) (*entity.Task, error)
+
+	GetCalendar(ctx context.Context, query entity.CalendarQuery) (*entity.Calendar, error)
+
+	GetChecklist(ctx context.Context, userId, taskId string) (*entity.Checklist, error)
+	AddChecklistItem(ctx context.Context, userId, taskId, text string) (*entity.Checklist, error)
+	ToggleChecklistItem(ctx context.Context, userId, taskId, itemId string) (*entity.Checklist, error)
+	ReorderChecklist(ctx context.Context, userId, taskId string, itemIds []string) (*entity.Checklist, error)
+	DeleteChecklistItem(ctx context.Context, userId, taskId, itemId string) (*entity.Checklist, error)
+	ConvertChecklistItem(ctx context.Context, userId, taskId, itemId string) (*entity.Task, error)
+
+	CloneTask(ctx context.Context, userId, taskId string, options entity.CloneTaskOptions) (*entity.Task, error)
+
+	GetTaskForUser(ctx context.Context, userId, taskId string) (*entity.Task, error)
+	ShareTask(ctx context.Context, userId, taskId, granteeId, permission string) (*entity.TaskShare, error)
+	UnshareTask(ctx context.Context, userId, taskId, granteeId string) error
+	ListShares(ctx context.Context, userId, taskId string) ([]entity.TaskShare, error)
+
+	CreatePublicLink(ctx context.Context, userId, taskId, expiresAt, timezone string) (*entity.PublicLink, error)
+	ListPublicLinks(ctx context.Context, userId, taskId string) ([]entity.PublicLink, error)
+	RevokePublicLink(ctx context.Context, userId, taskId, linkId string) (*entity.PublicLink, error)
+	GetPublicTask(ctx context.Context, token string) (*entity.PublicTaskView, error)
 }
 
 type taskService struct {
-	taskRepo repository.TaskRepository
-	Log      *zap.Logger
+	taskRepo         repository.TaskRepository
+	viewRepo         repository.SavedViewRepository
+	templateRepo     repository.TemplateRepository
+	fieldRepo        repository.CustomFieldRepository
+	slaRepo          repository.SLARepository
+	webhookRepo      repository.WebhookRepository
+	notificationRepo repository.NotificationRepository
+	quadrantRepo     repository.QuadrantRepository
+	checklistRepo    repository.ChecklistRepository
+	shareRepo        repository.ShareRepository
+	linkRepo         repository.PublicLinkRepository
+	webhookPolicy    webhook.AddressPolicy
+	Log              *zap.Logger
 }
 
-func NewTaskService(taskRepo repository.TaskRepository, Log *zap.Logger) TaskService {
+func NewTaskService(
+	taskRepo repository.TaskRepository,
+	viewRepo repository.SavedViewRepository,
+	templateRepo repository.TemplateRepository,
+	fieldRepo repository.CustomFieldRepository,
+	slaRepo repository.SLARepository,
+	webhookRepo repository.WebhookRepository,
+	notificationRepo repository.NotificationRepository,
+	quadrantRepo repository.QuadrantRepository,
+	checklistRepo repository.ChecklistRepository,
+	shareRepo repository.ShareRepository,
+	linkRepo repository.PublicLinkRepository,
+	webhookPolicy webhook.AddressPolicy,
+	Log *zap.Logger,
+) TaskService {
 	return &taskService{
-		taskRepo: taskRepo,
-		Log:      Log,
+		taskRepo:         taskRepo,
+		viewRepo:         viewRepo,
+		templateRepo:     templateRepo,
+		fieldRepo:        fieldRepo,
+		slaRepo:          slaRepo,
+		webhookRepo:      webhookRepo,
+		notificationRepo: notificationRepo,
+		quadrantRepo:     quadrantRepo,
+		checklistRepo:    checklistRepo,
+		shareRepo:        shareRepo,
+		linkRepo:         linkRepo,
+		webhookPolicy:    webhookPolicy,
+		Log:              Log,
 	}
 }
 
 func (s *taskService) CreateTask(ctx context.Context, task *entity.Task) (*entity.Task, error) {
+	if err := s.prepareNewTask(ctx, task); err != nil {
+		return nil, err
+	}
+
 	if err := s.taskRepo.CreateTask(ctx, task); err != nil {
-		s.Log.Fatal("Error caused, after calling repo's CreateTask, in task service", zap.Error(err))
+		s.Log.Error("Error caused, after calling repo's CreateTask, in task service", zap.Error(err))
 		return nil, err
 	}
 	return task, nil
 }
 
-func (s *taskService) ListTasks(ctx context.Context, userId string) ([]entity.Task, error) {
-	tasks, err := s.taskRepo.ListTasks(ctx, userId)
-	return tasks, err
+// prepareNewTask проверяет пользовательские поля новой задачи и назначает ей сроки SLA.
+// Через него проходят все способы создания задач: API, быстрое добавление, импорт и шаблоны
+func (s *taskService) prepareNewTask(ctx context.Context, task *entity.Task) error {
+	if err := s.validateNewTask(ctx, task); err != nil {
+		return err
+	}
+	return s.applySLA(ctx, task)
+}
+
+// validateNewTask нормализует пользовательские поля новой задачи и проверяет, что заданы обязательные
+func (s *taskService) validateNewTask(ctx context.Context, task *entity.Task) error {
+	task.Project = strings.TrimSpace(task.Project)
+	defs, err := s.customFieldDefinitions(ctx, task.User_id, task.Project)
+	if err != nil {
+		return err
+	}
+	if task.CustomFields, err = validateCustomFields(defs, task.CustomFields); err != nil {
+		return err
+	}
+	return checkRequiredFields(defs, task.CustomFields)
+}
+
+// ListTasks возвращает задачи пользователя; с ViewID применяется фильтр сохраненного представления,
+// поверх которого накладываются проект, условия на пользовательские поля и сортировка из запроса
+func (s *taskService) ListTasks(ctx context.Context, query entity.TaskListQuery) ([]entity.Task, error) {
+	filter := entity.TaskFilter{UserID: query.UserID}
+	if query.ViewID != "" {
+		view, err := s.viewRepo.GetByID(ctx, query.ViewID, query.UserID)
+		if err != nil {
+			s.Log.Error("Error caused, after calling repo's GetByID saved view, in ListTasks", zap.Error(err))
+			return nil, err
+		}
+		loc, err := time.LoadLocation(query.Timezone)
+		if err != nil {
+			return nil, ErrInvalidTimezone
+		}
+		if filter, err = viewToFilter(view, time.Now(), loc); err != nil {
+			return nil, err
+		}
+	}
+	if query.Project != "" {
+		filter.Project = query.Project
+	}
+	if query.SortBy != "" {
+		filter.SortBy, filter.SortDesc = query.SortBy, query.SortDesc
+	}
+	filter.CustomFields = append(filter.CustomFields, query.CustomFields...)
+	if !query.IncludeDeferred {
+		filter.AvailableAt = availableAt(time.Now())
+	}
+
+	sortKey, sortByCustom := strings.CutPrefix(filter.SortBy, entity.SortByCustomFieldPrefix)
+	if len(filter.CustomFields) > 0 || sortByCustom {
+		defs, err := s.customFieldDefinitions(ctx, query.UserID, filter.Project)
+		if err != nil {
+			return nil, err
+		}
+		if filter.CustomFields, err = customFieldConditions(defs, filter.CustomFields); err != nil {
+			return nil, err
+		}
+		if _, ok := defs[sortKey]; sortByCustom && !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownCustomField, sortKey)
+		}
+	}
+
+	var (
+		tasks []entity.Task
+		err   error
+	)
+	if query.SharedWithMe {
+		// чужие задачи читаются мимо кэша: он сбрасывается только по записям самого пользователя
+		tasks, err = s.shareRepo.ListSharedTasks(ctx, filter)
+	} else {
+		tasks, err = s.taskRepo.ListTasks(ctx, filter)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachChecklistProgress(ctx, tasks); err != nil {
+		return nil, err
+	}
+	return tasks, nil
 }
 
 func (s *taskService) GetTask(ctx context.Context, taskId string) (*entity.Task, error) {
@@ -55,5 +250,229 @@ func (s *taskService) GetTask(ctx context.Context, taskId string) (*entity.Task,
 		return &entity.Task{}, err
 	}
 	task, err := s.taskRepo.GetTaskByID(ctx, taskIdUUID)
-	return &task, err
+	if err != nil {
+		return &task, err
+	}
+	tasks := []entity.Task{task}
+	if err := s.attachChecklistProgress(ctx, tasks); err != nil {
+		return &task, err
+	}
+	return &tasks[0], nil
+}
+
+// UpdateTask применяет непустые поля update к задаче владельца
+func (s *taskService) UpdateTask(ctx context.Context, userId, taskId string, update entity.UpdateTaskRequest) (*entity.Task, error) {
+	task, err := s.GetTask(ctx, taskId)
+	if err != nil {
+		return nil, err
+	}
+	// менять задачу могут владелец и получатели доступа EDIT
+	permission, err := s.taskPermission(ctx, task, userId)
+	if err != nil {
+		return nil, err
+	}
+	if permission == entity.SharePermissionRead {
+		return nil, ErrForbidden
+	}
+
+	if update.Title != "" {
+		task.Title = update.Title
+	}
+	if update.Description != "" {
+		task.Description = update.Description
+	}
+	if update.Status != "" {
+		status := strings.ToUpper(update.Status)
+		if !isValidStatus(status) {
+			return nil, ErrInvalidStatus
+		}
+		if status != task.Status {
+			trackSLA(task, time.Now())
+		}
+		task.Status = status
+	}
+	if update.Tags != nil {
+		task.Tags = update.Tags
+	}
+	if update.DueDate != nil {
+		task.DueDate = *update.DueDate
+	}
+	if update.Estimate != nil {
+		task.Estimate = *update.Estimate
+	}
+	if update.StartAt != nil {
+		task.StartAt = *update.StartAt
+	}
+	if update.Project != nil || update.CustomFields != nil {
+		if err := s.applyCustomFields(ctx, task, update); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.taskRepo.UpdateTask(ctx, task); err != nil {
+		s.Log.Error("Error caused, after calling repo's UpdateTask, in task service", zap.Error(err))
+		return nil, err
+	}
+	return task, nil
+}
+
+// applyCustomFields сливает новые значения пользовательских полей с текущими.
+// При смене проекта все значения перепроверяются по определениям нового проекта
+func (s *taskService) applyCustomFields(ctx context.Context, task *entity.Task, update entity.UpdateTaskRequest) error {
+	if update.Project != nil {
+		task.Project = strings.TrimSpace(*update.Project)
+	}
+	defs, err := s.customFieldDefinitions(ctx, task.User_id, task.Project)
+	if err != nil {
+		return err
+	}
+
+	merged := make(map[string]any, len(task.CustomFields)+len(update.CustomFields))
+	for key, value := range task.CustomFields {
+		// значения удаленных определений молча отбрасываются
+		if _, ok := defs[key]; ok {
+			merged[key] = value
+		}
+	}
+	for key, value := range update.CustomFields {
+		if def, ok := defs[key]; ok && def.Required && value == nil {
+			return fmt.Errorf("%w: %s is required", ErrInvalidCustomField, key)
+		}
+		merged[key] = value
+	}
+
+	task.CustomFields, err = validateCustomFields(defs, merged)
+	return err
+}
+
+func isValidStatus(status string) bool {
+	switch status {
+	case entity.StatusPending, entity.StatusInProgress, entity.StatusCompleted, entity.StatusCancelled:
+		return true
+	}
+	return false
+}
+
+// ImportICS создает задачи из VTODO/VEVENT календаря. Повторный импорт того же UID обновляет задачу
+func (s *taskService) ImportICS(ctx context.Context, userId string, data []byte) (*entity.ImportResult, error) {
+	items, err := ical.Parse(bytes.NewReader(data))
+	if err != nil {
+		s.Log.Error("Failed to parse iCalendar data", zap.Error(err))
+		return nil, err
+	}
+
+	result := &entity.ImportResult{}
+	for _, item := range items {
+		if strings.TrimSpace(item.Summary) == "" {
+			result.Skipped++
+			continue
+		}
+
+		task := &entity.Task{
+			Title:       item.Summary,
+			Description: item.Description,
+			Priority:    icsPriority(item.Priority),
+			Status:      entity.StatusPending,
+			Tags:        item.Categories,
+			DueDate:     item.Deadline(),
+			User_id:     userId,
+			ExternalUID: item.UID,
+		}
+
+		if item.UID != "" {
+			existing, err := s.taskRepo.GetTaskByExternalUID(ctx, userId, item.UID)
+			if err == nil {
+				// обновляем только поля календаря: статус, оценка, пользовательские поля и SLA
+				// могли измениться после прошлого импорта. Сроки SLA не пересчитываются и при смене
+				// PRIORITY: как и при обычном изменении задачи, они фиксируются при создании
+				existing.Title = task.Title
+				existing.Description = task.Description
+				existing.Priority = task.Priority
+				existing.Tags = task.Tags
+				existing.DueDate = task.DueDate
+				task = &existing
+				if err := s.taskRepo.UpdateTask(ctx, task); err != nil {
+					s.Log.Error("Error caused, after calling repo's UpdateTask, in ImportICS", zap.Error(err))
+					return nil, err
+				}
+				result.Updated++
+				result.Tasks = append(result.Tasks, task)
+				continue
+			}
+			if !errors.Is(err, repository.ErrTaskNotFound) {
+				s.Log.Error("Error caused, after calling repo's GetTaskByExternalUID, in ImportICS", zap.Error(err))
+				return nil, err
+			}
+		}
+
+		if err := s.prepareNewTask(ctx, task); err != nil {
+			return nil, err
+		}
+		if err := s.taskRepo.CreateTask(ctx, task); err != nil {
+			s.Log.Error("Error caused, after calling repo's CreateTask, in ImportICS", zap.Error(err))
+			return nil, err
+		}
+		result.Created++
+		result.Tasks = append(result.Tasks, task)
+	}
+
+	s.Log.Info("iCalendar imported",
+		zap.String("user_id", userId),
+		zap.Int32("created", result.Created),
+		zap.Int32("updated", result.Updated),
+		zap.Int32("skipped", result.Skipped),
+	)
+	return result, nil
+}
+
+// icsPriority переводит PRIORITY из RFC 5545 (1 - наивысший, 9 - наинизший) в TaskPriorities
+func icsPriority(priority int) string {
+	switch {
+	case priority == 0:
+		return entity.PriorityNormal
+	case priority <= 2:
+		return entity.PriorityCritical
+	case priority <= 4:
+		return entity.PriorityHigh
+	case priority == 5:
+		return entity.PriorityNormal
+	default:
+		return entity.PriorityLow
+	}
+}
+
+// QuickAddTask распознает задачу из строки и, если это не предпросмотр, сохраняет ее
+func (s *taskService) QuickAddTask(ctx context.Context, userId, text, timezone string, preview bool) (*entity.Task, bool, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		s.Log.Error("Failed to load timezone in QuickAddTask", zap.String("timezone", timezone), zap.Error(err))
+		return nil, false, ErrInvalidTimezone
+	}
+
+	parsed, err := quickadd.Parse(text, time.Now(), loc)
+	if err != nil {
+		return nil, false, err
+	}
+
+	task := &entity.Task{
+		Title:    parsed.Title,
+		Priority: parsed.Priority,
+		Status:   entity.StatusPending,
+		Tags:     parsed.Tags,
+		DueDate:  parsed.DueDate,
+		User_id:  userId,
+	}
+	// предпросмотр проходит те же проверки, чтобы не обещать задачу, которую нельзя сохранить
+	if err := s.prepareNewTask(ctx, task); err != nil {
+		return nil, false, err
+	}
+	if preview {
+		return task, false, nil
+	}
+
+	if err := s.taskRepo.CreateTask(ctx, task); err != nil {
+		s.Log.Error("Error caused, after calling repo's CreateTask, in QuickAddTask", zap.Error(err))
+		return nil, false, err
+	}
+	return task, true, nil
 }
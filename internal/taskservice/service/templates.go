@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/taskservice/timeexpr"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidTemplate         = errors.New("invalid task template")
+	ErrMissingTemplateVariable = errors.New("missing template variable")
+)
+
+// maxTaskTitleLength совпадает с ограничением tasks_title_length на колонку title
+const maxTaskTitleLength = 255
+
+// placeholderRe - плейсхолдер вида {{client}} или {{ client }}
+var placeholderRe = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.-]+)\s*\}\}`)
+
+func (s *taskService) CreateTaskTemplate(ctx context.Context, template *entity.TaskTemplate) (*entity.TaskTemplate, error) {
+	if err := normalizeTemplate(template); err != nil {
+		return nil, err
+	}
+	if err := s.templateRepo.Create(ctx, template); err != nil {
+		s.Log.Error("Error caused, after calling repo's Create task template, in task service", zap.Error(err))
+		return nil, err
+	}
+	return template, nil
+}
+
+func (s *taskService) GetTaskTemplate(ctx context.Context, templateId, userId string) (*entity.TaskTemplate, error) {
+	return s.templateRepo.GetByID(ctx, templateId, userId)
+}
+
+func (s *taskService) ListTaskTemplates(ctx context.Context, userId string) ([]entity.TaskTemplate, error) {
+	return s.templateRepo.ListByUser(ctx, userId)
+}
+
+func (s *taskService) UpdateTaskTemplate(ctx context.Context, template *entity.TaskTemplate) (*entity.TaskTemplate, error) {
+	if err := normalizeTemplate(template); err != nil {
+		return nil, err
+	}
+	if err := s.templateRepo.Update(ctx, template); err != nil {
+		s.Log.Error("Error caused, after calling repo's Update task template, in task service", zap.Error(err))
+		return nil, err
+	}
+	return s.templateRepo.GetByID(ctx, template.ID, template.UserID)
+}
+
+func (s *taskService) DeleteTaskTemplate(ctx context.Context, templateId, userId string) error {
+	return s.templateRepo.Delete(ctx, templateId, userId)
+}
+
+// InstantiateTemplate создает задачу и подзадачи по шаблону, подставляя variables в плейсхолдеры.
+// Сроки считаются от текущего момента в часовом поясе timezone
+func (s *taskService) InstantiateTemplate(ctx context.Context, userId, templateId string, variables map[string]string, timezone string) (*entity.Task, []*entity.Task, error) {
+	template, err := s.templateRepo.GetByID(ctx, templateId, userId)
+	if err != nil {
+		return nil, nil, err
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, nil, ErrInvalidTimezone
+	}
+
+	parent, subtasks, checklist, err := instantiate(template, variables, time.Now(), loc)
+	if err != nil {
+		return nil, nil, err
+	}
+	// SLA отслеживается по задаче-обращению, подзадачи - внутренние шаги
+	if err := s.prepareNewTask(ctx, parent); err != nil {
+		return nil, nil, err
+	}
+	for _, subtask := range subtasks {
+		if err := s.validateNewTask(ctx, subtask); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := s.taskRepo.CreateTaskTree(ctx, parent, subtasks, checklist); err != nil {
+		s.Log.Error("Error caused, after calling repo's CreateTaskTree, in task service", zap.Error(err))
+		return nil, nil, err
+	}
+	parent.Checklist = checklistProgress(checklist)
+	return parent, subtasks, nil
+}
+
+// instantiate возвращает задачу, подзадачи и пункты чек-листа задачи
+func instantiate(template *entity.TaskTemplate, variables map[string]string, now time.Time, loc *time.Location) (*entity.Task, []*entity.Task, []entity.ChecklistItem, error) {
+	if missing := missingVariables(template, variables); len(missing) > 0 {
+		return nil, nil, nil, fmt.Errorf("%w: %s", ErrMissingTemplateVariable, strings.Join(missing, ", "))
+	}
+
+	checklist := make([]entity.ChecklistItem, 0, len(template.Checklist))
+	for i, item := range template.Checklist {
+		text := strings.TrimSpace(render(item, variables))
+		if text == "" || utf8.RuneCountInString(text) > maxChecklistItemLength {
+			return nil, nil, nil, fmt.Errorf("%w: rendered text must be 1-%d characters", ErrInvalidChecklistItem, maxChecklistItemLength)
+		}
+		checklist = append(checklist, entity.ChecklistItem{Text: text, Position: i})
+	}
+
+	title, err := renderTitle(template.Title, variables)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	dueDate, err := evalOffset(template.DueOffset, now, loc)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	parent := &entity.Task{
+		Title:       title,
+		Description: render(template.Description, variables),
+		Priority:    template.Priority,
+		Status:      entity.StatusPending,
+		Tags:        template.Tags,
+		User_id:     template.UserID,
+		DueDate:     dueDate,
+	}
+
+	subtasks := make([]*entity.Task, 0, len(template.Subtasks))
+	for _, blueprint := range template.Subtasks {
+		title, err := renderTitle(blueprint.Title, variables)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		dueDate, err := evalOffset(blueprint.DueOffset, now, loc)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		priority := blueprint.Priority
+		if priority == "" {
+			priority = template.Priority
+		}
+		subtasks = append(subtasks, &entity.Task{
+			Title:       title,
+			Description: render(blueprint.Description, variables),
+			Priority:    priority,
+			Status:      entity.StatusPending,
+			Tags:        template.Tags,
+			User_id:     template.UserID,
+			DueDate:     dueDate,
+		})
+	}
+	return parent, subtasks, checklist, nil
+}
+
+// renderTitle подставляет переменные в заголовок задачи и проверяет, что он поместится в колонку title
+func renderTitle(title string, variables map[string]string) (string, error) {
+	title = strings.TrimSpace(render(title, variables))
+	if title == "" || utf8.RuneCountInString(title) > maxTaskTitleLength {
+		return "", fmt.Errorf("%w: rendered title must be 1-%d characters", ErrInvalidTemplate, maxTaskTitleLength)
+	}
+	return title, nil
+}
+
+// missingVariables возвращает отсортированные имена плейсхолдеров, для которых не передано значение
+func missingVariables(template *entity.TaskTemplate, variables map[string]string) []string {
+	texts := []string{template.Title, template.Description}
+	texts = append(texts, template.Checklist...)
+	for _, blueprint := range template.Subtasks {
+		texts = append(texts, blueprint.Title, blueprint.Description)
+	}
+
+	seen := make(map[string]bool)
+	var missing []string
+	for _, text := range texts {
+		for _, m := range placeholderRe.FindAllStringSubmatch(text, -1) {
+			name := m[1]
+			if _, ok := variables[name]; ok || seen[name] {
+				continue
+			}
+			seen[name] = true
+			missing = append(missing, name)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+func render(text string, variables map[string]string) string {
+	return placeholderRe.ReplaceAllStringFunc(text, func(placeholder string) string {
+		return variables[placeholderRe.FindStringSubmatch(placeholder)[1]]
+	})
+}
+
+func evalOffset(expr string, now time.Time, loc *time.Location) (time.Time, error) {
+	if expr == "" {
+		return time.Time{}, nil
+	}
+	return timeexpr.Eval(expr, now, loc)
+}
+
+// normalizeTemplate проверяет шаблон и проставляет приоритет по умолчанию
+func normalizeTemplate(template *entity.TaskTemplate) error {
+	template.Name = strings.TrimSpace(template.Name)
+	template.Title = strings.TrimSpace(template.Title)
+	if template.Name == "" || template.Title == "" {
+		return ErrInvalidTemplate
+	}
+
+	template.Priority = strings.ToUpper(template.Priority)
+	if template.Priority == "" {
+		template.Priority = entity.PriorityNormal
+	}
+	if !isValidPriority(template.Priority) {
+		return ErrInvalidPriority
+	}
+	if template.DueOffset != "" {
+		if err := timeexpr.Validate(template.DueOffset); err != nil {
+			return err
+		}
+	}
+
+	if len(template.Checklist) > maxChecklistItems {
+		return fmt.Errorf("%w: checklist is limited to %d items", ErrInvalidTemplate, maxChecklistItems)
+	}
+
+	for i := range template.Subtasks {
+		blueprint := &template.Subtasks[i]
+		blueprint.Title = strings.TrimSpace(blueprint.Title)
+		if blueprint.Title == "" {
+			return ErrInvalidTemplate
+		}
+		blueprint.Priority = strings.ToUpper(blueprint.Priority)
+		if blueprint.Priority != "" && !isValidPriority(blueprint.Priority) {
+			return ErrInvalidPriority
+		}
+		if blueprint.DueOffset != "" {
+			if err := timeexpr.Validate(blueprint.DueOffset); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/taskservice/webhook"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidWebhook = errors.New("invalid webhook")
+)
+
+const (
+	// minWebhookSecretLength - минимальная длина секрета, заданного пользователем
+	minWebhookSecretLength = 16
+	defaultDeliveriesLimit = 50
+	maxDeliveriesLimit     = 200
+)
+
+// CreateWebhook создает активную подписку. Без секрета генерируется случайный;
+// секрет возвращается только в ответе на создание и при смене
+func (s *taskService) CreateWebhook(ctx context.Context, hook *entity.WebhookSubscription) (*entity.WebhookSubscription, error) {
+	if err := normalizeWebhook(hook, s.webhookPolicy); err != nil {
+		return nil, err
+	}
+	if hook.Secret == "" {
+		secret, err := generateWebhookSecret()
+		if err != nil {
+			return nil, err
+		}
+		hook.Secret = secret
+	}
+	hook.Active = true
+
+	if err := s.webhookRepo.Create(ctx, hook); err != nil {
+		s.Log.Error("Error caused, after calling repo's Create webhook, in task service", zap.Error(err))
+		return nil, err
+	}
+	return hook, nil
+}
+
+func (s *taskService) ListWebhooks(ctx context.Context, userId string) ([]entity.WebhookSubscription, error) {
+	hooks, err := s.webhookRepo.ListByUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	for i := range hooks {
+		hooks[i].Secret = ""
+	}
+	return hooks, nil
+}
+
+// UpdateWebhook заменяет адрес, проект, события и активность подписки; непустой Secret меняет секрет
+func (s *taskService) UpdateWebhook(ctx context.Context, hook *entity.WebhookSubscription) (*entity.WebhookSubscription, error) {
+	if err := normalizeWebhook(hook, s.webhookPolicy); err != nil {
+		return nil, err
+	}
+	if err := s.webhookRepo.Update(ctx, hook); err != nil {
+		s.Log.Error("Error caused, after calling repo's Update webhook, in task service", zap.Error(err))
+		return nil, err
+	}
+
+	updated, err := s.webhookRepo.GetByID(ctx, hook.ID, hook.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if hook.Secret == "" {
+		updated.Secret = ""
+	}
+	return updated, nil
+}
+
+func (s *taskService) DeleteWebhook(ctx context.Context, webhookId, userId string) error {
+	return s.webhookRepo.Delete(ctx, webhookId, userId)
+}
+
+// ListWebhookDeliveries возвращает журнал доставок подписки, новые первыми
+func (s *taskService) ListWebhookDeliveries(ctx context.Context, webhookId, userId string, limit int) ([]entity.WebhookDelivery, error) {
+	if limit <= 0 {
+		limit = defaultDeliveriesLimit
+	}
+	if limit > maxDeliveriesLimit {
+		limit = maxDeliveriesLimit
+	}
+	if _, err := s.webhookRepo.GetByID(ctx, webhookId, userId); err != nil {
+		return nil, err
+	}
+	return s.webhookRepo.ListWebhookDeliveries(ctx, webhookId, userId, limit)
+}
+
+// RedeliverWebhook ставит доставку (в том числе успешную) в очередь на повторную отправку
+func (s *taskService) RedeliverWebhook(ctx context.Context, userId, webhookId, deliveryId string) (*entity.WebhookDelivery, error) {
+	delivery, err := s.webhookRepo.RedeliverWebhook(ctx, deliveryId, webhookId, userId)
+	if err != nil {
+		s.Log.Error("Error caused, after calling repo's RedeliverWebhook, in task service", zap.Error(err))
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// normalizeWebhook проверяет адрес и события; пустой список событий - подписка на все
+func normalizeWebhook(hook *entity.WebhookSubscription, policy webhook.AddressPolicy) error {
+	hook.URL = strings.TrimSpace(hook.URL)
+	hook.Project = strings.TrimSpace(hook.Project)
+	hook.Secret = strings.TrimSpace(hook.Secret)
+
+	target, err := url.Parse(hook.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute http(s) address", ErrInvalidWebhook)
+	}
+	if err := policy.CheckHost(target.Hostname()); err != nil {
+		return fmt.Errorf("%w: url must not point to an internal address", ErrInvalidWebhook)
+	}
+	if hook.Secret != "" && len(hook.Secret) < minWebhookSecretLength {
+		return fmt.Errorf("%w: secret must be at least %d characters", ErrInvalidWebhook, minWebhookSecretLength)
+	}
+
+	seen := make(map[string]bool, len(hook.EventTypes))
+	eventTypes := make([]string, 0, len(hook.EventTypes))
+	for _, eventType := range hook.EventTypes {
+		eventType = strings.ToLower(strings.TrimSpace(eventType))
+		if !webhook.IsEventType(eventType) {
+			return fmt.Errorf("%w: unknown event type %q", ErrInvalidWebhook, eventType)
+		}
+		if !seen[eventType] {
+			seen[eventType] = true
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+	hook.EventTypes = eventTypes
+	return nil
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
@@ -0,0 +1,170 @@
+package sla
+
+import (
+	"context"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"go.uber.org/zap"
+)
+
+// DefaultAtRiskThreshold - доля цели, после которой задача под угрозой, если в политике не задано
+const DefaultAtRiskThreshold = 0.8
+
+// Repository - данные, которые нужны фоновой проверке
+type Repository interface {
+	ListSLAPolicies(ctx context.Context) ([]entity.SLAPolicy, error)
+	ListActiveSLATasks(ctx context.Context) ([]entity.Task, error)
+	// SetSLAStatus меняет статус, только если он все еще равен from; false - статус уже изменен.
+	// Непустая escalation публикуется событием task.sla_escalated вместе со сменой статуса
+	SetSLAStatus(ctx context.Context, taskId, from, to string, escalation *entity.SLAEscalation) (bool, error)
+}
+
+// Apply проставляет сроки SLA задаче, создаваемой в момент now
+func Apply(task *entity.Task, policy entity.SLAPolicy, now time.Time) {
+	task.SLAResponseDueAt = now.Add(time.Duration(policy.ResponseMinutes) * time.Minute)
+	task.SLADueAt = now.Add(time.Duration(policy.ResolutionMinutes) * time.Minute)
+	task.SLAStatus = entity.SLAStatusOnTrack
+}
+
+// Evaluate возвращает статус SLA открытой задачи на момент now и цель, определившую статус.
+// Нарушение важнее угрозы, цель реакции проверяется раньше цели решения
+func Evaluate(task entity.Task, threshold float64, now time.Time) (status, target string, dueAt time.Time) {
+	if threshold <= 0 || threshold >= 1 {
+		threshold = DefaultAtRiskThreshold
+	}
+
+	type goal struct {
+		target string
+		dueAt  time.Time
+	}
+	var goals []goal
+	if task.RespondedAt.IsZero() && !task.SLAResponseDueAt.IsZero() {
+		goals = append(goals, goal{entity.SLATargetResponse, task.SLAResponseDueAt})
+	}
+	if !task.SLADueAt.IsZero() {
+		goals = append(goals, goal{entity.SLATargetResolution, task.SLADueAt})
+	}
+
+	for _, g := range goals {
+		if now.After(g.dueAt) {
+			return entity.SLAStatusBreached, g.target, g.dueAt
+		}
+	}
+	for _, g := range goals {
+		total := g.dueAt.Sub(task.CreatedAt)
+		if total > 0 && float64(now.Sub(task.CreatedAt)) >= threshold*float64(total) {
+			return entity.SLAStatusAtRisk, g.target, g.dueAt
+		}
+	}
+	return entity.SLAStatusOnTrack, "", time.Time{}
+}
+
+// Transition - статус SLA после смены статуса задачи с from на to в момент now. При завершении
+// фиксируется итог, при возврате в работу после MET отслеживание возобновляется, а фоновая проверка
+// заново оценит сроки. Задача без SLA и нарушенный SLA не меняются
+func Transition(slaStatus string, slaDueAt time.Time, from, to string, now time.Time) string {
+	switch {
+	case slaStatus == "" || slaStatus == entity.SLAStatusBreached:
+		return slaStatus
+	case to == entity.StatusCompleted:
+		if now.After(slaDueAt) {
+			return entity.SLAStatusBreached
+		}
+		return entity.SLAStatusMet
+	case from == entity.StatusCompleted && slaStatus == entity.SLAStatusMet:
+		return entity.SLAStatusOnTrack
+	}
+	return slaStatus
+}
+
+// severity - порядок статусов: эскалация публикуется только при ухудшении
+func severity(status string) int {
+	switch status {
+	case entity.SLAStatusAtRisk:
+		return 1
+	case entity.SLAStatusBreached:
+		return 2
+	}
+	return 0
+}
+
+// Checker периодически пересчитывает статусы SLA открытых задач и публикует эскалации через outbox
+type Checker struct {
+	repo     Repository
+	interval time.Duration
+	Log      *zap.Logger
+	now      func() time.Time
+}
+
+func NewChecker(repo Repository, interval time.Duration, Log *zap.Logger) *Checker {
+	return &Checker{
+		repo:     repo,
+		interval: interval,
+		Log:      Log,
+		now:      time.Now,
+	}
+}
+
+// Run выполняет проверку каждые interval до отмены ctx
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.Check(ctx); err != nil {
+			c.Log.Error("Error caused in SLA checker", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check выполняет один проход по задачам с активным SLA
+func (c *Checker) Check(ctx context.Context) error {
+	policies, err := c.repo.ListSLAPolicies(ctx)
+	if err != nil {
+		return err
+	}
+	thresholds := make(map[string]float64, len(policies))
+	for _, policy := range policies {
+		thresholds[policy.Priority] = policy.AtRiskThreshold
+	}
+
+	tasks, err := c.repo.ListActiveSLATasks(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := c.now()
+	for _, task := range tasks {
+		status, target, dueAt := Evaluate(task, thresholds[task.Priority], now)
+		if status == task.SLAStatus {
+			continue
+		}
+
+		// эскалация пишется в outbox вместе со сменой статуса и не теряется при недоступном брокере
+		var escalation *entity.SLAEscalation
+		if severity(status) > severity(task.SLAStatus) {
+			escalation = &entity.SLAEscalation{
+				TaskID:     task.ID,
+				UserID:     task.User_id,
+				Project:    task.Project,
+				Title:      task.Title,
+				Priority:   task.Priority,
+				SLAStatus:  status,
+				Target:     target,
+				DueAt:      dueAt,
+				DetectedAt: now,
+			}
+		}
+
+		if _, err := c.repo.SetSLAStatus(ctx, task.ID, task.SLAStatus, status, escalation); err != nil {
+			c.Log.Error("Failed to update SLA status", zap.String("task_id", task.ID), zap.Error(err))
+		}
+	}
+	return nil
+}
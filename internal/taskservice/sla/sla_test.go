@@ -0,0 +1,86 @@
+package sla
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+)
+
+func TestEvaluate(t *testing.T) {
+	created := time.Date(2030, 1, 2, 9, 0, 0, 0, time.UTC)
+	after := func(d time.Duration) time.Time { return created.Add(d) }
+	// реакция - за час, решение - за 10 часов
+	task := entity.Task{CreatedAt: created, SLAResponseDueAt: after(time.Hour), SLADueAt: after(10 * time.Hour)}
+	responded := task
+	responded.RespondedAt = after(20 * time.Minute)
+	// цель решения короче цели реакции: нарушение решения проверяется раньше угрозы реакции
+	inverted := task
+	inverted.SLAResponseDueAt, inverted.SLADueAt = after(10*time.Hour), after(2*time.Hour)
+
+	tests := []struct {
+		name       string
+		task       entity.Task
+		threshold  float64
+		now        time.Time
+		wantStatus string
+		wantTarget string
+		wantDueAt  time.Time
+	}{
+		{name: "no SLA", task: entity.Task{CreatedAt: created}, threshold: 0.8, now: after(100 * time.Hour), wantStatus: entity.SLAStatusOnTrack},
+		{name: "on track", task: task, threshold: 0.8, now: after(30 * time.Minute), wantStatus: entity.SLAStatusOnTrack},
+		{name: "response at risk", task: task, threshold: 0.8, now: after(48 * time.Minute),
+			wantStatus: entity.SLAStatusAtRisk, wantTarget: entity.SLATargetResponse, wantDueAt: after(time.Hour)},
+		{name: "response breached", task: task, threshold: 0.8, now: after(61 * time.Minute),
+			wantStatus: entity.SLAStatusBreached, wantTarget: entity.SLATargetResponse, wantDueAt: after(time.Hour)},
+		{name: "response due moment is not a breach", task: task, threshold: 0.8, now: after(time.Hour),
+			wantStatus: entity.SLAStatusAtRisk, wantTarget: entity.SLATargetResponse, wantDueAt: after(time.Hour)},
+		{name: "responded, resolution on track", task: responded, threshold: 0.8, now: after(2 * time.Hour), wantStatus: entity.SLAStatusOnTrack},
+		{name: "responded, resolution at risk", task: responded, threshold: 0.8, now: after(8 * time.Hour),
+			wantStatus: entity.SLAStatusAtRisk, wantTarget: entity.SLATargetResolution, wantDueAt: after(10 * time.Hour)},
+		{name: "breach outranks earlier at risk goal", task: inverted, threshold: 0.8, now: after(8*time.Hour + 30*time.Minute),
+			wantStatus: entity.SLAStatusBreached, wantTarget: entity.SLATargetResolution, wantDueAt: after(2 * time.Hour)},
+		{name: "policy threshold", task: task, threshold: 0.5, now: after(30 * time.Minute),
+			wantStatus: entity.SLAStatusAtRisk, wantTarget: entity.SLATargetResponse, wantDueAt: after(time.Hour)},
+		{name: "missing threshold falls back to default", task: task, threshold: 0, now: after(45 * time.Minute), wantStatus: entity.SLAStatusOnTrack},
+		{name: "out of range threshold falls back to default", task: task, threshold: 1.5, now: after(50 * time.Minute),
+			wantStatus: entity.SLAStatusAtRisk, wantTarget: entity.SLATargetResponse, wantDueAt: after(time.Hour)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			status, target, dueAt := Evaluate(tc.task, tc.threshold, tc.now)
+			if status != tc.wantStatus || target != tc.wantTarget || !dueAt.Equal(tc.wantDueAt) {
+				t.Errorf("Evaluate = %s %q %v, want %s %q %v", status, target, dueAt, tc.wantStatus, tc.wantTarget, tc.wantDueAt)
+			}
+		})
+	}
+}
+
+func TestTransition(t *testing.T) {
+	dueAt := time.Date(2030, 1, 2, 19, 0, 0, 0, time.UTC)
+	before, after := dueAt.Add(-time.Minute), dueAt.Add(time.Minute)
+
+	tests := []struct {
+		name      string
+		slaStatus string
+		from, to  string
+		now       time.Time
+		want      string
+	}{
+		{"no SLA", "", entity.StatusPending, entity.StatusCompleted, before, ""},
+		{"taken into work", entity.SLAStatusAtRisk, entity.StatusPending, entity.StatusInProgress, before, entity.SLAStatusAtRisk},
+		{"completed in time", entity.SLAStatusAtRisk, entity.StatusInProgress, entity.StatusCompleted, before, entity.SLAStatusMet},
+		{"completed late", entity.SLAStatusOnTrack, entity.StatusInProgress, entity.StatusCompleted, after, entity.SLAStatusBreached},
+		{"reopened after met", entity.SLAStatusMet, entity.StatusCompleted, entity.StatusInProgress, after, entity.SLAStatusOnTrack},
+		{"breach is final", entity.SLAStatusBreached, entity.StatusCompleted, entity.StatusPending, before, entity.SLAStatusBreached},
+		{"breach survives completion", entity.SLAStatusBreached, entity.StatusInProgress, entity.StatusCompleted, before, entity.SLAStatusBreached},
+		{"cancelled keeps status", entity.SLAStatusOnTrack, entity.StatusPending, entity.StatusCancelled, after, entity.SLAStatusOnTrack},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Transition(tc.slaStatus, dueAt, tc.from, tc.to, tc.now); got != tc.want {
+				t.Errorf("Transition(%s, %s -> %s) = %s, want %s", tc.slaStatus, tc.from, tc.to, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package timeexpr
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidExpression = errors.New("invalid relative date expression")
+)
+
+// exprRe: опорная точка и необязательный сдвиг, например "today+7d", "now-2h", "end_of_week"
+var exprRe = regexp.MustCompile(`^([a-z_]+|\d{4}-\d{2}-\d{2})(?:([+-])(\d+)(min|h|d|w))?$`)
+
+// shiftRe: сдвиг без опорной точки, например "+7d", "-2h"
+var shiftRe = regexp.MustCompile(`^([+-]?)(\d+)(min|h|d|w)$`)
+
+// Eval вычисляет относительное выражение даты в момент now в часовом поясе loc.
+// Опорные точки: now, today, tomorrow, yesterday, start_of_week, end_of_week,
+// start_of_month, end_of_month или дата YYYY-MM-DD; сдвиг: +/-N min|h|d|w.
+// Также принимается абсолютное время в RFC3339
+func Eval(expr string, now time.Time, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	now = now.In(loc)
+
+	expr = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(expr), " ", ""))
+	if expr == "" {
+		return time.Time{}, fmt.Errorf("%w: empty", ErrInvalidExpression)
+	}
+	if t, err := time.Parse(time.RFC3339, strings.ToUpper(expr)); err == nil {
+		return t, nil
+	}
+
+	m := exprRe.FindStringSubmatch(expr)
+	if m == nil {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidExpression, expr)
+	}
+
+	base, err := anchor(m[1], now, loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if m[2] == "" {
+		return base, nil
+	}
+	return shift(base, m[2], m[3], m[4]), nil
+}
+
+// Shift сдвигает t на offset вида +/-N min|h|d|w; дни и недели отсчитываются по календарю
+// часового пояса loc, поэтому переход на летнее время не сдвигает время суток
+func Shift(t time.Time, offset string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	m := shiftRe.FindStringSubmatch(strings.ToLower(strings.ReplaceAll(strings.TrimSpace(offset), " ", "")))
+	if m == nil {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidExpression, offset)
+	}
+	return shift(t.In(loc), m[1], m[2], m[3]), nil
+}
+
+func shift(base time.Time, sign, count, unit string) time.Time {
+	n, _ := strconv.Atoi(count)
+	if sign == "-" {
+		n = -n
+	}
+	switch unit {
+	case "min":
+		return base.Add(time.Duration(n) * time.Minute)
+	case "h":
+		return base.Add(time.Duration(n) * time.Hour)
+	case "d":
+		return base.AddDate(0, 0, n)
+	default:
+		return base.AddDate(0, 0, 7*n)
+	}
+}
+
+// Validate проверяет выражение без привязки к текущему времени
+func Validate(expr string) error {
+	_, err := Eval(expr, time.Now(), time.UTC)
+	return err
+}
+
+func anchor(name string, now time.Time, loc *time.Location) (time.Time, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	// неделя начинается с понедельника
+	weekStart := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc)
+
+	switch name {
+	case "now":
+		return now, nil
+	case "today":
+		return today, nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	case "start_of_week":
+		return weekStart, nil
+	case "end_of_week":
+		return weekStart.AddDate(0, 0, 7).Add(-time.Nanosecond), nil
+	case "start_of_month":
+		return monthStart, nil
+	case "end_of_month":
+		return monthStart.AddDate(0, 1, 0).Add(-time.Nanosecond), nil
+	}
+
+	if t, err := time.ParseInLocation("2006-01-02", name, loc); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("%w: unknown anchor %q", ErrInvalidExpression, name)
+}
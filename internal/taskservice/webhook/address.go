@@ -0,0 +1,116 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+)
+
+var ErrForbiddenAddress = errors.New("webhook receiver address is not allowed")
+
+// internalNetworks - служебные диапазоны, которых нет среди проверок netip.Addr
+var internalNetworks = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("fec0::/10"),
+}
+
+// AddressPolicy не дает отправлять доставки во внутреннюю сеть: на loopback, частные, link-local
+// и служебные адреса. Иначе подписка на адрес метрик или метаданных облака открыла бы их ответы
+// через журнал доставок. Allowed - внутренние получатели, разрешенные конфигурацией
+type AddressPolicy struct {
+	Allowed []netip.Prefix
+}
+
+// ParseAllowedNetworks разбирает список сетей через запятую: CIDR или отдельные адреса
+func ParseAllowedNetworks(list string) ([]netip.Prefix, error) {
+	var networks []netip.Prefix
+	for _, item := range strings.Split(list, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if !strings.Contains(item, "/") {
+			addr, err := netip.ParseAddr(item)
+			if err != nil {
+				return nil, fmt.Errorf("webhook allowed network %q: %w", item, err)
+			}
+			networks = append(networks, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		network, err := netip.ParsePrefix(item)
+		if err != nil {
+			return nil, fmt.Errorf("webhook allowed network %q: %w", item, err)
+		}
+		networks = append(networks, network.Masked())
+	}
+	return networks, nil
+}
+
+// Allows сообщает, можно ли соединяться с адресом
+func (p AddressPolicy) Allows(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, network := range p.Allowed {
+		if network.Contains(addr) {
+			return true
+		}
+	}
+	return !isInternal(addr)
+}
+
+// CheckHost отклоняет хост URL подписки, если он задан запрещенным адресом или localhost.
+// Доменные имена проверяются при каждом соединении: адрес за именем может смениться
+func (p AddressPolicy) CheckHost(host string) error {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		if p.Allows(netip.IPv6Loopback()) && p.Allows(netip.AddrFrom4([4]byte{127, 0, 0, 1})) {
+			return nil
+		}
+		return ErrForbiddenAddress
+	}
+	addr, err := netip.ParseAddr(strings.Trim(host, "[]"))
+	if err != nil {
+		return nil
+	}
+	if !p.Allows(addr) {
+		return ErrForbiddenAddress
+	}
+	return nil
+}
+
+// control вызывается net.Dialer для уже разрешенного адреса перед соединением
+func (p AddressPolicy) control(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, address)
+	}
+	if !p.Allows(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, addrPort.Addr())
+	}
+	return nil
+}
+
+func (p AddressPolicy) dialer(timeout time.Duration) *net.Dialer {
+	return &net.Dialer{Timeout: timeout, Control: p.control}
+}
+
+func isInternal(addr netip.Addr) bool {
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() {
+		return true
+	}
+	for _, network := range internalNetworks {
+		if network.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,313 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/oogway93/taskmanager/internal/taskservice/notification"
+	"github.com/streadway/amqp"
+	"go.uber.org/zap"
+)
+
+// Queue - очередь RabbitMQ, из которой события задач раскладываются по подпискам
+const Queue = "task_webhooks"
+
+// Заголовки запроса доставки. Подпись - HMAC-SHA256 секрета подписки от "<timestamp>.<body>"
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+const (
+	// baseBackoff - пауза после первой неудачной попытки, дальше она удваивается до maxBackoff
+	baseBackoff = 30 * time.Second
+	maxBackoff  = 6 * time.Hour
+	// responseBodyLimit - сколько байт ответа получателя сохраняется в журнале
+	responseBodyLimit = 1024
+	batchSize         = 20
+)
+
+var ErrUnexpectedStatus = errors.New("webhook receiver responded with non-2xx status")
+
+// EventTypes - события, на которые можно подписаться
+var EventTypes = []string{entity.EventTaskCreated, entity.EventTaskUpdated, entity.EventSLAEscalated}
+
+// Repository - данные, которые нужны раскладке событий и отправке
+type Repository interface {
+	ListMatchingWebhooks(ctx context.Context, userId, project, eventType string) ([]entity.WebhookSubscription, error)
+	CreateWebhookDeliveries(ctx context.Context, deliveries []entity.WebhookDelivery) error
+	ClaimWebhookDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]entity.WebhookDelivery, error)
+	SaveWebhookDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error
+}
+
+// Envelope - тело запроса доставки
+type Envelope struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	DeliveryID string          `json:"delivery_id"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// Sign возвращает значение заголовка X-Webhook-Signature: "sha256=" + hex(HMAC-SHA256(secret, "<timestamp>.<body>"))
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify проверяет подпись доставки на стороне получателя
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// IsEventType сообщает, можно ли подписаться на событие eventType
+func IsEventType(eventType string) bool {
+	for _, known := range EventTypes {
+		if known == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Backoff возвращает паузу перед следующей попыткой после attempts неудачных
+func Backoff(attempts int32) time.Duration {
+	if attempts < 1 {
+		return 0
+	}
+	delay := baseBackoff
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return delay
+}
+
+// NewHTTPClient создает клиент доставки: редиректы не выполняются, чтобы подписанное тело
+// не ушло на чужой адрес, ответ 3xx считается неудачей. Соединения с адресами, которые
+// запрещает policy, не устанавливаются; прокси из окружения не используется, чтобы проверялся
+// адрес самого получателя
+func NewHTTPClient(timeout time.Duration, policy AddressPolicy) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = policy.dialer(timeout).DialContext
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// Fanout раскладывает события задач из RabbitMQ по подходящим подпискам. События, из которых
+// создаются уведомления, отправляются, только если у их типа включен канал webhook
+type Fanout struct {
+	repo  Repository
+	prefs notification.Preferences
+	Log   *zap.Logger
+}
+
+func NewFanout(repo Repository, prefs notification.Preferences, Log *zap.Logger) *Fanout {
+	return &Fanout{repo: repo, prefs: prefs, Log: Log}
+}
+
+// Handle - обработчик для rabbitmq.Consumer. Идентификатор события - message id из outbox,
+// поэтому повторное получение того же сообщения не создает новых доставок
+func (f *Fanout) Handle(ctx context.Context, delivery amqp.Delivery) error {
+	if !IsEventType(delivery.RoutingKey) {
+		return nil
+	}
+	if delivery.MessageId == "" {
+		f.Log.Warn("Skipping task event without message id", zap.String("type", delivery.RoutingKey))
+		return nil
+	}
+
+	var event entity.TaskEvent
+	if err := json.Unmarshal(delivery.Body, &event); err != nil {
+		f.Log.Error("Failed to decode task event for webhooks", zap.Error(err))
+		return nil
+	}
+
+	if notificationType := notification.TypeOf(delivery.RoutingKey); notificationType != "" {
+		recipient, err := f.prefs.GetNotificationRecipient(ctx, event.UserID)
+		if err != nil {
+			return err
+		}
+		if recipient == nil || !recipient.Preferences.Allows(notificationType, entity.ChannelWebhook) {
+			return nil
+		}
+	}
+
+	hooks, err := f.repo.ListMatchingWebhooks(ctx, event.UserID, event.Project, delivery.RoutingKey)
+	if err != nil {
+		return err
+	}
+	deliveries := make([]entity.WebhookDelivery, 0, len(hooks))
+	for _, hook := range hooks {
+		deliveries = append(deliveries, entity.WebhookDelivery{
+			SubscriptionID: hook.ID,
+			EventID:        delivery.MessageId,
+			EventType:      delivery.RoutingKey,
+			Payload:        delivery.Body,
+		})
+	}
+	return f.repo.CreateWebhookDeliveries(ctx, deliveries)
+}
+
+// Dispatcher периодически отправляет ожидающие доставки. Неудачная попытка откладывается
+// с экспоненциальной паузой, после maxAttempts доставка получает статус FAILED
+type Dispatcher struct {
+	repo        Repository
+	client      *http.Client
+	interval    time.Duration
+	maxAttempts int32
+	Log         *zap.Logger
+
+	now func() time.Time
+}
+
+// NewDispatcher создает отправителя; client подменяется в тестах (например, на клиент httptest сервера)
+func NewDispatcher(repo Repository, client *http.Client, interval time.Duration, maxAttempts int, Log *zap.Logger) *Dispatcher {
+	return &Dispatcher{
+		repo:        repo,
+		client:      client,
+		interval:    interval,
+		maxAttempts: int32(maxAttempts),
+		Log:         Log,
+		now:         time.Now,
+	}
+}
+
+// Run отправляет доставки до отмены ctx
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		sent, err := d.Dispatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			d.Log.Error("Error caused after calling webhook dispatcher's Dispatch", zap.Error(err))
+		}
+		if err == nil && sent == batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch отправляет одну пачку доставок и возвращает число обработанных
+func (d *Dispatcher) Dispatch(ctx context.Context) (int, error) {
+	now := d.now()
+	// пачка отправляется последовательно, аренда покрывает таймауты всех запросов;
+	// доставки упавшего экземпляра вернутся в очередь по ее истечении
+	lease := now.Add(time.Duration(batchSize)*d.client.Timeout + time.Minute)
+	deliveries, err := d.repo.ClaimWebhookDeliveries(ctx, now, lease, batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range deliveries {
+		delivery := &deliveries[i]
+		d.deliver(ctx, delivery)
+		if err := d.repo.SaveWebhookDelivery(ctx, delivery); err != nil {
+			return i, err
+		}
+	}
+	return len(deliveries), nil
+}
+
+// deliver выполняет одну попытку и записывает ее результат в delivery
+func (d *Dispatcher) deliver(ctx context.Context, delivery *entity.WebhookDelivery) {
+	delivery.Attempts++
+	delivery.ResponseStatus = 0
+	delivery.ResponseBody = ""
+	delivery.LastError = ""
+
+	start := d.now()
+	err := d.post(ctx, delivery)
+	finished := d.now()
+	delivery.DurationMs = finished.Sub(start).Milliseconds()
+
+	switch {
+	case err == nil:
+		delivery.Status = entity.WebhookDeliveryDelivered
+		delivery.DeliveredAt = &finished
+		delivery.NextAttemptAt = nil
+	case delivery.Attempts >= d.maxAttempts:
+		delivery.Status = entity.WebhookDeliveryFailed
+		delivery.LastError = err.Error()
+		delivery.NextAttemptAt = nil
+	default:
+		next := finished.Add(Backoff(delivery.Attempts))
+		delivery.LastError = err.Error()
+		delivery.NextAttemptAt = &next
+	}
+	if err != nil {
+		d.Log.Warn("Webhook delivery attempt failed",
+			zap.String("delivery_id", delivery.ID),
+			zap.String("url", delivery.URL),
+			zap.Int32("attempts", delivery.Attempts),
+			zap.Error(err))
+	}
+}
+
+func (d *Dispatcher) post(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	body, err := json.Marshal(Envelope{
+		ID:         delivery.EventID,
+		Type:       delivery.EventType,
+		DeliveryID: delivery.ID,
+		Data:       delivery.Payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	timestamp := d.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "taskmanager-webhooks/1.0")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, delivery.ID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	responseBody, _ := io.ReadAll(io.LimitReader(resp.Body, responseBodyLimit))
+	delivery.ResponseStatus = int32(resp.StatusCode)
+	delivery.ResponseBody = string(responseBody)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%w: %s", ErrUnexpectedStatus, resp.Status)
+	}
+	return nil
+}
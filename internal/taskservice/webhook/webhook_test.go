@@ -0,0 +1,287 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oogway93/taskmanager/internal/entity"
+	"github.com/streadway/amqp"
+	"go.uber.org/zap"
+)
+
+// Получатели поднимаются на httptest.Server, то есть на loopback, поэтому клиент тестов
+// разрешает 127.0.0.0/8 так же, как WEBHOOK_ALLOWED_NETWORKS разрешает внутренних получателей
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+var loopbackPolicy = AddressPolicy{Allowed: []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")}}
+
+// fakeRepository отдает одну пачку доставок и запоминает сохраненные результаты. Подписки
+// подбираются по тем же условиям, что и в SQL репозитория
+type fakeRepository struct {
+	hooks   []entity.WebhookSubscription
+	created []entity.WebhookDelivery
+	pending []entity.WebhookDelivery
+	saved   []entity.WebhookDelivery
+}
+
+func (r *fakeRepository) ListMatchingWebhooks(ctx context.Context, userId, project, eventType string) ([]entity.WebhookSubscription, error) {
+	var matching []entity.WebhookSubscription
+	for _, hook := range r.hooks {
+		if hook.UserID != userId || !hook.Active || (hook.Project != "" && hook.Project != project) {
+			continue
+		}
+		if len(hook.EventTypes) == 0 || slices.Contains(hook.EventTypes, eventType) {
+			matching = append(matching, hook)
+		}
+	}
+	return matching, nil
+}
+
+func (r *fakeRepository) CreateWebhookDeliveries(ctx context.Context, deliveries []entity.WebhookDelivery) error {
+	r.created = append(r.created, deliveries...)
+	return nil
+}
+
+func (r *fakeRepository) ClaimWebhookDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]entity.WebhookDelivery, error) {
+	claimed := r.pending
+	r.pending = nil
+	return claimed, nil
+}
+
+func (r *fakeRepository) SaveWebhookDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	r.saved = append(r.saved, *delivery)
+	return nil
+}
+
+func newTestDelivery(url string, attempts int32) entity.WebhookDelivery {
+	return entity.WebhookDelivery{
+		ID:        "delivery-1",
+		EventID:   "event-1",
+		EventType: entity.EventTaskCreated,
+		Payload:   []byte(`{"task_id":"task-1"}`),
+		Status:    entity.WebhookDeliveryPending,
+		Attempts:  attempts,
+		URL:       url,
+		Secret:    testSecret,
+	}
+}
+
+// dispatchOne отправляет одну доставку с фиксированным временем и возвращает ее результат
+func dispatchOne(t *testing.T, client *http.Client, maxAttempts int, delivery entity.WebhookDelivery, now time.Time) entity.WebhookDelivery {
+	t.Helper()
+	repo := &fakeRepository{pending: []entity.WebhookDelivery{delivery}}
+	dispatcher := NewDispatcher(repo, client, time.Second, maxAttempts, zap.NewNop())
+	dispatcher.now = func() time.Time { return now }
+
+	sent, err := dispatcher.Dispatch(context.Background())
+	if err != nil || sent != 1 {
+		t.Fatalf("Dispatch = %d, %v, want 1 delivery", sent, err)
+	}
+	if len(repo.saved) != 1 {
+		t.Fatalf("saved %d deliveries, want 1", len(repo.saved))
+	}
+	return repo.saved[0]
+}
+
+func TestDispatcherSignsDeliveries(t *testing.T) {
+	now := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	var verified atomic.Bool
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp, _ := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+		verified.Store(Verify(testSecret, timestamp, body, r.Header.Get(HeaderSignature)) &&
+			r.Header.Get(HeaderEvent) == entity.EventTaskCreated &&
+			r.Header.Get(HeaderDelivery) == "delivery-1")
+		w.Write([]byte("ok"))
+	}))
+	defer receiver.Close()
+
+	got := dispatchOne(t, NewHTTPClient(time.Second, loopbackPolicy), 3, newTestDelivery(receiver.URL, 0), now)
+
+	if !verified.Load() {
+		t.Error("receiver could not verify the delivery signature and headers")
+	}
+	if got.Status != entity.WebhookDeliveryDelivered || got.Attempts != 1 || got.ResponseStatus != http.StatusOK {
+		t.Errorf("delivery = status %s, attempts %d, response %d; want DELIVERED after 1 attempt with 200",
+			got.Status, got.Attempts, got.ResponseStatus)
+	}
+	if got.DeliveredAt == nil || !got.DeliveredAt.Equal(now) || got.NextAttemptAt != nil {
+		t.Errorf("delivered at = %v, next attempt = %v", got.DeliveredAt, got.NextAttemptAt)
+	}
+}
+
+func TestDispatcherRetries(t *testing.T) {
+	now := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "temporarily down", http.StatusServiceUnavailable)
+	}))
+	defer receiver.Close()
+	client := NewHTTPClient(time.Second, loopbackPolicy)
+
+	tests := []struct {
+		name       string
+		attempts   int32
+		wantStatus string
+		wantNext   time.Duration
+	}{
+		{name: "first failure", attempts: 0, wantStatus: entity.WebhookDeliveryPending, wantNext: baseBackoff},
+		{name: "third failure", attempts: 2, wantStatus: entity.WebhookDeliveryPending, wantNext: 4 * baseBackoff},
+		{name: "last attempt", attempts: 4, wantStatus: entity.WebhookDeliveryFailed},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := dispatchOne(t, client, 5, newTestDelivery(receiver.URL, tc.attempts), now)
+
+			if got.Status != tc.wantStatus || got.Attempts != tc.attempts+1 {
+				t.Errorf("delivery = status %s, attempts %d; want %s, %d", got.Status, got.Attempts, tc.wantStatus, tc.attempts+1)
+			}
+			if got.ResponseStatus != http.StatusServiceUnavailable || !strings.Contains(got.ResponseBody, "temporarily down") {
+				t.Errorf("response = %d %q", got.ResponseStatus, got.ResponseBody)
+			}
+			if !strings.Contains(got.LastError, "503") {
+				t.Errorf("last error = %q", got.LastError)
+			}
+			switch {
+			case tc.wantNext == 0 && got.NextAttemptAt != nil:
+				t.Errorf("next attempt = %v, want none", got.NextAttemptAt)
+			case tc.wantNext != 0 && (got.NextAttemptAt == nil || !got.NextAttemptAt.Equal(now.Add(tc.wantNext))):
+				t.Errorf("next attempt = %v, want %v", got.NextAttemptAt, now.Add(tc.wantNext))
+			}
+		})
+	}
+}
+
+func TestDispatcherDoesNotFollowRedirects(t *testing.T) {
+	var followed atomic.Bool
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		followed.Store(true)
+	}))
+	defer target.Close()
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer receiver.Close()
+
+	got := dispatchOne(t, NewHTTPClient(time.Second, loopbackPolicy), 3, newTestDelivery(receiver.URL, 0), time.Now())
+
+	if followed.Load() {
+		t.Error("signed delivery was sent to the redirect target")
+	}
+	if got.Status == entity.WebhookDeliveryDelivered || got.ResponseStatus != http.StatusTemporaryRedirect {
+		t.Errorf("delivery = status %s, response %d; want a failed attempt with 307", got.Status, got.ResponseStatus)
+	}
+}
+
+func TestDispatcherBlocksInternalAddresses(t *testing.T) {
+	var reached atomic.Bool
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached.Store(true)
+	}))
+	defer receiver.Close()
+
+	client := NewHTTPClient(time.Second, AddressPolicy{})
+	_, err := client.Post(receiver.URL, "application/json", strings.NewReader("{}"))
+	if !errors.Is(err, ErrForbiddenAddress) {
+		t.Errorf("Post to loopback = %v, want ErrForbiddenAddress", err)
+	}
+	if reached.Load() {
+		t.Error("request reached a loopback receiver")
+	}
+}
+
+func TestAddressPolicy(t *testing.T) {
+	allowed, err := ParseAllowedNetworks("10.0.5.0/24, 192.168.1.20")
+	if err != nil {
+		t.Fatalf("ParseAllowedNetworks: %v", err)
+	}
+	policy := AddressPolicy{Allowed: allowed}
+
+	tests := []struct {
+		host    string
+		allowed bool
+	}{
+		{"hooks.example.com", true},
+		{"93.184.216.34", true},
+		{"127.0.0.1", false},
+		{"localhost", false},
+		{"[::1]", false},
+		{"10.1.2.3", false},
+		{"169.254.169.254", false},
+		{"100.64.0.1", false},
+		{"::ffff:127.0.0.1", false},
+		{"fd00::1", false},
+		{"10.0.5.7", true},
+		{"192.168.1.20", true},
+		{"192.168.1.21", false},
+	}
+	for _, tc := range tests {
+		if err := policy.CheckHost(tc.host); (err == nil) != tc.allowed {
+			t.Errorf("CheckHost(%q) = %v, want allowed=%v", tc.host, err, tc.allowed)
+		}
+	}
+
+	if _, err := ParseAllowedNetworks("10.0.0.0/33"); err == nil {
+		t.Error("ParseAllowedNetworks accepted an invalid network")
+	}
+}
+
+// defaultPreferences - адресат с настройками уведомлений по умолчанию
+type defaultPreferences struct{}
+
+func (defaultPreferences) GetNotificationRecipient(ctx context.Context, userId string) (*entity.NotificationRecipient, error) {
+	return &entity.NotificationRecipient{UserID: userId, Preferences: entity.DefaultNotificationPreferences()}, nil
+}
+
+func TestFanoutMatchesProjectHooks(t *testing.T) {
+	escalation, err := json.Marshal(entity.SLAEscalation{
+		TaskID:    "task-1",
+		UserID:    "user-1",
+		Project:   "support",
+		Title:     "Printer is down",
+		SLAStatus: entity.SLAStatusBreached,
+	})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	repo := &fakeRepository{hooks: []entity.WebhookSubscription{
+		{ID: "all-projects", UserID: "user-1", Active: true},
+		{ID: "support", UserID: "user-1", Project: "support", Active: true, EventTypes: []string{entity.EventSLAEscalated}},
+		{ID: "sales", UserID: "user-1", Project: "sales", Active: true},
+		{ID: "created-only", UserID: "user-1", Active: true, EventTypes: []string{entity.EventTaskCreated}},
+		{ID: "other-user", UserID: "user-2", Active: true},
+	}}
+	fanout := NewFanout(repo, defaultPreferences{}, zap.NewNop())
+
+	err = fanout.Handle(context.Background(), amqp.Delivery{
+		RoutingKey: entity.EventSLAEscalated,
+		MessageId:  "event-1",
+		Body:       escalation,
+	})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+
+	var got []string
+	for _, delivery := range repo.created {
+		got = append(got, delivery.SubscriptionID)
+		if delivery.EventID != "event-1" || delivery.EventType != entity.EventSLAEscalated {
+			t.Errorf("delivery = %+v", delivery)
+		}
+	}
+	if want := []string{"all-projects", "support"}; !slices.Equal(got, want) {
+		t.Errorf("deliveries for %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_tasks_user_external_uid;
+DROP INDEX IF EXISTS idx_tasks_due_date;
+ALTER TABLE tasks DROP COLUMN IF EXISTS external_uid;
+ALTER TABLE tasks DROP COLUMN IF EXISTS due_date;
@@ -0,0 +1,6 @@
+-- Срок выполнения задачи и внешний идентификатор (UID из .ics) для импорта
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS due_date TIMESTAMP WITH TIME ZONE;
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS external_uid VARCHAR(255);
+
+CREATE INDEX IF NOT EXISTS idx_tasks_due_date ON tasks(due_date);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tasks_user_external_uid ON tasks(user_id, external_uid) WHERE external_uid IS NOT NULL;
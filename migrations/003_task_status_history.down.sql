@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS task_status_history CASCADE;
+DROP INDEX IF EXISTS idx_tasks_completed_at;
+ALTER TABLE tasks DROP COLUMN IF EXISTS completed_at;
@@ -0,0 +1,22 @@
+-- Время завершения задачи и история смены статусов для аналитики
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS completed_at TIMESTAMP WITH TIME ZONE;
+
+CREATE TABLE IF NOT EXISTS task_status_history (
+    id BIGSERIAL PRIMARY KEY,
+    task_id UUID NOT NULL,
+    status VARCHAR(30) NOT NULL,
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_status_history_task_id ON task_status_history(task_id, changed_at);
+CREATE INDEX IF NOT EXISTS idx_tasks_completed_at ON tasks(completed_at);
+
+-- Уже завершенные задачи: точное время завершения неизвестно, ближайшая оценка - последнее изменение
+UPDATE tasks SET completed_at = updated_at WHERE status = 'COMPLETED' AND completed_at IS NULL;
+
+-- Начальная запись истории для уже существующих задач
+INSERT INTO task_status_history (task_id, status, changed_at)
+SELECT id, status, created_at FROM tasks
+WHERE NOT EXISTS (SELECT 1 FROM task_status_history h WHERE h.task_id = tasks.id);
@@ -0,0 +1,2 @@
+-- Оценка трудоемкости задачи (часы или story points) для диаграммы сгорания
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS estimate DOUBLE PRECISION NOT NULL DEFAULT 0;
@@ -0,0 +1,25 @@
+-- Подзадачи: ссылка на родительскую задачу
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS parent_id UUID REFERENCES tasks (id) ON DELETE CASCADE;
+
+CREATE INDEX idx_tasks_parent_id ON tasks(parent_id) WHERE parent_id IS NOT NULL;
+
+-- Шаблоны задач: заголовок и описание с плейсхолдерами {{name}}, чек-лист и заготовки подзадач
+CREATE TABLE IF NOT EXISTS task_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    priority VARCHAR(20) NOT NULL DEFAULT 'NORMAL',
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    due_offset VARCHAR(50) NOT NULL DEFAULT '',
+    checklist JSONB NOT NULL DEFAULT '[]',
+    subtasks JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT task_templates_user_name UNIQUE (user_id, name)
+);
+
+CREATE INDEX idx_task_templates_user_id ON task_templates(user_id);
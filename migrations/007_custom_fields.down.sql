@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS custom_field_definitions CASCADE;
+DROP INDEX IF EXISTS idx_tasks_custom_fields;
+DROP INDEX IF EXISTS idx_tasks_project;
+ALTER TABLE tasks DROP COLUMN IF EXISTS custom_fields;
+ALTER TABLE tasks DROP COLUMN IF EXISTS project;
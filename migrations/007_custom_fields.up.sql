@@ -0,0 +1,26 @@
+-- Проект задачи и значения пользовательских полей
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS project VARCHAR(100) NOT NULL DEFAULT '';
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX idx_tasks_project ON tasks(user_id, project);
+-- jsonb_path_ops покрывает фильтры вида custom_fields @> '{"sla_tier": "gold"}'
+CREATE INDEX idx_tasks_custom_fields ON tasks USING GIN (custom_fields jsonb_path_ops);
+
+-- Определения пользовательских полей; пустой project - поле действует во всех проектах пользователя
+CREATE TABLE IF NOT EXISTS custom_field_definitions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    project VARCHAR(100) NOT NULL DEFAULT '',
+    key VARCHAR(64) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('text', 'number', 'date', 'single_select', 'multi_select')),
+    options TEXT[] NOT NULL DEFAULT '{}',
+    required BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT custom_field_definitions_scope_key UNIQUE (user_id, project, key)
+);
+
+CREATE INDEX idx_custom_field_definitions_user_id ON custom_field_definitions(user_id);
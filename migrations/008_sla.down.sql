@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_tasks_sla_active;
+ALTER TABLE tasks DROP COLUMN IF EXISTS sla_status;
+ALTER TABLE tasks DROP COLUMN IF EXISTS responded_at;
+ALTER TABLE tasks DROP COLUMN IF EXISTS sla_due_at;
+ALTER TABLE tasks DROP COLUMN IF EXISTS sla_response_due_at;
+DROP TABLE IF EXISTS sla_policies CASCADE;
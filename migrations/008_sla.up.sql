@@ -0,0 +1,24 @@
+-- SLA политики: цели реакции и решения для каждого приоритета
+CREATE TABLE IF NOT EXISTS sla_policies (
+    priority VARCHAR(20) PRIMARY KEY,
+    response_minutes INTEGER NOT NULL CHECK (response_minutes > 0),
+    resolution_minutes INTEGER NOT NULL CHECK (resolution_minutes > 0),
+    -- доля прошедшего времени цели, после которой задача считается под угрозой
+    at_risk_threshold DOUBLE PRECISION NOT NULL DEFAULT 0.8 CHECK (at_risk_threshold > 0 AND at_risk_threshold < 1),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO sla_policies (priority, response_minutes, resolution_minutes) VALUES
+    ('CRITICAL', 15, 240),
+    ('HIGH', 60, 480),
+    ('NORMAL', 240, 1440),
+    ('LOW', 1440, 4320)
+ON CONFLICT (priority) DO NOTHING;
+
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS sla_response_due_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS sla_due_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS responded_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS sla_status VARCHAR(20) NOT NULL DEFAULT '';
+
+-- фоновая проверка выбирает только задачи с активным SLA
+CREATE INDEX idx_tasks_sla_active ON tasks(sla_due_at) WHERE sla_status IN ('ON_TRACK', 'AT_RISK');
@@ -0,0 +1,16 @@
+-- Transactional outbox: доменные события пишутся в одной транзакции с изменением данных,
+-- а фоновый relay публикует их в RabbitMQ
+CREATE TABLE IF NOT EXISTS outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_type VARCHAR(100) NOT NULL,
+    aggregate_id VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    last_error TEXT,
+    dispatched_at TIMESTAMP WITH TIME ZONE
+);
+
+-- relay выбирает только неотправленные события
+CREATE INDEX idx_outbox_pending ON outbox(id) WHERE dispatched_at IS NULL;
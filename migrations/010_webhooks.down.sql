@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_webhook_deliveries_subscription;
+DROP INDEX IF EXISTS idx_webhook_deliveries_pending;
+DROP TABLE IF EXISTS webhook_deliveries CASCADE;
+DROP INDEX IF EXISTS idx_webhook_subscriptions_user;
+DROP TABLE IF EXISTS webhook_subscriptions CASCADE;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_users_digest;
+ALTER TABLE users DROP COLUMN IF EXISTS digest_sent_on;
+ALTER TABLE users DROP COLUMN IF EXISTS digest_hour;
+ALTER TABLE users DROP COLUMN IF EXISTS digest_enabled;
+ALTER TABLE users DROP COLUMN IF EXISTS timezone;
@@ -0,0 +1,8 @@
+-- Настройки ежедневного дайджеста: часовой пояс пользователя, отказ от рассылки и локальный час отправки
+ALTER TABLE users ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS digest_enabled BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS digest_hour SMALLINT NOT NULL DEFAULT 8 CHECK (digest_hour BETWEEN 0 AND 23);
+-- локальная дата последнего дайджеста: не больше одного письма в день
+ALTER TABLE users ADD COLUMN IF NOT EXISTS digest_sent_on DATE;
+
+CREATE INDEX idx_users_digest ON users(digest_sent_on) WHERE digest_enabled AND active;
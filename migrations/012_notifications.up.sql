@@ -0,0 +1,20 @@
+-- Уведомления в приложении: создаются из доменных событий, одна строка на пользователя/событие/тип
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('assignment', 'comment', 'mention', 'reminder', 'sla_breach')),
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL DEFAULT '',
+    -- задача, к которой относится уведомление; пусто для сводных напоминаний
+    task_id UUID,
+    event_id VARCHAR(100) NOT NULL,
+    read_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    -- повторная доставка события из RabbitMQ не создает дубликат
+    UNIQUE (user_id, event_id, type),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_notifications_user_created ON notifications(user_id, created_at DESC);
+CREATE INDEX idx_notifications_unread ON notifications(user_id) WHERE read_at IS NULL;
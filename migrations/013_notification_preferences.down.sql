@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_notifications_email_pending;
+ALTER TABLE notifications DROP COLUMN IF EXISTS email_pending;
+ALTER TABLE notifications DROP COLUMN IF EXISTS in_app;
+DROP TABLE IF EXISTS notification_preferences CASCADE;
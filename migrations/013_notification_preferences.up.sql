@@ -0,0 +1,19 @@
+-- Настройки уведомлений: каналы по типу уведомления (пропущенные типы - значения по умолчанию),
+-- тихие часы в часовом поясе users.timezone и накопление писем до дайджеста
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id UUID PRIMARY KEY,
+    channels JSONB NOT NULL DEFAULT '{}',
+    quiet_hours_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    quiet_hours_start VARCHAR(5) NOT NULL DEFAULT '22:00',
+    quiet_hours_end VARCHAR(5) NOT NULL DEFAULT '07:00',
+    digest_batching BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+-- in_app = FALSE - уведомление хранится только для дедупликации или письма в дайджесте;
+-- email_pending - письмо ждет ближайшего дайджеста
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS in_app BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS email_pending BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX idx_notifications_email_pending ON notifications(user_id) WHERE email_pending;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_task_quadrant_overrides_user;
+DROP TABLE IF EXISTS task_quadrant_overrides CASCADE;
@@ -0,0 +1,11 @@
+-- Ручное закрепление задачи в квадранте матрицы Эйзенхауэра, перекрывает вычисленный квадрант
+CREATE TABLE IF NOT EXISTS task_quadrant_overrides (
+    task_id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    quadrant VARCHAR(20) NOT NULL CHECK (quadrant IN ('DO_FIRST', 'SCHEDULE', 'DELEGATE', 'ELIMINATE')),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_task_quadrant_overrides_user ON task_quadrant_overrides(user_id);
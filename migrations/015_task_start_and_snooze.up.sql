@@ -0,0 +1,3 @@
+-- Дата, с которой задачу можно начать, и время, до которого она отложена; до этого задача скрыта из списка
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS start_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS snoozed_until TIMESTAMP WITH TIME ZONE;
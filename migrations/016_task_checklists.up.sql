@@ -0,0 +1,13 @@
+-- Пункты чек-листа задачи: короткие шаги, которым не нужна отдельная подзадача
+CREATE TABLE IF NOT EXISTS task_checklist_items (
+    id UUID PRIMARY KEY,
+    task_id UUID NOT NULL,
+    text VARCHAR(500) NOT NULL,
+    done BOOLEAN NOT NULL DEFAULT FALSE,
+    position INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_task_checklist_items_task ON task_checklist_items(task_id, position);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_task_shares_grantee;
+DROP TABLE IF EXISTS task_shares CASCADE;
@@ -0,0 +1,15 @@
+-- Доступ к задаче для других пользователей: READ - только просмотр, EDIT - изменение полей задачи
+CREATE TABLE IF NOT EXISTS task_shares (
+    task_id UUID NOT NULL,
+    grantee_id UUID NOT NULL,
+    permission VARCHAR(10) NOT NULL CHECK (permission IN ('READ', 'EDIT')),
+    granted_by UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (task_id, grantee_id),
+    FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE,
+    FOREIGN KEY (grantee_id) REFERENCES users (id) ON DELETE CASCADE,
+    FOREIGN KEY (granted_by) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_task_shares_grantee ON task_shares(grantee_id);
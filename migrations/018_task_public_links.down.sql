@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_task_public_links_task;
+DROP TABLE IF EXISTS task_public_links CASCADE;